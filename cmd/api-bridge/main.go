@@ -56,6 +56,17 @@ func main() {
 	}
 	defer cleanup(dependencies)
 
+	// 라우트 인덱스 주기적 갱신 (다른 인스턴스에서 변경된 규칙 반영)
+	refreshCtx, stopRefresh := context.WithCancel(context.Background())
+	defer stopRefresh()
+	go runRouteIndexRefresher(refreshCtx, dependencies.BridgeService, cfg.Cache.RoutingRulesTTL, dependencies.Logger)
+
+	// 단계적 전환 / 자동 롤백 주기적 평가
+	go runTransitionController(refreshCtx, dependencies.TransitionController, cfg.Orchestration.TransitionInterval, dependencies.Logger)
+
+	// 비교 결과 보존 정책 주기적 적용
+	go runComparisonRetention(refreshCtx, dependencies.RetentionJob, cfg.Orchestration.Retention.Interval, dependencies.Logger)
+
 	// Gin 모드 설정
 	gin.SetMode(gin.ReleaseMode)
 
@@ -77,6 +88,8 @@ func main() {
 		dependencies.RoutingService,
 		dependencies.OrchestrationService,
 		dependencies.Logger,
+		httpadapter.WithMaxBodySize(cfg.ExternalAPI.MaxBodySize),
+		httpadapter.WithCircuitBreakerService(dependencies.CircuitBreaker),
 	)
 
 	// 라우트 설정
@@ -154,6 +167,10 @@ type Dependencies struct {
 	RoutingService       port.RoutingService
 	OrchestrationService port.OrchestrationService
 	RedisClient          *redis.Client
+	TransitionRepo       port.TransitionHistoryRepository
+	ShadowPool           *service.ShadowPool
+	TransitionController *service.TransitionController
+	RetentionJob         *service.ComparisonRetentionJob
 }
 
 // initializeDependencies는 모든 의존성을 초기화합니다.
@@ -264,6 +281,7 @@ func initializeDependencies(cfg *config.Config) (*Dependencies, error) {
 	var routingRepo port.RoutingRepository
 	var orchestrationRepo port.OrchestrationRepository
 	var comparisonRepo port.ComparisonRepository
+	var transitionHistoryRepo port.TransitionHistoryRepository
 
 	// OracleDB 연결 시도
 	oracleRoutingRepo, err := database.NewOracleRoutingRepository(&cfg.Database)
@@ -275,6 +293,7 @@ func initializeDependencies(cfg *config.Config) (*Dependencies, error) {
 		routingRepo = database.NewMockRoutingRepository()
 		orchestrationRepo = database.NewMockOrchestrationRepository()
 		comparisonRepo = database.NewMockComparisonRepository()
+		transitionHistoryRepo = database.NewMockTransitionHistoryRepository()
 	} else {
 		// OracleDB 리포지토리 사용
 		routingRepo = oracleRoutingRepo
@@ -297,14 +316,35 @@ func initializeDependencies(cfg *config.Config) (*Dependencies, error) {
 			comparisonRepo = oracleComparisonRepo
 		}
 
+		// Transition History Repository OracleDB 구현
+		oracleTransitionHistoryRepo, err := database.NewOracleTransitionHistoryRepository(&cfg.Database)
+		if err != nil {
+			log.Warn(fmt.Sprintf("Failed to create Oracle transition history repository: %v", err))
+			transitionHistoryRepo = database.NewMockTransitionHistoryRepository()
+		} else {
+			transitionHistoryRepo = oracleTransitionHistoryRepo
+		}
+
 		log.Info("✅ OracleDB repositories initialized")
 	}
 
 	// Circuit Breaker 서비스 초기화
 	circuitBreakerService := service.NewCircuitBreakerService(log, metricsCollector)
 
+	// 전역 Circuit Breaker 정책 (엔드포인트별 설정에 없는 항목에 적용)
+	breakerPolicy := configadapter.CircuitBreakerPolicy(&cfg.CircuitBreaker)
+	if err := breakerPolicy.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid circuit breaker config: %w", err)
+	}
+
 	// HTTP 클라이언트 초기화 (Circuit Breaker 포함)
-	httpClient := httpclient.NewHTTPClientAdapterWithCircuitBreaker(cfg.ExternalAPI.Timeout, circuitBreakerService)
+	httpClient := httpclient.NewHTTPClientAdapterWithCircuitBreaker(
+		cfg.ExternalAPI.Timeout,
+		circuitBreakerService,
+		metricsCollector,
+		httpclient.WithMaxBodySize(cfg.ExternalAPI.MaxBodySize),
+		httpclient.WithCircuitBreakerPolicy(breakerPolicy),
+	)
 
 	// 서비스 초기화
 	healthService := service.NewHealthCheckService(routingRepo, endpointRepo, cacheRepo, log)
@@ -315,11 +355,21 @@ func initializeDependencies(cfg *config.Config) (*Dependencies, error) {
 	orchestrationService := service.NewOrchestrationService(
 		orchestrationRepo,
 		comparisonRepo,
+		transitionHistoryRepo,
 		httpClient,
 		log,
 		metricsCollector,
 	)
 
+	// SHADOW 모드 백그라운드 워커 풀
+	shadowPool := service.NewShadowPool(service.ShadowPoolConfig{
+		Workers:        cfg.Orchestration.Shadow.Workers,
+		QueueSize:      cfg.Orchestration.Shadow.QueueSize,
+		DropPolicy:     service.ShadowDropPolicy(cfg.Orchestration.Shadow.DropPolicy),
+		EnqueueTimeout: cfg.Orchestration.Shadow.EnqueueTimeout,
+		JobTimeout:     cfg.Orchestration.Shadow.JobTimeout,
+	}, log, metricsCollector)
+
 	bridgeService := service.NewBridgeService(
 		routingRepo,
 		endpointRepo,
@@ -330,8 +380,38 @@ func initializeDependencies(cfg *config.Config) (*Dependencies, error) {
 		cacheRepo,
 		log,
 		metricsCollector,
+		service.WithShadowPool(shadowPool),
+		service.WithStreaming(cfg.ExternalAPI.Streaming),
+	)
+
+	// 단계적 전환 / 자동 전환 / 자동 롤백 컨트롤러
+	transitionController := service.NewTransitionController(
+		orchestrationService,
+		orchestrationRepo,
+		comparisonRepo,
+		transitionHistoryRepo,
+		log,
+		metricsCollector,
+	)
+
+	// 비교 결과 보존 작업 (본문 제거, 일별 요약 후 삭제)
+	retentionJob := service.NewComparisonRetentionJob(
+		comparisonRepo,
+		service.ComparisonRetentionConfig{
+			BodyRetention: cfg.Orchestration.Retention.BodyRetention,
+			MaxAge:        cfg.Orchestration.Retention.MaxAge,
+		},
+		log,
+		metricsCollector,
 	)
 
+	// 라우트 인덱스 초기 구성 (실패 시 첫 요청에서 재시도)
+	if err := bridgeService.RefreshRoutingRules(context.Background()); err != nil {
+		log.Warn(fmt.Sprintf("Failed to build route index: %v", err))
+	} else {
+		log.Info("✅ Route index initialized")
+	}
+
 	return &Dependencies{
 		Logger:               log,
 		Metrics:              metricsCollector,
@@ -348,6 +428,10 @@ func initializeDependencies(cfg *config.Config) (*Dependencies, error) {
 		RoutingService:       routingService,
 		OrchestrationService: orchestrationService,
 		RedisClient:          redisClient,
+		TransitionRepo:       transitionHistoryRepo,
+		ShadowPool:           shadowPool,
+		TransitionController: transitionController,
+		RetentionJob:         retentionJob,
 	}, nil
 }
 
@@ -388,6 +472,7 @@ func setupRoutes(router *gin.Engine, handler *httpadapter.Handler) {
 		abs.GET("/v1/routing-rules/:id", handler.GetRoutingRule)
 		abs.PUT("/v1/routing-rules/:id", handler.UpdateRoutingRule)
 		abs.DELETE("/v1/routing-rules/:id", handler.DeleteRoutingRule)
+		abs.GET("/v1/routing-rules/:id/comparison-insights", handler.GetComparisonInsights)
 
 		// OrchestrationRule CRUD
 		abs.POST("/v1/orchestration-rules", handler.CreateOrchestrationRule)
@@ -397,6 +482,18 @@ func setupRoutes(router *gin.Engine, handler *httpadapter.Handler) {
 		// OrchestrationRule 전환 관련
 		abs.GET("/v1/orchestration-rules/:id/evaluate-transition", handler.EvaluateTransition)
 		abs.POST("/v1/orchestration-rules/:id/execute-transition", handler.ExecuteTransition)
+		abs.GET("/v1/orchestration-rules/:id/transitions", handler.GetOrchestrationTransitions)
+
+		// 비교 결과 조회 (차이점 트리, unified diff, 좌우 비교 HTML)
+		abs.GET("/v1/comparisons/:id", handler.GetComparison)
+
+		// Circuit Breaker 관리 (상태 조회, 리셋, 유지보수용 수동 고정, 상태 전환 이벤트 스트림)
+		abs.GET("/v1/circuit-breakers", handler.ListCircuitBreakers)
+		abs.GET("/v1/circuit-breakers/events", handler.StreamCircuitBreakerEvents)
+		abs.GET("/v1/circuit-breakers/:name", handler.GetCircuitBreaker)
+		abs.POST("/v1/circuit-breakers/:name/reset", handler.ResetCircuitBreaker)
+		abs.PUT("/v1/circuit-breakers/:name/override", handler.SetCircuitBreakerOverride)
+		abs.DELETE("/v1/circuit-breakers/:name/override", handler.ClearCircuitBreakerOverride)
 	}
 
 	// === API Bridge - 모든 외부 요청 처리 (반드시 마지막에 등록!) ===
@@ -404,8 +501,82 @@ func setupRoutes(router *gin.Engine, handler *httpadapter.Handler) {
 	router.NoRoute(handler.ProcessBridgeRequest)
 }
 
+// runRouteIndexRefresher는 주기적으로 라우트 인덱스를 재구성합니다.
+func runRouteIndexRefresher(ctx context.Context, bridgeService port.BridgeService, interval time.Duration, log port.Logger) {
+	if interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := bridgeService.RefreshRoutingRules(ctx); err != nil {
+				log.Warn(fmt.Sprintf("Failed to refresh route index: %v", err))
+			}
+		}
+	}
+}
+
+// runTransitionController는 주기적으로 단계적 전환, 자동 전환, 자동 롤백을 평가합니다.
+func runTransitionController(ctx context.Context, controller *service.TransitionController, interval time.Duration, log port.Logger) {
+	if interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := controller.EvaluateAll(ctx); err != nil {
+				log.Warn(fmt.Sprintf("Failed to evaluate orchestration transitions: %v", err))
+			}
+		}
+	}
+}
+
+// runComparisonRetention은 주기적으로 비교 결과 보존 정책을 적용합니다.
+func runComparisonRetention(ctx context.Context, job *service.ComparisonRetentionJob, interval time.Duration, log port.Logger) {
+	if interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := job.Run(ctx); err != nil {
+				log.Warn(fmt.Sprintf("Failed to apply comparison retention: %v", err))
+			}
+		}
+	}
+}
+
 // cleanup은 리소스를 정리합니다.
 func cleanup(deps *Dependencies) {
+	// 섀도우 워커 풀 정리 (대기 중인 비교 결과 저장을 위해 저장소보다 먼저 종료)
+	if deps.ShadowPool != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		if err := deps.ShadowPool.Close(ctx); err != nil {
+			fmt.Printf("Failed to drain shadow pool: %v\n", err)
+		} else {
+			fmt.Println("✅ Shadow pool drained")
+		}
+		cancel()
+	}
+
 	// 캐시 리포지토리 정리 (Ristretto의 경우 Close 호출 필요)
 	// ristrettoAdapter가 아닌 인터페이스를 통한 Close 메서드 확인
 	type cacheCloser interface {
@@ -0,0 +1,9 @@
+-- +migrate Up
+-- 라우팅 규칙에 업스트림 경로 재작성 템플릿 컬럼 추가
+ALTER TABLE routing_rules ADD rewrite_template VARCHAR2(500);
+
+-- 코멘트 추가
+COMMENT ON COLUMN routing_rules.rewrite_template IS '업스트림 경로 템플릿 (예: /v2/customers/{id}/orders), NULL이면 요청 경로 그대로 전달';
+
+-- +migrate Down
+ALTER TABLE routing_rules DROP COLUMN rewrite_template;
@@ -0,0 +1,31 @@
+-- +migrate Up
+-- 오케스트레이션 모드 전환 이력 테이블 생성
+-- 변경 관리 감사 기록이므로 규칙이 삭제되어도 이력은 유지합니다 (외래 키 없음)
+CREATE TABLE orchestration_transition_events (
+    id VARCHAR2(64) PRIMARY KEY,
+    rule_id VARCHAR2(36) NOT NULL,
+    from_mode VARCHAR2(20) NOT NULL,
+    to_mode VARCHAR2(20) NOT NULL,
+    from_percentage NUMBER(3) DEFAULT 0,
+    to_percentage NUMBER(3) DEFAULT 0,
+    trigger_type VARCHAR2(20) NOT NULL,
+    match_rate NUMBER(5,4) DEFAULT 0,
+    error_rate NUMBER(5,4) DEFAULT 0,
+    sample_size NUMBER(10) DEFAULT 0,
+    reason VARCHAR2(1000),
+    actor VARCHAR2(100),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_ote_trigger CHECK (trigger_type IN ('auto', 'manual', 'rollback'))
+);
+
+-- 인덱스 생성
+CREATE INDEX idx_ote_rule_created ON orchestration_transition_events(rule_id, created_at);
+
+-- 코멘트 추가
+COMMENT ON TABLE orchestration_transition_events IS '오케스트레이션 모드 전환 이력 (변경 관리 감사 기록)';
+COMMENT ON COLUMN orchestration_transition_events.trigger_type IS '전환 주체 (auto: 자동 전환, manual: 관리 API, rollback: 자동 롤백)';
+COMMENT ON COLUMN orchestration_transition_events.match_rate IS '전환 시점의 평균 일치율 스냅샷';
+COMMENT ON COLUMN orchestration_transition_events.actor IS '전환을 실행한 사용자 또는 시스템';
+
+-- +migrate Down
+DROP TABLE orchestration_transition_events CASCADE CONSTRAINTS;
@@ -0,0 +1,25 @@
+-- +migrate Up
+-- 비교 결과 일별 요약 테이블 생성
+-- 보존 기간이 지나 삭제한 비교 결과를 규칙별, 일별로 합산해 전환 판단용 통계를 유지합니다
+-- 규칙이 삭제되어도 이력은 유지합니다 (외래 키 없음)
+CREATE TABLE comparison_daily_rollups (
+    routing_rule_id VARCHAR2(36) NOT NULL,
+    rollup_date DATE NOT NULL,
+    total_comparisons NUMBER(12) DEFAULT 0,
+    successful_matches NUMBER(12) DEFAULT 0,
+    match_rate_sum NUMBER(16,4) DEFAULT 0,
+    modern_errors NUMBER(12) DEFAULT 0,
+    modern_responses NUMBER(12) DEFAULT 0,
+    modern_latency_ms_sum NUMBER(20,3) DEFAULT 0,
+    last_comparison TIMESTAMP,
+    CONSTRAINT pk_comparison_daily_rollups PRIMARY KEY (routing_rule_id, rollup_date)
+);
+
+-- 코멘트 추가
+COMMENT ON TABLE comparison_daily_rollups IS '보존 기간이 지난 비교 결과의 규칙별 일별 요약';
+COMMENT ON COLUMN comparison_daily_rollups.rollup_date IS '집계 일자 (자정 기준)';
+COMMENT ON COLUMN comparison_daily_rollups.match_rate_sum IS '일치율 합 (평균 = match_rate_sum / total_comparisons)';
+COMMENT ON COLUMN comparison_daily_rollups.modern_latency_ms_sum IS '모던 API 응답 시간 합 (밀리초, 평균 = modern_latency_ms_sum / modern_responses)';
+
+-- +migrate Down
+DROP TABLE comparison_daily_rollups CASCADE CONSTRAINTS;
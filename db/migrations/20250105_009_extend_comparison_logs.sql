@@ -0,0 +1,49 @@
+-- +migrate Up
+-- comparison_logs 비교 결과 컬럼 확장
+-- is_matched만으로는 전환 판단에 쓰는 일치율이 저장되지 않으므로 일치율, 양쪽 상태 코드/응답 시간,
+-- 오케스트레이션 규칙 ID를 컬럼으로 저장합니다 (응답 원문은 old_response/new_response에 JSON으로 유지)
+ALTER TABLE comparison_logs ADD (
+    orchestration_rule_id VARCHAR2(36),
+    match_rate BINARY_DOUBLE,
+    strict_mode NUMBER(1) DEFAULT 0,
+    legacy_status_code NUMBER(3),
+    modern_status_code NUMBER(3),
+    legacy_latency_ms NUMBER(12,3),
+    modern_latency_ms NUMBER(12,3),
+    comparison_duration_ms NUMBER(12,3),
+    CONSTRAINT chk_cmp_match_rate CHECK (match_rate BETWEEN 0 AND 1),
+    CONSTRAINT chk_cmp_strict_mode CHECK (strict_mode IN (0, 1))
+);
+
+-- 비교 결과는 설정 파일 기반 라우팅 규칙에서도 생성되고 보존 정책으로 따로 정리하므로
+-- routing_rules 외래 키를 제거합니다 (comparison_daily_rollups와 동일)
+ALTER TABLE comparison_logs DROP CONSTRAINT fk_cmp_routing;
+
+-- 기존 행은 일치 여부로 일치율을 채움 (일치 1.0, 불일치 0.0)
+UPDATE comparison_logs SET match_rate = is_matched WHERE match_rate IS NULL;
+
+-- 인덱스 생성 (규칙별 기간 통계, 오케스트레이션 규칙별 조회)
+CREATE INDEX idx_cmp_routing_created ON comparison_logs(routing_rule_id, created_at);
+CREATE INDEX idx_cmp_orchestration ON comparison_logs(orchestration_rule_id);
+
+-- 코멘트 추가
+COMMENT ON COLUMN comparison_logs.match_rate IS '가중 일치율 (0.0 ~ 1.0)';
+COMMENT ON COLUMN comparison_logs.is_matched IS '비교 성공 여부 (일치율 기준과 엄격 모드를 반영한 판정)';
+COMMENT ON COLUMN comparison_logs.legacy_status_code IS '레거시 응답 상태 코드 (호출 실패 시 NULL)';
+COMMENT ON COLUMN comparison_logs.modern_status_code IS '모던 응답 상태 코드 (호출 실패 시 NULL)';
+COMMENT ON COLUMN comparison_logs.legacy_latency_ms IS '레거시 API 응답 시간 (밀리초)';
+COMMENT ON COLUMN comparison_logs.modern_latency_ms IS '모던 API 응답 시간 (밀리초)';
+COMMENT ON COLUMN comparison_logs.orchestration_rule_id IS '비교를 수행한 오케스트레이션 규칙 ID';
+
+-- +migrate Down
+DROP INDEX idx_cmp_orchestration;
+DROP INDEX idx_cmp_routing_created;
+ALTER TABLE comparison_logs ADD CONSTRAINT fk_cmp_routing FOREIGN KEY (routing_rule_id)
+    REFERENCES routing_rules(id) ON DELETE CASCADE ENABLE NOVALIDATE;
+ALTER TABLE comparison_logs DROP CONSTRAINT chk_cmp_strict_mode;
+ALTER TABLE comparison_logs DROP CONSTRAINT chk_cmp_match_rate;
+ALTER TABLE comparison_logs DROP (
+    orchestration_rule_id, match_rate, strict_mode,
+    legacy_status_code, modern_status_code,
+    legacy_latency_ms, modern_latency_ms, comparison_duration_ms
+);
@@ -0,0 +1,21 @@
+-- +migrate Up
+-- 라우팅 규칙에 쓰기 메서드 미러링 안전 정책 컬럼 추가
+-- PARALLEL / SHADOW 등에서 POST, PUT, PATCH, DELETE를 양쪽 백엔드로 보내면 쓰기가 중복되므로
+-- 규칙이 명시적으로 허용한 경우에만 미러링합니다 (기본값 0: 미러링하지 않음)
+ALTER TABLE routing_rules ADD (
+    mirror_allow_unsafe_methods NUMBER(1) DEFAULT 0 NOT NULL,
+    mirror_dry_run_endpoint_id VARCHAR2(100),
+    mirror_dry_run_header NUMBER(1) DEFAULT 0 NOT NULL,
+    CONSTRAINT chk_rr_mirror_allow CHECK (mirror_allow_unsafe_methods IN (0, 1)),
+    CONSTRAINT chk_rr_mirror_header CHECK (mirror_dry_run_header IN (0, 1))
+);
+
+-- 코멘트 추가
+COMMENT ON COLUMN routing_rules.mirror_allow_unsafe_methods IS '안전하지 않은 메서드(GET, HEAD, OPTIONS, TRACE 외의 쓰기 메서드) 미러링 허용 여부';
+COMMENT ON COLUMN routing_rules.mirror_dry_run_endpoint_id IS '쓰기 요청의 모던 미러 호출을 보낼 샌드박스 엔드포인트 ID, NULL이면 모던 엔드포인트';
+COMMENT ON COLUMN routing_rules.mirror_dry_run_header IS '쓰기 요청의 미러 호출에 X-Bridge-Dry-Run: true 헤더 추가 여부';
+
+-- +migrate Down
+ALTER TABLE routing_rules DROP CONSTRAINT chk_rr_mirror_header;
+ALTER TABLE routing_rules DROP CONSTRAINT chk_rr_mirror_allow;
+ALTER TABLE routing_rules DROP (mirror_allow_unsafe_methods, mirror_dry_run_endpoint_id, mirror_dry_run_header);
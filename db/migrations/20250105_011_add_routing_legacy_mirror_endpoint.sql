@@ -0,0 +1,13 @@
+-- +migrate Up
+-- 라우팅 규칙에 쓰기 요청의 레거시 비교 호출용 샌드박스 엔드포인트 컬럼 추가
+-- CANARY 모던 그룹과 MODERN_ONLY 롤백 샘플링의 레거시 비교 호출이 운영 레거시에 쓰기를 반영하지 않도록
+-- 쓰기 요청은 이 엔드포인트가 있을 때만 비교합니다 (NULL: 비교하지 않음)
+ALTER TABLE routing_rules ADD (
+    mirror_legacy_dry_run_endpoint_id VARCHAR2(100)
+);
+
+-- 코멘트 추가
+COMMENT ON COLUMN routing_rules.mirror_legacy_dry_run_endpoint_id IS '쓰기 요청의 레거시 비교 호출을 보낼 샌드박스 엔드포인트 ID, NULL이면 쓰기 요청은 레거시 비교 안 함';
+
+-- +migrate Down
+ALTER TABLE routing_rules DROP (mirror_legacy_dry_run_endpoint_id);
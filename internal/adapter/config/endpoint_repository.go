@@ -73,6 +73,11 @@ func convertToEndpoint(key string, cfg *config.EndpointConfig) (*domain.APIEndpo
 		return nil, fmt.Errorf("endpoint base_url is required")
 	}
 
+	breakerPolicy := CircuitBreakerPolicy(&cfg.CircuitBreaker)
+	if err := breakerPolicy.Validate(); err != nil {
+		return nil, err
+	}
+
 	now := time.Now()
 
 	endpoint := &domain.APIEndpoint{
@@ -83,6 +88,15 @@ func convertToEndpoint(key string, cfg *config.EndpointConfig) (*domain.APIEndpo
 		HealthURL:   cfg.HealthURL,
 		Timeout:     cfg.Timeout,     // Timeout 추가
 		RetryCount:  cfg.RetryConfig.MaxAttempts - 1, // MaxAttempts는 초기 시도 포함이므로 -1
+		RetryPolicy: domain.RetryPolicy{
+			MaxAttempts:        cfg.RetryConfig.MaxAttempts,
+			InitialDelay:       cfg.RetryConfig.InitialDelay,
+			MaxDelay:           cfg.RetryConfig.MaxDelay,
+			BackoffMultiplier:  cfg.RetryConfig.BackoffMultiplier,
+			RetryableErrors:    cfg.RetryConfig.RetryableErrors,
+			RetryableHTTPCodes: cfg.RetryConfig.RetryableHTTPCodes,
+		},
+		CircuitBreakerPolicy: breakerPolicy,
 		IsActive:    cfg.IsActive,
 		IsLegacy:    cfg.IsLegacy,
 		IsDefault:   cfg.IsDefault,
@@ -93,6 +107,24 @@ func convertToEndpoint(key string, cfg *config.EndpointConfig) (*domain.APIEndpo
 	return endpoint, nil
 }
 
+// CircuitBreakerPolicy : Circuit Breaker 설정을 도메인 정책으로 변환합니다.
+//
+// 전역 설정(circuit_breaker)과 엔드포인트별 설정(endpoints.*.circuit_breaker)에 함께 사용합니다.
+// 값이 없는 항목은 그대로 두어 domain.CircuitBreakerPolicy.WithDefaults로 채웁니다.
+func CircuitBreakerPolicy(cfg *config.CircuitBreakerConfig) domain.CircuitBreakerPolicy {
+	return domain.CircuitBreakerPolicy{
+		Strategy:            domain.CircuitBreakerStrategy(cfg.Strategy),
+		MaxRequests:         cfg.MaxRequests,
+		Interval:            cfg.Interval,
+		Timeout:             cfg.Timeout,
+		ConsecutiveFailures: cfg.ConsecutiveFailures,
+		MinRequests:         cfg.MinRequests,
+		FailureRatio:        cfg.FailureRatio,
+		SlowCallRatio:       cfg.SlowCallRatio,
+		SlowCallThreshold:   cfg.SlowCallThreshold,
+	}
+}
+
 // FindByID : ID로 엔드포인트를 조회합니다.
 //
 // 메모리에서 조회하므로 매우 빠릅니다 (O(1), ~나노초 수준).
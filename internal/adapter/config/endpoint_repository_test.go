@@ -2,6 +2,7 @@ package config
 
 import (
 	"context"
+	"demo-api-bridge/internal/core/domain"
 	"demo-api-bridge/pkg/config"
 	"testing"
 	"time"
@@ -305,3 +306,67 @@ func TestConfigEndpointRepository_ConcurrentAccess(t *testing.T) {
 		<-done
 	}
 }
+
+func TestConvertToEndpoint_RetryPolicy(t *testing.T) {
+	cfg := &config.EndpointConfig{
+		ID:      "test-endpoint",
+		BaseURL: "https://test.example.com",
+		RetryConfig: config.RetryConfig{
+			MaxAttempts:        4,
+			InitialDelay:       200 * time.Millisecond,
+			MaxDelay:           2 * time.Second,
+			BackoffMultiplier:  3.0,
+			RetryableErrors:    []string{"timeout"},
+			RetryableHTTPCodes: []int{503},
+		},
+	}
+
+	endpoint, err := convertToEndpoint("test", cfg)
+	if err != nil {
+		t.Fatalf("convertToEndpoint() error = %v", err)
+	}
+
+	policy := endpoint.EffectiveRetryPolicy()
+	if policy.MaxAttempts != 4 || endpoint.RetryCount != 3 {
+		t.Errorf("expected 4 attempts (3 retries), got %d attempts (%d retries)", policy.MaxAttempts, endpoint.RetryCount)
+	}
+	if policy.InitialDelay != 200*time.Millisecond || policy.MaxDelay != 2*time.Second || policy.BackoffMultiplier != 3.0 {
+		t.Errorf("unexpected backoff settings: %+v", policy)
+	}
+	if !policy.ShouldRetryStatus("GET", 503) || policy.ShouldRetryStatus("GET", 500) {
+		t.Errorf("unexpected retryable status codes: %v", policy.RetryableHTTPCodes)
+	}
+	if !policy.ShouldRetryError("GET", "timeout") || policy.ShouldRetryError("GET", "connection_refused") {
+		t.Errorf("unexpected retryable errors: %v", policy.RetryableErrors)
+	}
+}
+
+func TestConvertToEndpoint_CircuitBreakerPolicy(t *testing.T) {
+	cfg := &config.EndpointConfig{
+		ID:      "test-endpoint",
+		BaseURL: "https://test.example.com",
+		CircuitBreaker: config.CircuitBreakerConfig{
+			Strategy:     "failure_ratio",
+			MinRequests:  20,
+			FailureRatio: 0.3,
+		},
+	}
+
+	endpoint, err := convertToEndpoint("test", cfg)
+	if err != nil {
+		t.Fatalf("convertToEndpoint() error = %v", err)
+	}
+
+	policy := endpoint.CircuitBreakerPolicy
+	if policy.Strategy != domain.TripFailureRatio || policy.MinRequests != 20 || policy.FailureRatio != 0.3 {
+		t.Errorf("unexpected circuit breaker policy: %+v", policy)
+	}
+	if policy.Timeout != 0 {
+		t.Errorf("expected unset timeout to inherit global policy, got %s", policy.Timeout)
+	}
+
+	cfg.CircuitBreaker.Strategy = "unknown"
+	if _, err := convertToEndpoint("test", cfg); err == nil {
+		t.Error("expected error for unknown circuit breaker strategy")
+	}
+}
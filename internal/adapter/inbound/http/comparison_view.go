@@ -0,0 +1,88 @@
+package http
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"time"
+
+	"demo-api-bridge/internal/core/domain"
+)
+
+// comparisonPageTemplate은 비교 결과를 좌우로 나란히 보여주는 HTML 화면입니다.
+// QA가 DB 접근 없이 브라우저에서 차이를 확인할 수 있도록 외부 리소스 없이 단일 페이지로 구성합니다.
+var comparisonPageTemplate = template.Must(template.New("comparison").Funcs(template.FuncMap{
+	"percent":   func(rate float64) string { return fmt.Sprintf("%.2f%%", rate*100) },
+	"timestamp": func(t time.Time) string { return t.Format(time.RFC3339) },
+	"lineNo": func(n int) string {
+		if n == 0 {
+			return ""
+		}
+		return fmt.Sprint(n)
+	},
+}).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Comparison {{.Detail.ID}}</title>
+<style>
+body { font-family: sans-serif; margin: 16px; }
+table { border-collapse: collapse; width: 100%; }
+th, td { border: 1px solid #ddd; padding: 2px 6px; vertical-align: top; }
+td.code { font-family: monospace; white-space: pre-wrap; word-break: break-all; width: 48%; }
+td.num { color: #888; text-align: right; font-family: monospace; }
+tr.changed td.code, tr.removed td.legacy { background: #ffecec; }
+tr.changed td.modern, tr.added td.modern { background: #eaffea; }
+.summary td { border: none; padding: 2px 12px 2px 0; }
+</style>
+</head>
+<body>
+<h1>Comparison {{.Detail.ID}}</h1>
+<table class="summary">
+<tr><td>Request ID</td><td>{{.Detail.RequestID}}</td></tr>
+<tr><td>Routing rule</td><td>{{.Detail.RoutingRuleID}}</td></tr>
+<tr><td>Match rate</td><td>{{percent .Detail.MatchRate}}</td></tr>
+<tr><td>Successful</td><td>{{.Detail.Successful}}</td></tr>
+<tr><td>Created at</td><td>{{timestamp .Detail.CreatedAt}}</td></tr>
+</table>
+
+<h2>Differences ({{len .Detail.Differences}})</h2>
+{{if .Detail.Differences}}
+<table>
+<tr><th>Dimension</th><th>Type</th><th>Path</th><th>Legacy</th><th>Modern</th><th>Message</th></tr>
+{{range .Detail.Differences}}
+<tr><td>{{.Dimension}}</td><td>{{.Type}}</td><td>{{.Path}}</td><td>{{.LegacyValue}}</td><td>{{.ModernValue}}</td><td>{{.Message}}</td></tr>
+{{end}}
+</table>
+{{else}}
+<p>No differences.</p>
+{{end}}
+
+<h2>Side by side</h2>
+<table>
+<tr><th></th><th>Legacy</th><th></th><th>Modern</th></tr>
+{{range .Rows}}
+<tr class="{{.Op}}"><td class="num">{{lineNo .LegacyLine}}</td><td class="code legacy">{{.LegacyText}}</td><td class="num">{{lineNo .ModernLine}}</td><td class="code modern">{{.ModernText}}</td></tr>
+{{end}}
+</table>
+</body>
+</html>
+`))
+
+// renderComparisonHTML은 비교 결과를 좌우 비교 HTML 화면으로 렌더링합니다.
+func renderComparisonHTML(comparison *domain.APIComparison) ([]byte, error) {
+	lines := domain.DiffLines(domain.ResponseLines(comparison.LegacyResponse), domain.ResponseLines(comparison.ModernResponse))
+
+	var buf bytes.Buffer
+	err := comparisonPageTemplate.Execute(&buf, struct {
+		Detail *ComparisonDetailResponse
+		Rows   []domain.SideBySideRow
+	}{
+		Detail: ToComparisonDetailResponse(comparison),
+		Rows:   domain.SideBySide(lines),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -1,8 +1,11 @@
 package http
 
 import (
+	"encoding/base64"
 	"encoding/json"
+	"sort"
 	"time"
+	"unicode/utf8"
 
 	"demo-api-bridge/internal/core/domain"
 
@@ -79,29 +82,35 @@ func (req *UpdateEndpointRequest) ApplyTo(endpoint *domain.APIEndpoint) {
 
 // CreateRoutingRuleRequest는 라우팅 규칙 생성을 위한 요청 DTO입니다.
 type CreateRoutingRuleRequest struct {
-	Name           string             `json:"name" binding:"required"`
-	Description    string             `json:"description"`
-	PathPattern    string             `json:"path_pattern" binding:"required"`
-	Method         string             `json:"method" binding:"required"`
-	Priority       int                `json:"priority"`
-	IsActive       bool               `json:"is_active"`
-	LegacyEndpoint *EndpointReference `json:"legacy_endpoint"`
-	ModernEndpoint *EndpointReference `json:"modern_endpoint"`
-	Headers        map[string]string  `json:"headers"`
-	QueryParams    map[string]string  `json:"query_params"`
+	Name            string             `json:"name" binding:"required"`
+	Description     string             `json:"description"`
+	PathPattern     string             `json:"path_pattern" binding:"required"`
+	RewriteTemplate string             `json:"rewrite_template"`
+	Method          string             `json:"method" binding:"required"`
+	Priority        int                `json:"priority"`
+	IsActive        bool               `json:"is_active"`
+	LegacyEndpoint  *EndpointReference `json:"legacy_endpoint"`
+	ModernEndpoint  *EndpointReference `json:"modern_endpoint"`
+	Headers         map[string]string  `json:"headers"`
+	QueryParams     map[string]string  `json:"query_params"`
+	MirrorPolicy    *MirrorPolicyDTO   `json:"mirror_policy,omitempty"`
 }
 
 // ToDomain는 CreateRoutingRuleRequest를 Domain RoutingRule로 변환합니다.
 func (req *CreateRoutingRuleRequest) ToDomain() *domain.RoutingRule {
 	rule := &domain.RoutingRule{
-		Name:        req.Name,
-		Description: req.Description,
-		PathPattern: req.PathPattern,
-		Method:      req.Method,
-		Priority:    req.Priority,
-		IsActive:    req.IsActive,
-		Headers:     req.Headers,
-		QueryParams: req.QueryParams,
+		Name:            req.Name,
+		Description:     req.Description,
+		PathPattern:     req.PathPattern,
+		RewriteTemplate: req.RewriteTemplate,
+		Method:          req.Method,
+		Priority:        req.Priority,
+		IsActive:        req.IsActive,
+		Headers:         req.Headers,
+		QueryParams:     req.QueryParams,
+	}
+	if req.MirrorPolicy != nil {
+		rule.MirrorPolicy = req.MirrorPolicy.ToDomain()
 	}
 
 	if req.LegacyEndpoint != nil {
@@ -118,16 +127,18 @@ func (req *CreateRoutingRuleRequest) ToDomain() *domain.RoutingRule {
 
 // UpdateRoutingRuleRequest는 라우팅 규칙 업데이트를 위한 요청 DTO입니다.
 type UpdateRoutingRuleRequest struct {
-	Name           *string            `json:"name,omitempty"`
-	Description    *string            `json:"description,omitempty"`
-	PathPattern    *string            `json:"path_pattern,omitempty"`
-	Method         *string            `json:"method,omitempty"`
-	Priority       *int               `json:"priority,omitempty"`
-	IsActive       *bool              `json:"is_active,omitempty"`
-	LegacyEndpoint *EndpointReference `json:"legacy_endpoint,omitempty"`
-	ModernEndpoint *EndpointReference `json:"modern_endpoint,omitempty"`
-	Headers        map[string]string  `json:"headers,omitempty"`
-	QueryParams    map[string]string  `json:"query_params,omitempty"`
+	Name            *string            `json:"name,omitempty"`
+	Description     *string            `json:"description,omitempty"`
+	PathPattern     *string            `json:"path_pattern,omitempty"`
+	RewriteTemplate *string            `json:"rewrite_template,omitempty"`
+	Method          *string            `json:"method,omitempty"`
+	Priority        *int               `json:"priority,omitempty"`
+	IsActive        *bool              `json:"is_active,omitempty"`
+	LegacyEndpoint  *EndpointReference `json:"legacy_endpoint,omitempty"`
+	ModernEndpoint  *EndpointReference `json:"modern_endpoint,omitempty"`
+	Headers         map[string]string  `json:"headers,omitempty"`
+	QueryParams     map[string]string  `json:"query_params,omitempty"`
+	MirrorPolicy    *MirrorPolicyDTO   `json:"mirror_policy,omitempty"`
 }
 
 // ApplyTo는 UpdateRoutingRuleRequest의 값을 Domain RoutingRule에 적용합니다.
@@ -141,6 +152,9 @@ func (req *UpdateRoutingRuleRequest) ApplyTo(rule *domain.RoutingRule) {
 	if req.PathPattern != nil {
 		rule.PathPattern = *req.PathPattern
 	}
+	if req.RewriteTemplate != nil {
+		rule.RewriteTemplate = *req.RewriteTemplate
+	}
 	if req.Method != nil {
 		rule.Method = *req.Method
 	}
@@ -162,6 +176,37 @@ func (req *UpdateRoutingRuleRequest) ApplyTo(rule *domain.RoutingRule) {
 	if req.QueryParams != nil {
 		rule.QueryParams = req.QueryParams
 	}
+	if req.MirrorPolicy != nil {
+		rule.MirrorPolicy = req.MirrorPolicy.ToDomain()
+	}
+}
+
+// MirrorPolicyDTO는 쓰기 메서드 미러링 안전 정책 DTO입니다 (요청/응답 공용).
+type MirrorPolicyDTO struct {
+	AllowUnsafeMethods bool   `json:"allow_unsafe_methods"`
+	DryRunEndpointID   string `json:"dry_run_endpoint_id,omitempty"`
+	DryRunHeader       bool   `json:"dry_run_header"`
+
+	LegacyDryRunEndpointID string `json:"legacy_dry_run_endpoint_id,omitempty"`
+}
+
+// ToDomain는 MirrorPolicyDTO를 Domain MirrorPolicy로 변환합니다.
+func (dto *MirrorPolicyDTO) ToDomain() domain.MirrorPolicy {
+	return domain.MirrorPolicy{
+		AllowUnsafeMethods: dto.AllowUnsafeMethods,
+		DryRunEndpointID:   dto.DryRunEndpointID,
+		DryRunHeader:       dto.DryRunHeader,
+
+		LegacyDryRunEndpointID: dto.LegacyDryRunEndpointID,
+	}
+}
+
+// FromDomain는 Domain MirrorPolicy를 MirrorPolicyDTO로 변환합니다.
+func (dto *MirrorPolicyDTO) FromDomain(policy domain.MirrorPolicy) {
+	dto.AllowUnsafeMethods = policy.AllowUnsafeMethods
+	dto.DryRunEndpointID = policy.DryRunEndpointID
+	dto.DryRunHeader = policy.DryRunHeader
+	dto.LegacyDryRunEndpointID = policy.LegacyDryRunEndpointID
 }
 
 // EndpointReference는 엔드포인트 참조를 위한 DTO입니다.
@@ -207,6 +252,10 @@ func (req *CreateOrchestrationRuleRequest) ToDomain() *domain.OrchestrationRule
 		rule.CurrentMode = domain.MODERN_ONLY
 	case "PARALLEL":
 		rule.CurrentMode = domain.PARALLEL
+	case "CANARY":
+		rule.CurrentMode = domain.CANARY
+	case "SHADOW":
+		rule.CurrentMode = domain.SHADOW
 	default:
 		rule.CurrentMode = domain.PARALLEL // 기본값
 	}
@@ -228,13 +277,7 @@ func (req *CreateOrchestrationRuleRequest) ToDomain() *domain.OrchestrationRule
 	if req.ComparisonConfig != nil {
 		rule.ComparisonConfig = req.ComparisonConfig.ToDomain()
 	} else {
-		rule.ComparisonConfig = domain.ComparisonConfig{
-			Enabled:               true,
-			IgnoreFields:          []string{"timestamp", "requestId"},
-			AllowableDifference:   0.01,
-			StrictMode:            false,
-			SaveComparisonHistory: true,
-		}
+		rule.ComparisonConfig = domain.DefaultComparisonConfig()
 	}
 
 	return rule
@@ -266,10 +309,17 @@ func (req *UpdateOrchestrationRuleRequest) ApplyTo(rule *domain.OrchestrationRul
 			rule.CurrentMode = domain.MODERN_ONLY
 		case "PARALLEL":
 			rule.CurrentMode = domain.PARALLEL
+		case "CANARY":
+			rule.CurrentMode = domain.CANARY
+		case "SHADOW":
+			rule.CurrentMode = domain.SHADOW
 		}
 	}
 	if req.TransitionConfig != nil {
+		// 단계적 전환 진행 상태는 설정 변경과 무관하게 유지
+		rampState := rule.TransitionConfig.RampState
 		rule.TransitionConfig = req.TransitionConfig.ToDomain()
+		rule.TransitionConfig.RampState = rampState
 	}
 	if req.ComparisonConfig != nil {
 		rule.ComparisonConfig = req.ComparisonConfig.ToDomain()
@@ -281,42 +331,207 @@ func (req *UpdateOrchestrationRuleRequest) ApplyTo(rule *domain.OrchestrationRul
 
 // TransitionConfigRequest는 전환 설정을 위한 DTO입니다.
 type TransitionConfigRequest struct {
-	AutoTransitionEnabled    bool    `json:"auto_transition_enabled"`
-	MatchRateThreshold       float64 `json:"match_rate_threshold"`
-	StabilityPeriodHours     int     `json:"stability_period_hours"`
-	MinRequestsForTransition int     `json:"min_requests_for_transition"`
-	RollbackThreshold        float64 `json:"rollback_threshold"`
+	AutoTransitionEnabled    bool                   `json:"auto_transition_enabled"`
+	MatchRateThreshold       float64                `json:"match_rate_threshold"`
+	StabilityPeriodHours     int                    `json:"stability_period_hours"`
+	StabilityWindows         int                    `json:"stability_windows,omitempty"`
+	MinSamplesPerWindow      int                    `json:"min_samples_per_window,omitempty"`
+	ConfidenceLevel          float64                `json:"confidence_level,omitempty"`
+	MinRequestsForTransition int                    `json:"min_requests_for_transition"`
+	RollbackThreshold        float64                `json:"rollback_threshold"`
+	Canary                   *CanaryConfigRequest   `json:"canary,omitempty"`
+	RampPlan                 []RampStageRequest     `json:"ramp_plan,omitempty"`
+	Rollback                 *RollbackConfigRequest `json:"rollback,omitempty"`
 }
 
 // ToDomain는 TransitionConfigRequest를 Domain TransitionConfig로 변환합니다.
 func (req *TransitionConfigRequest) ToDomain() domain.TransitionConfig {
-	return domain.TransitionConfig{
+	config := domain.TransitionConfig{
 		AutoTransitionEnabled:    req.AutoTransitionEnabled,
 		MatchRateThreshold:       req.MatchRateThreshold,
 		StabilityPeriod:          time.Duration(req.StabilityPeriodHours) * time.Hour,
+		StabilityWindows:         req.StabilityWindows,
+		MinSamplesPerWindow:      req.MinSamplesPerWindow,
+		ConfidenceLevel:          req.ConfidenceLevel,
 		MinRequestsForTransition: req.MinRequestsForTransition,
 		RollbackThreshold:        req.RollbackThreshold,
 	}
+	if req.Canary != nil {
+		config.Canary = req.Canary.ToDomain()
+	}
+	for _, stage := range req.RampPlan {
+		config.RampPlan = append(config.RampPlan, stage.ToDomain())
+	}
+	if req.Rollback != nil {
+		config.Rollback = req.Rollback.ToDomain()
+	}
+	return config
+}
+
+// RollbackConfigRequest는 MODERN_ONLY 모드 자동 롤백 설정을 위한 DTO입니다.
+type RollbackConfigRequest struct {
+	TargetMode    string  `json:"target_mode,omitempty"`
+	SampleRate    float64 `json:"sample_rate"`
+	WindowMinutes int     `json:"window_minutes,omitempty"`
+	MinSampleSize int     `json:"min_sample_size,omitempty"`
+	MaxErrorRate  float64 `json:"max_error_rate,omitempty"`
+	MaxLatencyMs  int     `json:"max_latency_ms,omitempty"`
+}
+
+// ToDomain는 RollbackConfigRequest를 Domain RollbackConfig로 변환합니다.
+func (req *RollbackConfigRequest) ToDomain() domain.RollbackConfig {
+	return domain.RollbackConfig{
+		TargetMode:    domain.APIMode(req.TargetMode),
+		SampleRate:    req.SampleRate,
+		Window:        time.Duration(req.WindowMinutes) * time.Minute,
+		MinSampleSize: req.MinSampleSize,
+		MaxErrorRate:  req.MaxErrorRate,
+		MaxLatency:    time.Duration(req.MaxLatencyMs) * time.Millisecond,
+	}
+}
+
+// RampStageRequest는 단계적 전환 계획의 단계 설정을 위한 DTO입니다.
+// 0인 기준값은 전역 전환 설정(min_requests_for_transition, match_rate_threshold, stability_period_hours)을 따릅니다.
+type RampStageRequest struct {
+	Percentage       int     `json:"percentage"`
+	MinSampleSize    int     `json:"min_sample_size,omitempty"`
+	MinMatchRate     float64 `json:"min_match_rate,omitempty"`
+	MaxErrorRate     float64 `json:"max_error_rate,omitempty"`
+	DwellTimeMinutes int     `json:"dwell_time_minutes,omitempty"`
+}
+
+// ToDomain는 RampStageRequest를 Domain RampStage로 변환합니다.
+func (req RampStageRequest) ToDomain() domain.RampStage {
+	return domain.RampStage{
+		Percentage:    req.Percentage,
+		MinSampleSize: req.MinSampleSize,
+		MinMatchRate:  req.MinMatchRate,
+		MaxErrorRate:  req.MaxErrorRate,
+		DwellTime:     time.Duration(req.DwellTimeMinutes) * time.Minute,
+	}
+}
+
+// CanaryConfigRequest는 CANARY 모드 트래픽 분배 설정을 위한 DTO입니다.
+type CanaryConfigRequest struct {
+	Percentage      int    `json:"percentage"`
+	StickyKeySource string `json:"sticky_key_source"`
+	StickyKeyName   string `json:"sticky_key_name,omitempty"`
+}
+
+// ToDomain는 CanaryConfigRequest를 Domain CanaryConfig로 변환합니다.
+func (req *CanaryConfigRequest) ToDomain() domain.CanaryConfig {
+	return domain.CanaryConfig{
+		Percentage:      req.Percentage,
+		StickyKeySource: domain.StickyKeySource(req.StickyKeySource),
+		StickyKeyName:   req.StickyKeyName,
+	}
 }
 
 // ComparisonConfigRequest는 비교 설정을 위한 DTO입니다.
+// ignore_fields와 normalizers의 경로는 JSONPath 선택자입니다 (예: $.items[*].updatedAt).
 type ComparisonConfigRequest struct {
-	Enabled               bool     `json:"enabled"`
-	IgnoreFields          []string `json:"ignore_fields"`
-	AllowableDifference   float64  `json:"allowable_difference"`
-	StrictMode            bool     `json:"strict_mode"`
-	SaveComparisonHistory bool     `json:"save_comparison_history"`
+	Enabled               bool                     `json:"enabled"`
+	IgnoreFields          []string                 `json:"ignore_fields"`
+	AllowableDifference   float64                  `json:"allowable_difference"`
+	StrictMode            bool                     `json:"strict_mode"`
+	SaveComparisonHistory bool                     `json:"save_comparison_history"`
+	Normalizers           []FieldNormalizerRequest `json:"normalizers,omitempty"`
+	ArrayRules            []ArrayRuleRequest       `json:"array_rules,omitempty"`
+	MaxArrayElements      int                      `json:"max_array_elements,omitempty"`
+	ArraySampling         string                   `json:"array_sampling,omitempty"`
+	CompareHeaders        []string                 `json:"compare_headers,omitempty"`
+	Weights               *ComparisonWeightsDTO    `json:"weights,omitempty"`
+	FieldWeights          []FieldWeightDTO         `json:"field_weights,omitempty"`
+	Sampling              *ComparisonSamplingDTO   `json:"sampling,omitempty"`
+}
+
+// ComparisonSamplingDTO는 비교 결과 저장 샘플링 정책 DTO입니다.
+type ComparisonSamplingDTO struct {
+	Rate                  float64 `json:"rate"`
+	AlwaysStoreMismatches bool    `json:"always_store_mismatches"`
+	MaxPerMinute          int     `json:"max_per_minute"`
+}
+
+// FieldWeightDTO는 경로별 본문 리프 가중치 DTO입니다.
+type FieldWeightDTO struct {
+	Path   string  `json:"path"`
+	Weight float64 `json:"weight"`
+}
+
+// ComparisonWeightsDTO는 비교 항목별 일치율 가중치 DTO입니다.
+type ComparisonWeightsDTO struct {
+	StatusCode float64 `json:"status_code"`
+	Headers    float64 `json:"headers"`
+	Body       float64 `json:"body"`
 }
 
 // ToDomain는 ComparisonConfigRequest를 Domain ComparisonConfig로 변환합니다.
 func (req *ComparisonConfigRequest) ToDomain() domain.ComparisonConfig {
-	return domain.ComparisonConfig{
+	config := domain.ComparisonConfig{
 		Enabled:               req.Enabled,
 		IgnoreFields:          req.IgnoreFields,
 		AllowableDifference:   req.AllowableDifference,
 		StrictMode:            req.StrictMode,
 		SaveComparisonHistory: req.SaveComparisonHistory,
+		MaxArrayElements:      req.MaxArrayElements,
+		ArraySampling:         domain.ArraySamplingMode(req.ArraySampling),
+		CompareHeaders:        req.CompareHeaders,
+	}
+	for _, weight := range req.FieldWeights {
+		config.FieldWeights = append(config.FieldWeights, domain.FieldWeight{Path: weight.Path, Weight: weight.Weight})
+	}
+	if req.Sampling != nil {
+		config.Sampling = domain.ComparisonSampling{
+			Rate:                  req.Sampling.Rate,
+			AlwaysStoreMismatches: req.Sampling.AlwaysStoreMismatches,
+			MaxPerMinute:          req.Sampling.MaxPerMinute,
+		}
+	}
+	if req.Weights != nil {
+		config.Weights = domain.ComparisonWeights{
+			StatusCode: req.Weights.StatusCode,
+			Headers:    req.Weights.Headers,
+			Body:       req.Weights.Body,
+		}
+	}
+	for _, normalizer := range req.Normalizers {
+		config.Normalizers = append(config.Normalizers, normalizer.ToDomain())
+	}
+	for _, rule := range req.ArrayRules {
+		config.ArrayRules = append(config.ArrayRules, rule.ToDomain())
+	}
+	return config
+}
+
+// ArrayRuleRequest는 경로별 배열 비교 방식을 위한 DTO입니다.
+type ArrayRuleRequest struct {
+	Path      string   `json:"path"`
+	Strategy  string   `json:"strategy"`
+	KeyFields []string `json:"key_fields,omitempty"`
+}
+
+// ToDomain는 ArrayRuleRequest를 Domain ArrayRule로 변환합니다.
+func (req ArrayRuleRequest) ToDomain() domain.ArrayRule {
+	return domain.ArrayRule{
+		Path:      req.Path,
+		Strategy:  domain.ArrayStrategy(req.Strategy),
+		KeyFields: req.KeyFields,
+	}
+}
+
+// FieldNormalizerRequest는 경로별 정규화 규칙을 위한 DTO입니다.
+type FieldNormalizerRequest struct {
+	Path        string   `json:"path"`
+	Normalizers []string `json:"normalizers"`
+}
+
+// ToDomain는 FieldNormalizerRequest를 Domain FieldNormalizer로 변환합니다.
+func (req FieldNormalizerRequest) ToDomain() domain.FieldNormalizer {
+	normalizer := domain.FieldNormalizer{Path: req.Path}
+	for _, n := range req.Normalizers {
+		normalizer.Normalizers = append(normalizer.Normalizers, domain.NormalizerType(n))
 	}
+	return normalizer
 }
 
 // === 응답 DTO ===
@@ -351,19 +566,21 @@ func (resp *EndpointResponse) FromDomain(endpoint *domain.APIEndpoint) {
 
 // RoutingRuleResponse는 라우팅 규칙 응답 DTO입니다.
 type RoutingRuleResponse struct {
-	ID             string             `json:"id"`
-	Name           string             `json:"name"`
-	Description    string             `json:"description"`
-	PathPattern    string             `json:"path_pattern"`
-	Method         string             `json:"method"`
-	Priority       int                `json:"priority"`
-	IsActive       bool               `json:"is_active"`
-	LegacyEndpoint *EndpointReference `json:"legacy_endpoint"`
-	ModernEndpoint *EndpointReference `json:"modern_endpoint"`
-	Headers        map[string]string  `json:"headers"`
-	QueryParams    map[string]string  `json:"query_params"`
-	CreatedAt      time.Time          `json:"created_at"`
-	UpdatedAt      time.Time          `json:"updated_at"`
+	ID              string             `json:"id"`
+	Name            string             `json:"name"`
+	Description     string             `json:"description"`
+	PathPattern     string             `json:"path_pattern"`
+	RewriteTemplate string             `json:"rewrite_template,omitempty"`
+	Method          string             `json:"method"`
+	Priority        int                `json:"priority"`
+	IsActive        bool               `json:"is_active"`
+	LegacyEndpoint  *EndpointReference `json:"legacy_endpoint"`
+	ModernEndpoint  *EndpointReference `json:"modern_endpoint"`
+	Headers         map[string]string  `json:"headers"`
+	QueryParams     map[string]string  `json:"query_params"`
+	MirrorPolicy    *MirrorPolicyDTO   `json:"mirror_policy"`
+	CreatedAt       time.Time          `json:"created_at"`
+	UpdatedAt       time.Time          `json:"updated_at"`
 }
 
 // FromDomain는 Domain RoutingRule을 RoutingRuleResponse로 변환합니다.
@@ -372,11 +589,14 @@ func (resp *RoutingRuleResponse) FromDomain(rule *domain.RoutingRule) {
 	resp.Name = rule.Name
 	resp.Description = rule.Description
 	resp.PathPattern = rule.PathPattern
+	resp.RewriteTemplate = rule.RewriteTemplate
 	resp.Method = rule.Method
 	resp.Priority = rule.Priority
 	resp.IsActive = rule.IsActive
 	resp.Headers = rule.Headers
 	resp.QueryParams = rule.QueryParams
+	resp.MirrorPolicy = &MirrorPolicyDTO{}
+	resp.MirrorPolicy.FromDomain(rule.MirrorPolicy)
 	resp.CreatedAt = rule.CreatedAt
 	resp.UpdatedAt = rule.UpdatedAt
 
@@ -519,11 +739,18 @@ func (resp *OrchestrationRuleResponse) FromDomain(rule *domain.OrchestrationRule
 
 // TransitionConfigResponse는 전환 설정 응답 DTO입니다.
 type TransitionConfigResponse struct {
-	AutoTransitionEnabled    bool    `json:"auto_transition_enabled"`
-	MatchRateThreshold       float64 `json:"match_rate_threshold"`
-	StabilityPeriodHours     int     `json:"stability_period_hours"`
-	MinRequestsForTransition int     `json:"min_requests_for_transition"`
-	RollbackThreshold        float64 `json:"rollback_threshold"`
+	AutoTransitionEnabled    bool                    `json:"auto_transition_enabled"`
+	MatchRateThreshold       float64                 `json:"match_rate_threshold"`
+	StabilityPeriodHours     int                     `json:"stability_period_hours"`
+	StabilityWindows         int                     `json:"stability_windows"`
+	MinSamplesPerWindow      int                     `json:"min_samples_per_window"`
+	ConfidenceLevel          float64                 `json:"confidence_level"`
+	MinRequestsForTransition int                     `json:"min_requests_for_transition"`
+	RollbackThreshold        float64                 `json:"rollback_threshold"`
+	Canary                   *CanaryConfigResponse   `json:"canary"`
+	RampPlan                 []RampStageResponse     `json:"ramp_plan,omitempty"`
+	RampState                *RampStateResponse      `json:"ramp_state,omitempty"`
+	Rollback                 *RollbackConfigResponse `json:"rollback"`
 }
 
 // FromDomain는 Domain TransitionConfig를 TransitionConfigResponse로 변환합니다.
@@ -531,17 +758,116 @@ func (resp *TransitionConfigResponse) FromDomain(config domain.TransitionConfig)
 	resp.AutoTransitionEnabled = config.AutoTransitionEnabled
 	resp.MatchRateThreshold = config.MatchRateThreshold
 	resp.StabilityPeriodHours = int(config.StabilityPeriod.Hours())
+	resp.StabilityWindows = config.EffectiveStabilityWindows()
+	resp.MinSamplesPerWindow = config.EffectiveMinSamplesPerWindow()
+	resp.ConfidenceLevel = config.EffectiveConfidenceLevel()
 	resp.MinRequestsForTransition = config.MinRequestsForTransition
 	resp.RollbackThreshold = config.RollbackThreshold
+
+	resp.Canary = &CanaryConfigResponse{}
+	resp.Canary.FromDomain(config.Canary)
+
+	resp.Rollback = &RollbackConfigResponse{}
+	resp.Rollback.FromDomain(config.Rollback)
+
+	if len(config.RampPlan) > 0 {
+		resp.RampPlan = make([]RampStageResponse, len(config.RampPlan))
+		for i, stage := range config.RampPlan {
+			resp.RampPlan[i].FromDomain(stage)
+		}
+		resp.RampState = &RampStateResponse{
+			StageIndex:     config.RampState.StageIndex,
+			StageStartedAt: config.RampState.StageStartedAt,
+		}
+	}
+}
+
+// RollbackConfigResponse는 MODERN_ONLY 모드 자동 롤백 설정 응답 DTO입니다.
+type RollbackConfigResponse struct {
+	TargetMode    string  `json:"target_mode"`
+	SampleRate    float64 `json:"sample_rate"`
+	WindowMinutes int     `json:"window_minutes"`
+	MinSampleSize int     `json:"min_sample_size"`
+	MaxErrorRate  float64 `json:"max_error_rate"`
+	MaxLatencyMs  int     `json:"max_latency_ms"`
+}
+
+// FromDomain는 Domain RollbackConfig를 RollbackConfigResponse로 변환합니다.
+func (resp *RollbackConfigResponse) FromDomain(config domain.RollbackConfig) {
+	resp.TargetMode = string(config.RollbackTarget())
+	resp.SampleRate = config.SampleRate
+	resp.WindowMinutes = int(config.ObservationWindow().Minutes())
+	resp.MinSampleSize = config.MinSampleSize
+	resp.MaxErrorRate = config.MaxErrorRate
+	resp.MaxLatencyMs = int(config.MaxLatency.Milliseconds())
+}
+
+// RampStageResponse는 단계적 전환 계획의 단계 응답 DTO입니다.
+type RampStageResponse struct {
+	Percentage       int     `json:"percentage"`
+	MinSampleSize    int     `json:"min_sample_size"`
+	MinMatchRate     float64 `json:"min_match_rate"`
+	MaxErrorRate     float64 `json:"max_error_rate"`
+	DwellTimeMinutes int     `json:"dwell_time_minutes"`
+}
+
+// FromDomain는 Domain RampStage를 RampStageResponse로 변환합니다.
+func (resp *RampStageResponse) FromDomain(stage domain.RampStage) {
+	resp.Percentage = stage.Percentage
+	resp.MinSampleSize = stage.MinSampleSize
+	resp.MinMatchRate = stage.MinMatchRate
+	resp.MaxErrorRate = stage.MaxErrorRate
+	resp.DwellTimeMinutes = int(stage.DwellTime.Minutes())
+}
+
+// RampStateResponse는 단계적 전환 진행 상태 응답 DTO입니다.
+type RampStateResponse struct {
+	StageIndex     int       `json:"stage_index"`
+	StageStartedAt time.Time `json:"stage_started_at"`
+}
+
+// CanaryConfigResponse는 CANARY 모드 트래픽 분배 설정 응답 DTO입니다.
+type CanaryConfigResponse struct {
+	Percentage      int    `json:"percentage"`
+	StickyKeySource string `json:"sticky_key_source"`
+	StickyKeyName   string `json:"sticky_key_name,omitempty"`
+}
+
+// FromDomain는 Domain CanaryConfig를 CanaryConfigResponse로 변환합니다.
+func (resp *CanaryConfigResponse) FromDomain(config domain.CanaryConfig) {
+	resp.Percentage = config.Percentage
+	resp.StickyKeySource = string(config.StickyKeySource)
+	resp.StickyKeyName = config.StickyKeyName
 }
 
 // ComparisonConfigResponse는 비교 설정 응답 DTO입니다.
 type ComparisonConfigResponse struct {
-	Enabled               bool     `json:"enabled"`
-	IgnoreFields          []string `json:"ignore_fields"`
-	AllowableDifference   float64  `json:"allowable_difference"`
-	StrictMode            bool     `json:"strict_mode"`
-	SaveComparisonHistory bool     `json:"save_comparison_history"`
+	Enabled               bool                      `json:"enabled"`
+	IgnoreFields          []string                  `json:"ignore_fields"`
+	AllowableDifference   float64                   `json:"allowable_difference"`
+	StrictMode            bool                      `json:"strict_mode"`
+	SaveComparisonHistory bool                      `json:"save_comparison_history"`
+	Normalizers           []FieldNormalizerResponse `json:"normalizers"`
+	ArrayRules            []ArrayRuleResponse       `json:"array_rules"`
+	MaxArrayElements      int                       `json:"max_array_elements"`
+	ArraySampling         string                    `json:"array_sampling"`
+	CompareHeaders        []string                  `json:"compare_headers"`
+	Weights               ComparisonWeightsDTO      `json:"weights"`
+	FieldWeights          []FieldWeightDTO          `json:"field_weights"`
+	Sampling              ComparisonSamplingDTO     `json:"sampling"`
+}
+
+// ArrayRuleResponse는 경로별 배열 비교 방식 응답 DTO입니다.
+type ArrayRuleResponse struct {
+	Path      string   `json:"path"`
+	Strategy  string   `json:"strategy"`
+	KeyFields []string `json:"key_fields,omitempty"`
+}
+
+// FieldNormalizerResponse는 경로별 정규화 규칙 응답 DTO입니다.
+type FieldNormalizerResponse struct {
+	Path        string   `json:"path"`
+	Normalizers []string `json:"normalizers"`
 }
 
 // FromDomain는 Domain ComparisonConfig를 ComparisonConfigResponse로 변환합니다.
@@ -551,6 +877,52 @@ func (resp *ComparisonConfigResponse) FromDomain(config domain.ComparisonConfig)
 	resp.AllowableDifference = config.AllowableDifference
 	resp.StrictMode = config.StrictMode
 	resp.SaveComparisonHistory = config.SaveComparisonHistory
+	resp.Normalizers = make([]FieldNormalizerResponse, 0, len(config.Normalizers))
+	for _, normalizer := range config.Normalizers {
+		item := FieldNormalizerResponse{Path: normalizer.Path}
+		for _, n := range normalizer.Normalizers {
+			item.Normalizers = append(item.Normalizers, string(n))
+		}
+		resp.Normalizers = append(resp.Normalizers, item)
+	}
+	resp.ArrayRules = make([]ArrayRuleResponse, 0, len(config.ArrayRules))
+	for _, rule := range config.ArrayRules {
+		resp.ArrayRules = append(resp.ArrayRules, ArrayRuleResponse{
+			Path:      rule.Path,
+			Strategy:  string(rule.Strategy),
+			KeyFields: rule.KeyFields,
+		})
+	}
+	resp.MaxArrayElements = config.MaxArrayElements
+	if resp.MaxArrayElements == 0 {
+		resp.MaxArrayElements = domain.DefaultMaxArrayElements
+	}
+	resp.ArraySampling = string(config.ArraySampling)
+	if resp.ArraySampling == "" {
+		resp.ArraySampling = string(domain.ArraySamplingHead)
+	}
+	resp.CompareHeaders = config.CompareHeaders
+	if resp.CompareHeaders == nil {
+		resp.CompareHeaders = domain.DefaultComparedHeaders
+	}
+	weights := config.Weights
+	if weights.IsZero() {
+		weights = domain.DefaultComparisonWeights()
+	}
+	resp.Weights = ComparisonWeightsDTO{
+		StatusCode: weights.StatusCode,
+		Headers:    weights.Headers,
+		Body:       weights.Body,
+	}
+	resp.FieldWeights = make([]FieldWeightDTO, 0, len(config.FieldWeights))
+	for _, weight := range config.FieldWeights {
+		resp.FieldWeights = append(resp.FieldWeights, FieldWeightDTO{Path: weight.Path, Weight: weight.Weight})
+	}
+	resp.Sampling = ComparisonSamplingDTO{
+		Rate:                  config.Sampling.EffectiveRate(),
+		AlwaysStoreMismatches: config.Sampling.AlwaysStoreMismatches,
+		MaxPerMinute:          config.Sampling.MaxPerMinute,
+	}
 }
 
 // ToOrchestrationRuleResponse는 Domain OrchestrationRule을 OrchestrationRuleResponse로 변환합니다.
@@ -569,6 +941,332 @@ func ToOrchestrationRuleResponseList(rules []*domain.OrchestrationRule) []*Orche
 	return responses
 }
 
+// TransitionEventResponse는 오케스트레이션 모드 전환 이력 응답 DTO입니다.
+type TransitionEventResponse struct {
+	ID             string    `json:"id"`
+	RuleID         string    `json:"rule_id"`
+	FromMode       string    `json:"from_mode"`
+	ToMode         string    `json:"to_mode"`
+	FromPercentage int       `json:"from_percentage"`
+	ToPercentage   int       `json:"to_percentage"`
+	Trigger        string    `json:"trigger"`
+	MatchRate      float64   `json:"match_rate"`
+	ErrorRate      float64   `json:"error_rate"`
+	SampleSize     int       `json:"sample_size"`
+	Reason         string    `json:"reason"`
+	Actor          string    `json:"actor"`
+	Timestamp      time.Time `json:"timestamp"`
+}
+
+// ToTransitionEventResponseList는 Domain TransitionEvent 리스트를 TransitionEventResponse 리스트로 변환합니다.
+func ToTransitionEventResponseList(events []*domain.TransitionEvent) []*TransitionEventResponse {
+	responses := make([]*TransitionEventResponse, len(events))
+	for i, event := range events {
+		responses[i] = &TransitionEventResponse{
+			ID:             event.ID,
+			RuleID:         event.RuleID,
+			FromMode:       string(event.FromMode),
+			ToMode:         string(event.ToMode),
+			FromPercentage: event.FromPercentage,
+			ToPercentage:   event.ToPercentage,
+			Trigger:        string(event.Trigger),
+			MatchRate:      event.MatchRate,
+			ErrorRate:      event.ErrorRate,
+			SampleSize:     event.SampleSize,
+			Reason:         event.Reason,
+			Actor:          event.Actor,
+			Timestamp:      event.Timestamp,
+		}
+	}
+	return responses
+}
+
+// ComparisonDetailResponse는 저장된 비교 결과 상세 응답 DTO입니다.
+type ComparisonDetailResponse struct {
+	ID                   string                `json:"id"`
+	RequestID            string                `json:"request_id"`
+	RoutingRuleID        string                `json:"routing_rule_id"`
+	OrchestrationRuleID  string                `json:"orchestration_rule_id,omitempty"`
+	MatchRate            float64               `json:"match_rate"`
+	Successful           bool                  `json:"successful"`
+	ComparisonDurationMs int64                 `json:"comparison_duration_ms"`
+	CreatedAt            time.Time             `json:"created_at"`
+	LegacyResponse       *ResponseSnapshot     `json:"legacy_response"`
+	ModernResponse       *ResponseSnapshot     `json:"modern_response"`
+	Differences          []domain.ResponseDiff `json:"differences"`
+	DiffTree             *domain.DiffNode      `json:"diff_tree"`
+}
+
+// ResponseSnapshot은 비교에 사용된 응답의 저장본입니다.
+// 본문이 UTF-8 텍스트가 아니면 base64로 인코딩하고 body_encoding을 base64로 표시합니다.
+type ResponseSnapshot struct {
+	StatusCode   int                 `json:"status_code"`
+	Headers      map[string][]string `json:"headers,omitempty"`
+	ContentType  string              `json:"content_type,omitempty"`
+	Body         string              `json:"body"`
+	BodyEncoding string              `json:"body_encoding,omitempty"`
+	DurationMs   int64               `json:"duration_ms"`
+}
+
+// ToComparisonDetailResponse는 Domain APIComparison을 ComparisonDetailResponse로 변환합니다.
+func ToComparisonDetailResponse(comparison *domain.APIComparison) *ComparisonDetailResponse {
+	differences := comparison.Differences
+	if differences == nil {
+		differences = []domain.ResponseDiff{}
+	}
+
+	createdAt := comparison.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = comparison.Timestamp
+	}
+
+	return &ComparisonDetailResponse{
+		ID:                   comparison.ID,
+		RequestID:            comparison.RequestID,
+		RoutingRuleID:        comparison.RoutingRuleID,
+		OrchestrationRuleID:  comparison.OrchestrationRuleID,
+		MatchRate:            comparison.MatchRate,
+		Successful:           comparison.IsSuccessful(),
+		ComparisonDurationMs: comparison.ComparisonDuration.Milliseconds(),
+		CreatedAt:            createdAt,
+		LegacyResponse:       toResponseSnapshot(comparison.LegacyResponse),
+		ModernResponse:       toResponseSnapshot(comparison.ModernResponse),
+		Differences:          differences,
+		DiffTree:             domain.BuildDiffTree(differences),
+	}
+}
+
+// toResponseSnapshot은 Domain Response를 ResponseSnapshot으로 변환합니다.
+func toResponseSnapshot(response *domain.Response) *ResponseSnapshot {
+	if response == nil {
+		return nil
+	}
+
+	snapshot := &ResponseSnapshot{
+		StatusCode:  response.StatusCode,
+		Headers:     response.Headers,
+		ContentType: response.ContentType,
+		Body:        string(response.Body),
+		DurationMs:  response.Duration.Milliseconds(),
+	}
+	if !utf8.Valid(response.Body) {
+		snapshot.Body = base64.StdEncoding.EncodeToString(response.Body)
+		snapshot.BodyEncoding = "base64"
+	}
+	return snapshot
+}
+
+// ComparisonInsightsResponse는 라우팅 규칙의 비교 결과 분석 응답 DTO입니다.
+type ComparisonInsightsResponse struct {
+	RoutingRuleID    string                     `json:"routing_rule_id"`
+	From             time.Time                  `json:"from"`
+	To               time.Time                  `json:"to"`
+	Bucket           string                     `json:"bucket"`
+	TotalComparisons int                        `json:"total_comparisons"`
+	TopPaths         []*PathMismatchResponse    `json:"top_mismatch_paths"`
+	DiffTypeCounts   map[string]int             `json:"diff_type_counts"`
+	Trend            []*MatchRateBucketResponse `json:"match_rate_trend"`
+}
+
+// PathMismatchResponse는 경로별 불일치 집계 응답 DTO입니다.
+type PathMismatchResponse struct {
+	Dimension   string `json:"dimension,omitempty"`
+	Path        string `json:"path"`
+	Count       int    `json:"count"`
+	Comparisons int    `json:"comparisons"`
+}
+
+// MatchRateBucketResponse는 시간대별 일치율 응답 DTO입니다.
+type MatchRateBucketResponse struct {
+	Start            time.Time `json:"start"`
+	Comparisons      int       `json:"comparisons"`
+	Mismatched       int       `json:"mismatched"`
+	AverageMatchRate float64   `json:"average_match_rate"`
+	MinMatchRate     float64   `json:"min_match_rate"`
+}
+
+// ToComparisonInsightsResponse는 Domain ComparisonInsights를 ComparisonInsightsResponse로 변환합니다.
+func ToComparisonInsightsResponse(insights *domain.ComparisonInsights) *ComparisonInsightsResponse {
+	response := &ComparisonInsightsResponse{
+		RoutingRuleID:    insights.RoutingRuleID,
+		From:             insights.From,
+		To:               insights.To,
+		Bucket:           string(insights.Bucket),
+		TotalComparisons: insights.TotalComparisons,
+		TopPaths:         make([]*PathMismatchResponse, len(insights.TopPaths)),
+		DiffTypeCounts:   make(map[string]int, len(insights.DiffTypeCounts)),
+		Trend:            make([]*MatchRateBucketResponse, len(insights.Trend)),
+	}
+
+	for i, path := range insights.TopPaths {
+		response.TopPaths[i] = &PathMismatchResponse{
+			Dimension:   string(path.Dimension),
+			Path:        path.Path,
+			Count:       path.Count,
+			Comparisons: path.Comparisons,
+		}
+	}
+	for diffType, count := range insights.DiffTypeCounts {
+		response.DiffTypeCounts[string(diffType)] = count
+	}
+	for i, bucket := range insights.Trend {
+		response.Trend[i] = &MatchRateBucketResponse{
+			Start:            bucket.Start,
+			Comparisons:      bucket.Comparisons,
+			Mismatched:       bucket.Mismatched,
+			AverageMatchRate: bucket.AverageMatchRate,
+			MinMatchRate:     bucket.MinMatchRate,
+		}
+	}
+	return response
+}
+
+// TransitionEvaluationResponse는 전환 평가 결과 응답 DTO입니다.
+type TransitionEvaluationResponse struct {
+	RuleID              string                     `json:"rule_id"`
+	CurrentMode         string                     `json:"current_mode"`
+	CanTransition       bool                       `json:"can_transition"`
+	Reason              string                     `json:"reason"`
+	EvaluatedAt         time.Time                  `json:"evaluated_at"`
+	From                time.Time                  `json:"from"`
+	To                  time.Time                  `json:"to"`
+	SampleSize          int                        `json:"sample_size"`
+	SuccessfulMatches   int                        `json:"successful_matches"`
+	MatchRate           float64                    `json:"match_rate"`
+	PassRate            float64                    `json:"pass_rate"`
+	PassRateLowerBound  float64                    `json:"pass_rate_lower_bound"`
+	ConfidenceLevel     float64                    `json:"confidence_level"`
+	MinSamplesPerWindow int                        `json:"min_samples_per_window"`
+	Windows             []*StabilityWindowResponse `json:"windows"`
+}
+
+// StabilityWindowResponse는 안정성 확인 구간별 통계 응답 DTO입니다.
+type StabilityWindowResponse struct {
+	Start             time.Time `json:"start"`
+	End               time.Time `json:"end"`
+	SampleSize        int       `json:"sample_size"`
+	SuccessfulMatches int       `json:"successful_matches"`
+	MatchRate         float64   `json:"match_rate"`
+	Passed            bool      `json:"passed"`
+	Reason            string    `json:"reason"`
+}
+
+// ToTransitionEvaluationResponse는 Domain TransitionEvaluation을 TransitionEvaluationResponse로 변환합니다.
+func ToTransitionEvaluationResponse(evaluation *domain.TransitionEvaluation) *TransitionEvaluationResponse {
+	response := &TransitionEvaluationResponse{
+		RuleID:              evaluation.RuleID,
+		CurrentMode:         string(evaluation.CurrentMode),
+		CanTransition:       evaluation.CanTransition,
+		Reason:              evaluation.Reason,
+		EvaluatedAt:         evaluation.EvaluatedAt,
+		From:                evaluation.From,
+		To:                  evaluation.To,
+		SampleSize:          evaluation.SampleSize,
+		SuccessfulMatches:   evaluation.SuccessfulMatches,
+		MatchRate:           evaluation.MatchRate,
+		PassRate:            evaluation.PassRate,
+		PassRateLowerBound:  evaluation.PassRateLowerBound,
+		ConfidenceLevel:     evaluation.ConfidenceLevel,
+		MinSamplesPerWindow: evaluation.MinSamplesPerWindow,
+		Windows:             make([]*StabilityWindowResponse, len(evaluation.Windows)),
+	}
+
+	for i, window := range evaluation.Windows {
+		response.Windows[i] = &StabilityWindowResponse{
+			Start:             window.Start,
+			End:               window.End,
+			SampleSize:        window.SampleSize,
+			SuccessfulMatches: window.SuccessfulMatches,
+			MatchRate:         window.MatchRate,
+			Passed:            window.Passed,
+			Reason:            window.Reason,
+		}
+	}
+	return response
+}
+
+// CircuitBreakerResponse는 Circuit Breaker 상태 응답 DTO입니다.
+type CircuitBreakerResponse struct {
+	Name           string                       `json:"name"`
+	State          string                       `json:"state"`
+	Counts         CircuitBreakerCountsResponse `json:"counts"`
+	MaxRequests    uint32                       `json:"max_requests"`
+	Expiry         *time.Time                   `json:"expiry,omitempty"`
+	LastError      string                       `json:"last_error,omitempty"`
+	LastErrorAt    *time.Time                   `json:"last_error_at,omitempty"`
+	Override       string                       `json:"override,omitempty"`
+	OverrideReason string                       `json:"override_reason,omitempty"`
+}
+
+// CircuitBreakerCountsResponse는 Circuit Breaker 요청 카운터 응답 DTO입니다.
+type CircuitBreakerCountsResponse struct {
+	Requests             uint32 `json:"requests"`
+	TotalSuccesses       uint32 `json:"total_successes"`
+	TotalFailures        uint32 `json:"total_failures"`
+	ConsecutiveSuccesses uint32 `json:"consecutive_successes"`
+	ConsecutiveFailures  uint32 `json:"consecutive_failures"`
+}
+
+// CircuitBreakerEventResponse는 Circuit Breaker 상태 전환 이벤트 응답 DTO입니다.
+type CircuitBreakerEventResponse struct {
+	Name      string    `json:"name"`
+	From      string    `json:"from"`
+	To        string    `json:"to"`
+	Cause     string    `json:"cause"`
+	Reason    string    `json:"reason,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// ToCircuitBreakerResponse는 Domain CircuitBreakerInfo를 CircuitBreakerResponse로 변환합니다.
+func ToCircuitBreakerResponse(info *domain.CircuitBreakerInfo) *CircuitBreakerResponse {
+	response := &CircuitBreakerResponse{
+		Name:  info.Name,
+		State: string(info.State),
+		Counts: CircuitBreakerCountsResponse{
+			Requests:             info.Counts.Requests,
+			TotalSuccesses:       info.Counts.TotalSuccesses,
+			TotalFailures:        info.Counts.TotalFailures,
+			ConsecutiveSuccesses: info.Counts.ConsecutiveSuccesses,
+			ConsecutiveFailures:  info.Counts.ConsecutiveFailures,
+		},
+		MaxRequests:    info.MaxRequests,
+		LastError:      info.LastError,
+		Override:       string(info.Override),
+		OverrideReason: info.OverrideReason,
+	}
+	if !info.Expiry.IsZero() {
+		response.Expiry = &info.Expiry
+	}
+	if !info.LastErrorAt.IsZero() {
+		response.LastErrorAt = &info.LastErrorAt
+	}
+	return response
+}
+
+// ToCircuitBreakerResponseList는 Circuit Breaker 상태 정보를 이름순으로 정렬한 응답 리스트로 변환합니다.
+func ToCircuitBreakerResponseList(infos map[string]*domain.CircuitBreakerInfo) []*CircuitBreakerResponse {
+	responses := make([]*CircuitBreakerResponse, 0, len(infos))
+	for _, info := range infos {
+		responses = append(responses, ToCircuitBreakerResponse(info))
+	}
+	sort.Slice(responses, func(i, j int) bool {
+		return responses[i].Name < responses[j].Name
+	})
+	return responses
+}
+
+// ToCircuitBreakerEventResponse는 Domain CircuitBreakerEvent를 CircuitBreakerEventResponse로 변환합니다.
+func ToCircuitBreakerEventResponse(event domain.CircuitBreakerEvent) *CircuitBreakerEventResponse {
+	return &CircuitBreakerEventResponse{
+		Name:      event.Name,
+		From:      string(event.From),
+		To:        string(event.To),
+		Cause:     event.Cause,
+		Reason:    event.Reason,
+		Timestamp: event.Timestamp,
+	}
+}
+
 // ToHealthResponse는 Domain HealthStatus를 HealthResponse로 변환합니다.
 func ToHealthResponse(status domain.HealthStatus) *HealthResponse {
 	return &HealthResponse{
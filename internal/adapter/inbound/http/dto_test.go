@@ -97,6 +97,22 @@ func TestCreateRoutingRuleRequest_ToDomain(t *testing.T) {
 	assert.Equal(t, "v1", rule.QueryParams["version"])
 }
 
+func TestCreateRoutingRuleRequest_ToDomain_RewriteTemplate(t *testing.T) {
+	req := &CreateRoutingRuleRequest{
+		Name:            "rewrite-rule",
+		PathPattern:     "/test/{id}/*",
+		RewriteTemplate: "/v2/test/{id}/{*}",
+		Method:          "GET",
+		LegacyEndpoint:  &EndpointReference{ID: "endpoint-1"},
+	}
+
+	rule := req.ToDomain()
+
+	assert.Equal(t, "/test/{id}/*", rule.PathPattern)
+	assert.Equal(t, "/v2/test/{id}/{*}", rule.RewriteTemplate)
+	assert.Equal(t, "endpoint-1", rule.LegacyEndpointID)
+}
+
 func TestUpdateRoutingRuleRequest_ApplyTo(t *testing.T) {
 	original := &domain.RoutingRule{
 		ID:               "rule-1",
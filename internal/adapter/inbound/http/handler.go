@@ -1,29 +1,71 @@
 package http
 
 import (
+	"context"
 	"crypto/rand"
 	"demo-api-bridge/internal/core/domain"
 	"demo-api-bridge/internal/core/port"
 	"encoding/hex"
+	"errors"
+	"math"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
 	"time"
 
 	"github.com/gin-gonic/gin"
 )
 
+// 전환 이력 조회 개수 기본값 / 최대값
+const (
+	defaultTransitionHistoryLimit = 50
+	maxTransitionHistoryLimit     = 500
+)
+
+// unifiedDiffContext는 unified diff에서 변경된 줄 앞뒤로 표시할 줄 수입니다.
+const unifiedDiffContext = 3
+
+// 비교 분석 조회 기본 기간 / 불일치 경로 순위 최대 개수
+const (
+	defaultInsightsWindow = 24 * time.Hour
+	maxInsightsPathLimit  = 100
+)
+
+// sseKeepaliveInterval은 Circuit Breaker 이벤트 스트림이 유휴 상태일 때 keepalive 주석을 보내는 주기입니다.
+const sseKeepaliveInterval = 15 * time.Second
+
 // Handler는 HTTP 인바운드 어댑터의 핵심 구조체입니다.
 // Core Layer의 서비스들을 사용하여 HTTP 요청을 처리합니다.
 type Handler struct {
-	bridgeService        port.BridgeService
-	healthService        port.HealthCheckService
-	endpointService      port.EndpointService
-	routingService       port.RoutingService
-	orchestrationService port.OrchestrationService
-	logger               port.Logger
-	shutdownChannel      chan os.Signal
+	bridgeService         port.BridgeService
+	healthService         port.HealthCheckService
+	endpointService       port.EndpointService
+	routingService        port.RoutingService
+	orchestrationService  port.OrchestrationService
+	circuitBreakerService port.CircuitBreakerService // Circuit Breaker 관리 API (nil이면 503)
+	logger                port.Logger
+	shutdownChannel       chan os.Signal
+	maxBodySize           int64 // 브리지 요청 본문 최대 크기 (0이면 제한 없음)
+}
+
+// HandlerOption은 Handler의 선택적 설정을 지정합니다.
+type HandlerOption func(*Handler)
+
+// WithMaxBodySize는 브리지 요청 본문의 최대 크기를 지정합니다.
+// 이를 넘는 요청은 413 Request Entity Too Large로 거부합니다.
+func WithMaxBodySize(maxBodySize int64) HandlerOption {
+	return func(h *Handler) {
+		h.maxBodySize = maxBodySize
+	}
+}
+
+// WithCircuitBreakerService는 Circuit Breaker 관리 API(상태 조회, 리셋, 수동 고정, 이벤트 스트림)가 사용할 서비스를 지정합니다.
+func WithCircuitBreakerService(circuitBreakerService port.CircuitBreakerService) HandlerOption {
+	return func(h *Handler) {
+		h.circuitBreakerService = circuitBreakerService
+	}
 }
 
 // NewHandler는 새로운 HTTP 핸들러를 생성합니다.
@@ -34,11 +76,12 @@ func NewHandler(
 	routingService port.RoutingService,
 	orchestrationService port.OrchestrationService,
 	logger port.Logger,
+	opts ...HandlerOption,
 ) *Handler {
 	shutdownChannel := make(chan os.Signal, 1)
 	signal.Notify(shutdownChannel, os.Interrupt, syscall.SIGTERM)
 
-	return &Handler{
+	h := &Handler{
 		bridgeService:        bridgeService,
 		healthService:        healthService,
 		endpointService:      endpointService,
@@ -47,6 +90,10 @@ func NewHandler(
 		logger:               logger,
 		shutdownChannel:      shutdownChannel,
 	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
 }
 
 // HealthCheck는 서비스의 헬스체크를 처리합니다.
@@ -112,47 +159,111 @@ func (h *Handler) ProcessBridgeRequest(c *gin.Context) {
 	// 도메인 요청 객체 생성
 	requestID := generateRequestID()
 	request := domain.NewRequest(requestID, method, path)
+	request.ClientIP = c.ClientIP()
+	request.SourceIP = request.ClientIP
 
-	// 헤더 복사
-	for key, values := range c.Request.Header {
-		if len(values) > 0 {
-			request.SetHeader(key, values[0])
-		}
-	}
+	// 헤더 복사 (같은 이름의 여러 값 유지)
+	request.Headers = c.Request.Header.Clone()
 
-	// 쿼리 파라미터 복사
-	for key, values := range c.Request.URL.Query() {
-		if len(values) > 0 {
-			request.SetQueryParam(key, values[0])
-		}
-	}
+	// 쿼리 파라미터 복사 (같은 이름의 여러 값 유지)
+	request.QueryParams = c.Request.URL.Query()
 
-	// 요청 본문 읽기 (POST, PUT 등)
-	if method == "POST" || method == "PUT" || method == "PATCH" {
-		body, err := c.GetRawData()
-		if err != nil {
-			h.logger.WithContext(ctx).Error("failed to read request body", "error", err)
-			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+	// 요청 본문은 읽지 않고 스트림으로 전달 (응답 비교 등 본문이 필요한 경우에만 브리지 서비스가 버퍼링)
+	if c.Request.Body != nil && c.Request.Body != http.NoBody {
+		if h.maxBodySize > 0 && c.Request.ContentLength > h.maxBodySize {
+			h.writeBridgeError(c, requestID, &http.MaxBytesError{Limit: h.maxBodySize})
 			return
 		}
-		request.Body = body
+
+		body := c.Request.Body
+		if h.maxBodySize > 0 {
+			body = http.MaxBytesReader(c.Writer, body, h.maxBodySize)
+		}
+		request.BodyStream = body
+		request.ContentLength = c.Request.ContentLength
 	}
 
 	// 브리지 서비스로 요청 처리
 	response, err := h.bridgeService.ProcessRequest(ctx, request)
 	if err != nil {
-		h.logger.WithContext(ctx).Error("bridge request processing failed", "error", err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		h.logger.WithContext(ctx).Error("bridge request processing failed", "trace_id", requestID, "error", err)
+		h.writeBridgeError(c, requestID, err)
 		return
 	}
 
-	// 응답 헤더 설정
-	for key, value := range response.Headers {
-		c.Header(key, value)
+	// 응답 헤더 설정 (업스트림 값으로 대체하되 같은 이름의 여러 값 유지, 예: Set-Cookie)
+	header := c.Writer.Header()
+	for key, values := range response.Headers {
+		header.Del(key)
+		for _, value := range values {
+			header.Add(key, value)
+		}
+	}
+
+	// 업스트림 Content-Type 유지 (알 수 없으면 JSON으로 간주)
+	contentType := response.ContentType
+	if contentType == "" {
+		contentType, _ = response.GetHeader("Content-Type")
+	}
+	if contentType == "" {
+		contentType = "application/json"
+	}
+	header.Set("Content-Type", contentType)
+
+	// 스트리밍 응답은 본문을 그대로 전달
+	if response.BodyStream != nil {
+		defer response.BodyStream.Close()
+		c.DataFromReader(response.StatusCode, -1, contentType, response.BodyStream, nil)
+		if len(c.Errors) > 0 {
+			h.logger.WithContext(ctx).Warn("failed to stream response body", "error", c.Errors.Last().Err)
+		}
+		return
 	}
 
 	// 응답 반환
-	c.Data(response.StatusCode, "application/json", response.Body)
+	c.Data(response.StatusCode, contentType, response.Body)
+}
+
+// writeBridgeError는 브리지 요청 처리 에러를 게이트웨이 상태 코드와 구조화된 에러 본문으로 응답합니다.
+// Circuit Breaker가 열려 있으면 다시 시도할 수 있는 시점을 Retry-After 헤더(초)로 알려줍니다.
+//
+// 에러 원문에는 업스트림 주소나 드라이버 메시지가 들어 있을 수 있으므로 본문에는 분류된 메시지만 담고,
+// 원문은 호출자가 trace_id와 함께 로그로 남깁니다.
+func (h *Handler) writeBridgeError(c *gin.Context, traceID string, err error) {
+	status, message := bridgeErrorStatus(err)
+
+	var circuitErr *domain.CircuitOpenError
+	if errors.As(err, &circuitErr) {
+		c.Header("Retry-After", strconv.Itoa(int(math.Ceil(circuitErr.RetryAfter.Seconds()))))
+	}
+
+	c.JSON(status, &ErrorResponse{
+		Error:     true,
+		Message:   message,
+		TraceID:   traceID,
+		Timestamp: time.Now().Format(time.RFC3339),
+	})
+}
+
+// bridgeErrorStatus는 브리지 요청 처리 에러에 해당하는 HTTP 상태 코드와 클라이언트용 메시지를 반환합니다.
+func bridgeErrorStatus(err error) (int, string) {
+	var maxBytesErr *http.MaxBytesError
+	switch {
+	case errors.As(err, &maxBytesErr):
+		return http.StatusRequestEntityTooLarge, "request body too large"
+	case errors.Is(err, domain.ErrInvalidRequestID), errors.Is(err, domain.ErrInvalidMethod), errors.Is(err, domain.ErrInvalidPath):
+		return http.StatusBadRequest, "invalid request"
+	case errors.Is(err, domain.ErrRouteNotFound):
+		return http.StatusNotFound, "route not found"
+	case errors.Is(err, domain.ErrCircuitOpen), errors.Is(err, domain.ErrExternalAPIUnavailable):
+		return http.StatusServiceUnavailable, "upstream unavailable"
+	case errors.Is(err, domain.ErrExternalAPITimeout), errors.Is(err, context.DeadlineExceeded):
+		return http.StatusGatewayTimeout, "upstream timeout"
+	case errors.Is(err, domain.ErrAllAPICallsFailed), errors.Is(err, domain.ErrExternalAPIFailed):
+		return http.StatusBadGateway, "upstream request failed"
+	default:
+		return http.StatusInternalServerError, "internal server error"
+	}
 }
 
 // Metrics는 Prometheus 메트릭을 반환합니다.
@@ -320,6 +431,7 @@ func (h *Handler) CreateRoutingRule(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create routing rule", "details": err.Error()})
 		return
 	}
+	h.refreshRouteIndex(c)
 
 	// 응답 생성
 	response := ToRoutingRuleResponse(rule)
@@ -386,6 +498,7 @@ func (h *Handler) UpdateRoutingRule(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to update routing rule", "details": err.Error()})
 		return
 	}
+	h.refreshRouteIndex(c)
 
 	// 응답 생성
 	response := ToRoutingRuleResponse(rule)
@@ -402,10 +515,20 @@ func (h *Handler) DeleteRoutingRule(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to delete routing rule", "details": err.Error()})
 		return
 	}
+	h.refreshRouteIndex(c)
 
 	c.JSON(http.StatusNoContent, nil)
 }
 
+// refreshRouteIndex는 라우팅 규칙 변경 후 라우트 인덱스를 재구성합니다.
+// 재구성에 실패해도 변경 자체는 저장되었으므로 경고만 남기고 주기적 갱신에 맡깁니다.
+func (h *Handler) refreshRouteIndex(c *gin.Context) {
+	ctx := c.Request.Context()
+	if err := h.bridgeService.RefreshRoutingRules(ctx); err != nil {
+		h.logger.WithContext(ctx).Warn("failed to refresh route index", "error", err)
+	}
+}
+
 // generateRoutingRuleID는 라우팅 규칙 ID를 생성합니다.
 func generateRoutingRuleID() string {
 	return "rule-" + time.Now().Format("20060102150405") + "-" + randomString(6)
@@ -491,7 +614,7 @@ func (h *Handler) UpdateOrchestrationRule(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
-// EvaluateTransition은 전환 가능성을 평가합니다.
+// EvaluateTransition은 전환 가능성을 평가하고 구간별 통계를 포함한 평가 결과를 반환합니다.
 func (h *Handler) EvaluateTransition(c *gin.Context) {
 	ctx := c.Request.Context()
 	routingRuleID := c.Param("id")
@@ -505,18 +628,14 @@ func (h *Handler) EvaluateTransition(c *gin.Context) {
 	}
 
 	// 전환 평가
-	canTransition, err := h.orchestrationService.EvaluateTransition(ctx, rule)
+	evaluation, err := h.orchestrationService.EvaluateTransition(ctx, rule)
 	if err != nil {
 		h.logger.WithContext(ctx).Error("failed to evaluate transition", "error", err)
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to evaluate transition", "details": err.Error()})
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{
-		"can_transition": canTransition,
-		"current_mode":   string(rule.CurrentMode),
-		"rule_id":        rule.ID,
-	})
+	c.JSON(http.StatusOK, ToTransitionEvaluationResponse(evaluation))
 }
 
 // ExecuteTransition은 API 모드를 전환합니다.
@@ -526,6 +645,7 @@ func (h *Handler) ExecuteTransition(c *gin.Context) {
 
 	var req struct {
 		NewMode string `json:"new_mode" binding:"required"`
+		Actor   string `json:"actor"`
 	}
 	if err := c.ShouldBindJSON(&req); err != nil {
 		h.logger.WithContext(ctx).Error("invalid request body", "error", err)
@@ -550,13 +670,27 @@ func (h *Handler) ExecuteTransition(c *gin.Context) {
 		newMode = domain.MODERN_ONLY
 	case "PARALLEL":
 		newMode = domain.PARALLEL
+	case "CANARY":
+		newMode = domain.CANARY
+	case "SHADOW":
+		newMode = domain.SHADOW
 	default:
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid mode", "details": "mode must be LEGACY_ONLY, MODERN_ONLY, or PARALLEL"})
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid mode", "details": "mode must be LEGACY_ONLY, MODERN_ONLY, PARALLEL, CANARY, or SHADOW"})
 		return
 	}
 
+	// 전환 실행자 (요청 본문 → X-Actor 헤더 → 기본값 순)
+	actor := req.Actor
+	if actor == "" {
+		actor = c.GetHeader("X-Actor")
+	}
+	if actor == "" {
+		actor = "api"
+	}
+
 	// 전환 실행
-	if err := h.orchestrationService.ExecuteTransition(ctx, rule, newMode); err != nil {
+	fromMode := rule.CurrentMode
+	if err := h.orchestrationService.ExecuteTransition(ctx, rule, newMode, domain.TransitionTriggerManual, actor); err != nil {
 		h.logger.WithContext(ctx).Error("failed to execute transition", "error", err)
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to execute transition", "details": err.Error()})
 		return
@@ -564,12 +698,348 @@ func (h *Handler) ExecuteTransition(c *gin.Context) {
 
 	c.JSON(http.StatusOK, gin.H{
 		"message":   "transition executed successfully",
-		"from_mode": string(rule.CurrentMode),
+		"from_mode": string(fromMode),
 		"to_mode":   string(newMode),
 		"rule_id":   rule.ID,
 	})
 }
 
+// GetOrchestrationTransitions는 오케스트레이션 규칙의 모드 전환 이력을 최신순으로 조회합니다.
+func (h *Handler) GetOrchestrationTransitions(c *gin.Context) {
+	ctx := c.Request.Context()
+	routingRuleID := c.Param("id")
+
+	limit := defaultTransitionHistoryLimit
+	if raw := c.Query("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed <= 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid limit", "details": "limit must be a positive integer"})
+			return
+		}
+		limit = parsed
+	}
+	if limit > maxTransitionHistoryLimit {
+		limit = maxTransitionHistoryLimit
+	}
+
+	// 오케스트레이션 규칙 조회
+	rule, err := h.orchestrationService.GetOrchestrationRule(ctx, routingRuleID)
+	if err != nil {
+		h.logger.WithContext(ctx).Error("orchestration rule not found", "routing_rule_id", routingRuleID, "error", err)
+		c.JSON(http.StatusNotFound, gin.H{"error": "orchestration rule not found", "details": err.Error()})
+		return
+	}
+
+	events, err := h.orchestrationService.GetTransitionHistory(ctx, rule.ID, limit)
+	if err != nil {
+		h.logger.WithContext(ctx).Error("failed to get transition history", "rule_id", rule.ID, "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get transition history", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"rule_id":     rule.ID,
+		"transitions": ToTransitionEventResponseList(events),
+		"count":       len(events),
+	})
+}
+
+// GetComparison은 저장된 비교 결과를 차이점 트리와 함께 조회합니다.
+// format 쿼리로 json(기본), unified(unified diff 텍스트), html(좌우 비교 화면)을 선택합니다.
+func (h *Handler) GetComparison(c *gin.Context) {
+	ctx := c.Request.Context()
+	comparisonID := c.Param("id")
+
+	format := c.DefaultQuery("format", "json")
+	if format != "json" && format != "unified" && format != "html" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid format", "details": "format must be one of json, unified, html"})
+		return
+	}
+
+	comparison, err := h.orchestrationService.GetComparison(ctx, comparisonID)
+	if err != nil {
+		h.logger.WithContext(ctx).Error("comparison not found", "comparison_id", comparisonID, "error", err)
+		c.JSON(http.StatusNotFound, gin.H{"error": "comparison not found", "details": err.Error()})
+		return
+	}
+
+	switch format {
+	case "unified":
+		lines := domain.DiffLines(domain.ResponseLines(comparison.LegacyResponse), domain.ResponseLines(comparison.ModernResponse))
+		c.String(http.StatusOK, domain.UnifiedDiff("legacy", "modern", lines, unifiedDiffContext))
+	case "html":
+		page, err := renderComparisonHTML(comparison)
+		if err != nil {
+			h.logger.WithContext(ctx).Error("failed to render comparison", "comparison_id", comparisonID, "error", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to render comparison", "details": err.Error()})
+			return
+		}
+		c.Data(http.StatusOK, "text/html; charset=utf-8", page)
+	default:
+		c.JSON(http.StatusOK, ToComparisonDetailResponse(comparison))
+	}
+}
+
+// GetComparisonInsights는 라우팅 규칙의 비교 결과 분석을 조회합니다.
+// from/to(RFC3339, 기본 최근 24시간), bucket(hour|day, 기본 hour), limit(불일치 경로 순위 개수)을 지원합니다.
+func (h *Handler) GetComparisonInsights(c *gin.Context) {
+	ctx := c.Request.Context()
+	routingRuleID := c.Param("id")
+
+	to := time.Now()
+	if raw := c.Query("to"); raw != "" {
+		parsed, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid to", "details": "to must be an RFC3339 timestamp"})
+			return
+		}
+		to = parsed
+	}
+	from := to.Add(-defaultInsightsWindow)
+	if raw := c.Query("from"); raw != "" {
+		parsed, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid from", "details": "from must be an RFC3339 timestamp"})
+			return
+		}
+		from = parsed
+	}
+	if !from.Before(to) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid time range", "details": "from must be before to"})
+		return
+	}
+
+	bucket := domain.InsightBucket(c.DefaultQuery("bucket", string(domain.InsightBucketHour)))
+	if !bucket.IsValid() {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid bucket", "details": "bucket must be one of hour, day"})
+		return
+	}
+
+	limit := domain.DefaultTopMismatchPaths
+	if raw := c.Query("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed <= 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid limit", "details": "limit must be a positive integer"})
+			return
+		}
+		limit = parsed
+	}
+	if limit > maxInsightsPathLimit {
+		limit = maxInsightsPathLimit
+	}
+
+	// 라우팅 규칙 조회
+	if _, err := h.routingService.GetRule(ctx, routingRuleID); err != nil {
+		h.logger.WithContext(ctx).Error("failed to get routing rule", "rule_id", routingRuleID, "error", err)
+		c.JSON(http.StatusNotFound, gin.H{"error": "routing rule not found", "details": err.Error()})
+		return
+	}
+
+	insights, err := h.orchestrationService.GetComparisonInsights(ctx, routingRuleID, from, to, bucket, limit)
+	if err != nil {
+		h.logger.WithContext(ctx).Error("failed to get comparison insights", "routing_rule_id", routingRuleID, "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get comparison insights", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, ToComparisonInsightsResponse(insights))
+}
+
+// ListCircuitBreakers는 모든 Circuit Breaker의 상태를 이름순으로 조회합니다.
+func (h *Handler) ListCircuitBreakers(c *gin.Context) {
+	if !h.requireCircuitBreakerService(c) {
+		return
+	}
+
+	breakers := ToCircuitBreakerResponseList(h.circuitBreakerService.GetAllBreakerInfos())
+	c.JSON(http.StatusOK, gin.H{
+		"circuit_breakers": breakers,
+		"count":            len(breakers),
+	})
+}
+
+// GetCircuitBreaker는 Circuit Breaker의 상태를 조회합니다.
+func (h *Handler) GetCircuitBreaker(c *gin.Context) {
+	if !h.requireCircuitBreakerService(c) {
+		return
+	}
+
+	info, err := h.circuitBreakerService.GetBreakerInfo(c.Param("name"))
+	if err != nil {
+		h.writeCircuitBreakerError(c, "failed to get circuit breaker", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, ToCircuitBreakerResponse(info))
+}
+
+// ResetCircuitBreaker는 Circuit Breaker를 CLOSED 상태로 리셋합니다. 수동 고정 상태는 유지합니다.
+func (h *Handler) ResetCircuitBreaker(c *gin.Context) {
+	if !h.requireCircuitBreakerService(c) {
+		return
+	}
+
+	ctx := c.Request.Context()
+	name := c.Param("name")
+	if err := h.circuitBreakerService.ResetBreaker(name); err != nil {
+		h.writeCircuitBreakerError(c, "failed to reset circuit breaker", err)
+		return
+	}
+	h.logger.WithContext(ctx).Info("circuit breaker reset via API", "name", name)
+
+	info, err := h.circuitBreakerService.GetBreakerInfo(name)
+	if err != nil {
+		h.writeCircuitBreakerError(c, "failed to get circuit breaker", err)
+		return
+	}
+	c.JSON(http.StatusOK, ToCircuitBreakerResponse(info))
+}
+
+// SetCircuitBreakerOverride는 Circuit Breaker 상태를 수동으로 고정합니다 (유지보수 등).
+// state는 OPEN(모든 요청 차단) 또는 CLOSED(실패 집계 없이 통과)이고,
+// duration(예: 30m)을 지정하면 그 시간이 지난 뒤 자동으로 해제됩니다.
+func (h *Handler) SetCircuitBreakerOverride(c *gin.Context) {
+	if !h.requireCircuitBreakerService(c) {
+		return
+	}
+
+	ctx := c.Request.Context()
+	name := c.Param("name")
+
+	var req struct {
+		State    string `json:"state" binding:"required"`
+		Reason   string `json:"reason"`
+		Duration string `json:"duration"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.WithContext(ctx).Error("invalid request body", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body", "details": err.Error()})
+		return
+	}
+
+	var override domain.CircuitBreakerOverride
+	switch req.State {
+	case string(domain.OPEN):
+		override = domain.OverrideForceOpen
+	case string(domain.CLOSED):
+		override = domain.OverrideForceClosed
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid state", "details": "state must be OPEN or CLOSED"})
+		return
+	}
+
+	var duration time.Duration
+	if req.Duration != "" {
+		parsed, err := time.ParseDuration(req.Duration)
+		if err != nil || parsed <= 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid duration", "details": "duration must be a positive Go duration (e.g. 30m, 2h)"})
+			return
+		}
+		duration = parsed
+	}
+
+	if err := h.circuitBreakerService.SetOverride(name, override, req.Reason, duration); err != nil {
+		h.writeCircuitBreakerError(c, "failed to override circuit breaker", err)
+		return
+	}
+	h.logger.WithContext(ctx).Info("circuit breaker override set via API", "name", name, "override", override)
+
+	info, err := h.circuitBreakerService.GetBreakerInfo(name)
+	if err != nil {
+		h.writeCircuitBreakerError(c, "failed to get circuit breaker", err)
+		return
+	}
+	c.JSON(http.StatusOK, ToCircuitBreakerResponse(info))
+}
+
+// ClearCircuitBreakerOverride는 수동 상태 고정을 해제합니다.
+func (h *Handler) ClearCircuitBreakerOverride(c *gin.Context) {
+	if !h.requireCircuitBreakerService(c) {
+		return
+	}
+
+	ctx := c.Request.Context()
+	name := c.Param("name")
+	if err := h.circuitBreakerService.ClearOverride(name); err != nil {
+		h.writeCircuitBreakerError(c, "failed to clear circuit breaker override", err)
+		return
+	}
+	h.logger.WithContext(ctx).Info("circuit breaker override cleared via API", "name", name)
+
+	info, err := h.circuitBreakerService.GetBreakerInfo(name)
+	if err != nil {
+		h.writeCircuitBreakerError(c, "failed to get circuit breaker", err)
+		return
+	}
+	c.JSON(http.StatusOK, ToCircuitBreakerResponse(info))
+}
+
+// StreamCircuitBreakerEvents는 Circuit Breaker 상태 전환 이벤트를 Server-Sent Events로 전송합니다.
+// 연결 직후 현재 상태를 snapshot 이벤트로 보내고, 이후 전환마다 state_change 이벤트를 보냅니다.
+func (h *Handler) StreamCircuitBreakerEvents(c *gin.Context) {
+	if !h.requireCircuitBreakerService(c) {
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	// 스냅샷과 구독 사이의 전환을 놓치지 않도록 먼저 구독
+	events, unsubscribe := h.circuitBreakerService.Subscribe()
+	defer unsubscribe()
+
+	// 서버 WriteTimeout이 스트림을 끊지 않도록 이 연결의 쓰기 기한을 해제
+	_ = http.NewResponseController(c.Writer).SetWriteDeadline(time.Time{})
+
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no") // 프록시 버퍼링 비활성화
+	c.Status(http.StatusOK)
+
+	breakers := ToCircuitBreakerResponseList(h.circuitBreakerService.GetAllBreakerInfos())
+	c.SSEvent("snapshot", gin.H{"circuit_breakers": breakers, "count": len(breakers)})
+	c.Writer.Flush()
+
+	keepalive := time.NewTicker(sseKeepaliveInterval)
+	defer keepalive.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			c.SSEvent("state_change", ToCircuitBreakerEventResponse(event))
+		case <-keepalive.C:
+			if _, err := c.Writer.WriteString(": keepalive\n\n"); err != nil {
+				return
+			}
+		}
+		c.Writer.Flush()
+	}
+}
+
+// requireCircuitBreakerService는 Circuit Breaker 서비스가 설정되지 않았으면 503으로 응답하고 false를 반환합니다.
+func (h *Handler) requireCircuitBreakerService(c *gin.Context) bool {
+	if h.circuitBreakerService != nil {
+		return true
+	}
+	c.JSON(http.StatusServiceUnavailable, gin.H{"error": "circuit breaker service is not configured"})
+	return false
+}
+
+// writeCircuitBreakerError는 Circuit Breaker 관리 API의 에러를 응답합니다.
+func (h *Handler) writeCircuitBreakerError(c *gin.Context, message string, err error) {
+	h.logger.WithContext(c.Request.Context()).Error(message, "name", c.Param("name"), "error", err)
+
+	if errors.Is(err, domain.ErrCircuitBreakerNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "circuit breaker not found", "details": err.Error()})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, gin.H{"error": message, "details": err.Error()})
+}
+
 // generateOrchestrationRuleID는 오케스트레이션 규칙 ID를 생성합니다.
 func generateOrchestrationRuleID() string {
 	return "orch-" + time.Now().Format("20060102150405") + "-" + randomString(6)
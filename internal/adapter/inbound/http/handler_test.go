@@ -1,17 +1,25 @@
 package http
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"demo-api-bridge/internal/core/domain"
 	"demo-api-bridge/pkg/logger"
 
 	"github.com/gin-gonic/gin"
+	"github.com/sony/gobreaker"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -36,6 +44,11 @@ func (m *MockBridgeService) GetEndpoint(ctx context.Context, endpointID string)
 	return args.Get(0).(*domain.APIEndpoint), args.Error(1)
 }
 
+func (m *MockBridgeService) RefreshRoutingRules(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
 type MockRoutingService struct {
 	mock.Mock
 }
@@ -117,8 +130,24 @@ type MockOrchestrationService struct {
 	mock.Mock
 }
 
-func (m *MockOrchestrationService) ProcessParallelRequest(ctx context.Context, request *domain.Request, legacyEndpoint, modernEndpoint *domain.APIEndpoint) (*domain.APIComparison, error) {
-	args := m.Called(ctx, request, legacyEndpoint, modernEndpoint)
+func (m *MockOrchestrationService) ProcessParallelRequest(ctx context.Context, rule *domain.OrchestrationRule, request *domain.Request, legacyEndpoint, modernEndpoint *domain.APIEndpoint) (*domain.APIComparison, error) {
+	args := m.Called(ctx, rule, request, legacyEndpoint, modernEndpoint)
+	return args.Get(0).(*domain.APIComparison), args.Error(1)
+}
+
+func (m *MockOrchestrationService) CompareShadowResponse(ctx context.Context, rule *domain.OrchestrationRule, request *domain.Request, legacyResponse *domain.Response, modernEndpoint *domain.APIEndpoint) (*domain.APIComparison, error) {
+	args := m.Called(ctx, rule, request, legacyResponse, modernEndpoint)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.APIComparison), args.Error(1)
+}
+
+func (m *MockOrchestrationService) CompareCanaryResponse(ctx context.Context, rule *domain.OrchestrationRule, request *domain.Request, modernResponse *domain.Response, legacyEndpoint *domain.APIEndpoint) (*domain.APIComparison, error) {
+	args := m.Called(ctx, rule, request, modernResponse, legacyEndpoint)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
 	return args.Get(0).(*domain.APIComparison), args.Error(1)
 }
 
@@ -137,16 +166,43 @@ func (m *MockOrchestrationService) UpdateOrchestrationRule(ctx context.Context,
 	return args.Error(0)
 }
 
-func (m *MockOrchestrationService) EvaluateTransition(ctx context.Context, rule *domain.OrchestrationRule) (bool, error) {
+func (m *MockOrchestrationService) EvaluateTransition(ctx context.Context, rule *domain.OrchestrationRule) (*domain.TransitionEvaluation, error) {
 	args := m.Called(ctx, rule)
-	return args.Bool(0), args.Error(1)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.TransitionEvaluation), args.Error(1)
 }
 
-func (m *MockOrchestrationService) ExecuteTransition(ctx context.Context, rule *domain.OrchestrationRule, newMode domain.APIMode) error {
-	args := m.Called(ctx, rule, newMode)
+func (m *MockOrchestrationService) ExecuteTransition(ctx context.Context, rule *domain.OrchestrationRule, newMode domain.APIMode, trigger domain.TransitionTrigger, actor string) error {
+	args := m.Called(ctx, rule, newMode, trigger, actor)
 	return args.Error(0)
 }
 
+func (m *MockOrchestrationService) GetTransitionHistory(ctx context.Context, ruleID string, limit int) ([]*domain.TransitionEvent, error) {
+	args := m.Called(ctx, ruleID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.TransitionEvent), args.Error(1)
+}
+
+func (m *MockOrchestrationService) GetComparison(ctx context.Context, id string) (*domain.APIComparison, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.APIComparison), args.Error(1)
+}
+
+func (m *MockOrchestrationService) GetComparisonInsights(ctx context.Context, routingRuleID string, from, to time.Time, bucket domain.InsightBucket, limit int) (*domain.ComparisonInsights, error) {
+	args := m.Called(ctx, routingRuleID, from, to, bucket, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ComparisonInsights), args.Error(1)
+}
+
 func setupTestHandler() (*Handler, *MockBridgeService, *MockRoutingService, *MockEndpointService, *MockHealthService, *MockOrchestrationService, *gin.Engine) {
 	// Create mock services
 	mockBridge := &MockBridgeService{}
@@ -194,6 +250,13 @@ func setupTestHandler() (*Handler, *MockBridgeService, *MockRoutingService, *Moc
 		abs.GET("/v1/routing-rules/:id", handler.GetRoutingRule)
 		abs.PUT("/v1/routing-rules/:id", handler.UpdateRoutingRule)
 		abs.DELETE("/v1/routing-rules/:id", handler.DeleteRoutingRule)
+		abs.GET("/v1/routing-rules/:id/comparison-insights", handler.GetComparisonInsights)
+
+		// Orchestration rule routes
+		abs.GET("/v1/orchestration-rules/:id/evaluate-transition", handler.EvaluateTransition)
+		abs.POST("/v1/orchestration-rules/:id/execute-transition", handler.ExecuteTransition)
+		abs.GET("/v1/orchestration-rules/:id/transitions", handler.GetOrchestrationTransitions)
+		abs.GET("/v1/comparisons/:id", handler.GetComparison)
 	}
 
 	// External API Bridge - all other requests
@@ -291,7 +354,7 @@ func TestHandleAPIRequest(t *testing.T) {
 	// Mock bridge service response
 	expectedResponse := &domain.Response{
 		StatusCode:  http.StatusOK,
-		Headers:     map[string]string{"Content-Type": "application/json"},
+		Headers:     http.Header{"Content-Type": {"application/json"}},
 		Body:        []byte(`{"message": "success"}`),
 		ContentType: "application/json",
 	}
@@ -421,10 +484,12 @@ func TestListRoutingRules(t *testing.T) {
 }
 
 func TestCreateRoutingRule(t *testing.T) {
-	_, _, mockRouting, _, _, _, router := setupTestHandler()
+	_, mockBridge, mockRouting, _, _, _, router := setupTestHandler()
 
 	// Mock create routing rule - CreateRule returns error only
 	mockRouting.On("CreateRule", mock.Anything, mock.AnythingOfType("*domain.RoutingRule")).Return(nil)
+	// 규칙 생성 후 라우트 인덱스 재구성
+	mockBridge.On("RefreshRoutingRules", mock.Anything).Return(nil)
 
 	// Create request body
 	requestBody := CreateRoutingRuleRequest{
@@ -453,6 +518,338 @@ func TestCreateRoutingRule(t *testing.T) {
 	assert.Equal(t, "new-rule", response.Name)
 
 	mockRouting.AssertExpectations(t)
+	mockBridge.AssertExpectations(t)
+}
+
+func TestEvaluateTransition_ReturnsReport(t *testing.T) {
+	_, _, _, _, _, mockOrchestration, router := setupTestHandler()
+
+	now := time.Date(2025, 1, 5, 12, 0, 0, 0, time.UTC)
+	rule := &domain.OrchestrationRule{ID: "orch-1", RoutingRuleID: "rule-1", CurrentMode: domain.PARALLEL}
+	evaluation := &domain.TransitionEvaluation{
+		RuleID:             "orch-1",
+		CurrentMode:        domain.PARALLEL,
+		EvaluatedAt:        now,
+		From:               now.Add(-12 * time.Hour),
+		To:                 now,
+		SampleSize:         60,
+		SuccessfulMatches:  60,
+		MatchRate:          0.99,
+		PassRate:           1.0,
+		PassRateLowerBound: 0.956,
+		ConfidenceLevel:    0.95,
+		Reason:             "1 of 2 windows below criteria",
+		Windows: []domain.StabilityWindow{
+			{Start: now.Add(-12 * time.Hour), End: now.Add(-6 * time.Hour), SampleSize: 50, SuccessfulMatches: 50, MatchRate: 0.99, Passed: true, Reason: "criteria met"},
+			{Start: now.Add(-6 * time.Hour), End: now, SampleSize: 10, SuccessfulMatches: 10, MatchRate: 0.99, Reason: "samples 10 < 25"},
+		},
+	}
+	mockOrchestration.On("GetOrchestrationRule", mock.Anything, "rule-1").Return(rule, nil)
+	mockOrchestration.On("EvaluateTransition", mock.Anything, rule).Return(evaluation, nil)
+
+	req, _ := http.NewRequest("GET", "/abs/v1/orchestration-rules/rule-1/evaluate-transition", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response TransitionEvaluationResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.False(t, response.CanTransition)
+	assert.Equal(t, "orch-1", response.RuleID)
+	assert.Equal(t, "PARALLEL", response.CurrentMode)
+	assert.Equal(t, "1 of 2 windows below criteria", response.Reason)
+	assert.Equal(t, 0.956, response.PassRateLowerBound)
+	assert.Len(t, response.Windows, 2)
+	assert.False(t, response.Windows[1].Passed)
+	assert.Equal(t, "samples 10 < 25", response.Windows[1].Reason)
+
+	mockOrchestration.AssertExpectations(t)
+}
+
+func TestCreateRoutingRule_MirrorPolicy(t *testing.T) {
+	_, mockBridge, mockRouting, _, _, _, router := setupTestHandler()
+
+	mockRouting.On("CreateRule", mock.Anything, mock.MatchedBy(func(rule *domain.RoutingRule) bool {
+		return rule.MirrorPolicy == domain.MirrorPolicy{AllowUnsafeMethods: true, DryRunEndpointID: "sandbox-1", DryRunHeader: true}
+	})).Return(nil)
+	mockBridge.On("RefreshRoutingRules", mock.Anything).Return(nil)
+
+	body := `{"name":"orders","path_pattern":"/api/orders","method":"POST",` +
+		`"mirror_policy":{"allow_unsafe_methods":true,"dry_run_endpoint_id":"sandbox-1","dry_run_header":true}}`
+	req, _ := http.NewRequest("POST", "/abs/v1/routing-rules", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	var response RoutingRuleResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, &MirrorPolicyDTO{AllowUnsafeMethods: true, DryRunEndpointID: "sandbox-1", DryRunHeader: true}, response.MirrorPolicy)
+
+	mockRouting.AssertExpectations(t)
+}
+
+func TestExecuteTransition_RecordsManualActor(t *testing.T) {
+	_, _, _, _, _, mockOrchestration, router := setupTestHandler()
+
+	rule := &domain.OrchestrationRule{ID: "orch-1", RoutingRuleID: "rule-1", CurrentMode: domain.PARALLEL}
+	mockOrchestration.On("GetOrchestrationRule", mock.Anything, "rule-1").Return(rule, nil)
+	mockOrchestration.On("ExecuteTransition", mock.Anything, rule, domain.MODERN_ONLY, domain.TransitionTriggerManual, "alice").
+		Run(func(args mock.Arguments) {
+			args.Get(1).(*domain.OrchestrationRule).CurrentMode = domain.MODERN_ONLY
+		}).Return(nil)
+
+	req, _ := http.NewRequest("POST", "/abs/v1/orchestration-rules/rule-1/execute-transition", bytes.NewBufferString(`{"new_mode":"MODERN_ONLY"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Actor", "alice")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response gin.H
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, "PARALLEL", response["from_mode"])
+	assert.Equal(t, "MODERN_ONLY", response["to_mode"])
+
+	mockOrchestration.AssertExpectations(t)
+}
+
+func TestGetOrchestrationTransitions(t *testing.T) {
+	_, _, _, _, _, mockOrchestration, router := setupTestHandler()
+
+	rule := &domain.OrchestrationRule{ID: "orch-1", RoutingRuleID: "rule-1", CurrentMode: domain.MODERN_ONLY}
+	events := []*domain.TransitionEvent{
+		{
+			ID:           "orch-1-1",
+			RuleID:       "orch-1",
+			FromMode:     domain.PARALLEL,
+			ToMode:       domain.MODERN_ONLY,
+			ToPercentage: 100,
+			Trigger:      domain.TransitionTriggerManual,
+			MatchRate:    0.99,
+			Actor:        "alice",
+		},
+	}
+	mockOrchestration.On("GetOrchestrationRule", mock.Anything, "rule-1").Return(rule, nil)
+	mockOrchestration.On("GetTransitionHistory", mock.Anything, "orch-1", 500).Return(events, nil)
+
+	req, _ := http.NewRequest("GET", "/abs/v1/orchestration-rules/rule-1/transitions?limit=1000", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response struct {
+		RuleID      string                     `json:"rule_id"`
+		Transitions []*TransitionEventResponse `json:"transitions"`
+		Count       int                        `json:"count"`
+	}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, "orch-1", response.RuleID)
+	assert.Equal(t, 1, response.Count)
+	assert.Equal(t, "PARALLEL", response.Transitions[0].FromMode)
+	assert.Equal(t, "manual", response.Transitions[0].Trigger)
+	assert.Equal(t, "alice", response.Transitions[0].Actor)
+
+	mockOrchestration.AssertExpectations(t)
+}
+
+func TestGetOrchestrationTransitions_InvalidLimit(t *testing.T) {
+	_, _, _, _, _, _, router := setupTestHandler()
+
+	req, _ := http.NewRequest("GET", "/abs/v1/orchestration-rules/rule-1/transitions?limit=abc", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func newTestComparison() *domain.APIComparison {
+	return &domain.APIComparison{
+		ID:            "cmp-1",
+		RequestID:     "req-1",
+		RoutingRuleID: "rule-1",
+		LegacyResponse: &domain.Response{
+			StatusCode: 200,
+			Headers:    http.Header{"Content-Type": {"application/json"}},
+			Body:       []byte(`{"id":1,"name":"kim","items":[{"id":1,"price":100}]}`),
+		},
+		ModernResponse: &domain.Response{
+			StatusCode: 200,
+			Headers:    http.Header{"Content-Type": {"application/json"}},
+			Body:       []byte(`{"id":1,"name":"lee","items":[{"id":1,"price":120}]}`),
+		},
+		MatchRate: 0.5,
+		Differences: []domain.ResponseDiff{
+			{Type: domain.VALUE_MISMATCH, Dimension: domain.DimensionBody, Path: "name", LegacyValue: "kim", ModernValue: "lee"},
+			{Type: domain.VALUE_MISMATCH, Dimension: domain.DimensionBody, Path: "items[0].price", LegacyValue: 100.0, ModernValue: 120.0},
+		},
+	}
+}
+
+func TestGetComparison(t *testing.T) {
+	_, _, _, _, _, mockOrchestration, router := setupTestHandler()
+
+	mockOrchestration.On("GetComparison", mock.Anything, "cmp-1").Return(newTestComparison(), nil)
+
+	req, _ := http.NewRequest("GET", "/abs/v1/comparisons/cmp-1", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response ComparisonDetailResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, "cmp-1", response.ID)
+	assert.Equal(t, 200, response.LegacyResponse.StatusCode)
+	assert.Contains(t, response.ModernResponse.Body, `"lee"`)
+	assert.Len(t, response.Differences, 2)
+
+	body := response.DiffTree.Children[0]
+	assert.Equal(t, "body", body.Name)
+	assert.Equal(t, "name", body.Children[0].Name)
+	assert.Equal(t, "items", body.Children[1].Name)
+	assert.Equal(t, "body.items[0].price", body.Children[1].Children[0].Children[0].Path)
+
+	mockOrchestration.AssertExpectations(t)
+}
+
+func TestGetComparison_Unified(t *testing.T) {
+	_, _, _, _, _, mockOrchestration, router := setupTestHandler()
+
+	mockOrchestration.On("GetComparison", mock.Anything, "cmp-1").Return(newTestComparison(), nil)
+
+	req, _ := http.NewRequest("GET", "/abs/v1/comparisons/cmp-1?format=unified", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Header().Get("Content-Type"), "text/plain")
+	assert.Contains(t, w.Body.String(), "--- legacy\n+++ modern\n")
+	assert.Contains(t, w.Body.String(), `-  "name": "kim"`)
+	assert.Contains(t, w.Body.String(), `+  "name": "lee"`)
+}
+
+func TestGetComparison_HTML(t *testing.T) {
+	_, _, _, _, _, mockOrchestration, router := setupTestHandler()
+
+	comparison := newTestComparison()
+	comparison.ModernResponse.Body = []byte(`{"id":1,"name":"<script>","items":[]}`)
+	mockOrchestration.On("GetComparison", mock.Anything, "cmp-1").Return(comparison, nil)
+
+	req, _ := http.NewRequest("GET", "/abs/v1/comparisons/cmp-1?format=html", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Header().Get("Content-Type"), "text/html")
+	assert.Contains(t, w.Body.String(), `<tr class="changed">`)
+	assert.Contains(t, w.Body.String(), "&lt;script&gt;")
+	assert.NotContains(t, w.Body.String(), `"<script>"`)
+}
+
+func TestGetComparison_NotFound(t *testing.T) {
+	_, _, _, _, _, mockOrchestration, router := setupTestHandler()
+
+	mockOrchestration.On("GetComparison", mock.Anything, "missing").Return(nil, assert.AnError)
+
+	req, _ := http.NewRequest("GET", "/abs/v1/comparisons/missing", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestGetComparison_InvalidFormat(t *testing.T) {
+	_, _, _, _, _, _, router := setupTestHandler()
+
+	req, _ := http.NewRequest("GET", "/abs/v1/comparisons/cmp-1?format=pdf", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestGetComparisonInsights(t *testing.T) {
+	_, _, mockRouting, _, _, mockOrchestration, router := setupTestHandler()
+
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	insights := domain.NewComparisonInsights("rule-1", from, to, domain.InsightBucketDay, 10)
+	insights.Add(0.5, []domain.ResponseDiff{
+		{Type: domain.VALUE_MISMATCH, Dimension: domain.DimensionBody, Path: "items[0].price"},
+		{Type: domain.VALUE_MISMATCH, Dimension: domain.DimensionBody, Path: "items[1].price"},
+	}, from.Add(time.Hour))
+	insights.Finalize()
+
+	mockRouting.On("GetRule", mock.Anything, "rule-1").Return(&domain.RoutingRule{ID: "rule-1"}, nil)
+	mockOrchestration.On("GetComparisonInsights", mock.Anything, "rule-1", from, to, domain.InsightBucketDay, 10).Return(insights, nil)
+
+	req, _ := http.NewRequest("GET", "/abs/v1/routing-rules/rule-1/comparison-insights?from=2025-01-01T00:00:00Z&to=2025-01-02T00:00:00Z&bucket=day&limit=10", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response ComparisonInsightsResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, 1, response.TotalComparisons)
+	assert.Equal(t, "items[*].price", response.TopPaths[0].Path)
+	assert.Equal(t, 2, response.TopPaths[0].Count)
+	assert.Equal(t, 2, response.DiffTypeCounts["VALUE_MISMATCH"])
+	assert.Len(t, response.Trend, 1)
+
+	mockRouting.AssertExpectations(t)
+	mockOrchestration.AssertExpectations(t)
+}
+
+func TestGetComparisonInsights_InvalidQuery(t *testing.T) {
+	_, _, _, _, _, _, router := setupTestHandler()
+
+	queries := []string{
+		"from=yesterday",
+		"to=2025-01-01",
+		"from=2025-01-02T00:00:00Z&to=2025-01-01T00:00:00Z",
+		"bucket=week",
+		"limit=0",
+	}
+
+	for _, query := range queries {
+		req, _ := http.NewRequest("GET", "/abs/v1/routing-rules/rule-1/comparison-insights?"+query, nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code, query)
+	}
+}
+
+func TestGetComparisonInsights_RuleNotFound(t *testing.T) {
+	_, _, mockRouting, _, _, _, router := setupTestHandler()
+
+	mockRouting.On("GetRule", mock.Anything, "missing").Return((*domain.RoutingRule)(nil), assert.AnError)
+
+	req, _ := http.NewRequest("GET", "/abs/v1/routing-rules/missing/comparison-insights", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
 }
 
 func TestHealthCheckFailure(t *testing.T) {
@@ -505,6 +902,188 @@ func TestHandleAPIRequestError(t *testing.T) {
 	mockBridge.AssertExpectations(t)
 }
 
+func TestProcessBridgeRequest_StreamsBodies(t *testing.T) {
+	_, mockBridge, _, _, _, _, router := setupTestHandler()
+
+	streamed := &domain.Response{
+		StatusCode: http.StatusOK,
+		Headers:    http.Header{},
+		BodyStream: io.NopCloser(strings.NewReader(`{"file": "large"}`)),
+	}
+
+	var forwarded string
+	mockBridge.On("ProcessRequest", mock.Anything, mock.AnythingOfType("*domain.Request")).
+		Run(func(args mock.Arguments) {
+			request := args.Get(1).(*domain.Request)
+			assert.Nil(t, request.Body, "handler should not buffer the request body")
+			body, _ := io.ReadAll(request.BodyStream)
+			forwarded = string(body)
+		}).
+		Return(streamed, nil)
+
+	req, _ := http.NewRequest("PUT", "/api/v1/files/1", strings.NewReader(`{"upload": true}`))
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `{"file": "large"}`, w.Body.String())
+	assert.Equal(t, `{"upload": true}`, forwarded)
+	mockBridge.AssertExpectations(t)
+}
+
+func TestProcessBridgeRequest_RequestBodyTooLarge(t *testing.T) {
+	t.Run("content length over limit", func(t *testing.T) {
+		handler, mockBridge, _, _, _, _, router := setupTestHandler()
+		handler.maxBodySize = 16
+
+		req, _ := http.NewRequest("POST", "/api/v1/files", strings.NewReader(strings.Repeat("x", 32)))
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+		mockBridge.AssertNotCalled(t, "ProcessRequest", mock.Anything, mock.Anything)
+	})
+
+	t.Run("chunked body over limit", func(t *testing.T) {
+		handler, mockBridge, _, _, _, _, router := setupTestHandler()
+		handler.maxBodySize = 16
+
+		mockBridge.On("ProcessRequest", mock.Anything, mock.AnythingOfType("*domain.Request")).
+			Run(func(args mock.Arguments) {
+				var maxBytesErr *http.MaxBytesError
+				err := args.Get(1).(*domain.Request).BufferBody()
+				assert.ErrorAs(t, err, &maxBytesErr)
+			}).
+			Return((*domain.Response)(nil), fmt.Errorf("failed to read request body: %w", &http.MaxBytesError{Limit: 16}))
+
+		// 길이를 알 수 없는 본문 (Content-Length 없음)
+		req, _ := http.NewRequest("POST", "/api/v1/files", io.MultiReader(strings.NewReader(strings.Repeat("x", 32))))
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+		mockBridge.AssertExpectations(t)
+	})
+}
+
+func TestProcessBridgeRequest_MultiValueHeadersAndQuery(t *testing.T) {
+	_, mockBridge, _, _, _, _, router := setupTestHandler()
+
+	expectedResponse := &domain.Response{
+		StatusCode: http.StatusOK,
+		Headers:    http.Header{"Set-Cookie": {"a=1", "b=2"}},
+		Body:       []byte(`{"ok": true}`),
+	}
+
+	var forwarded *domain.Request
+	mockBridge.On("ProcessRequest", mock.Anything, mock.AnythingOfType("*domain.Request")).
+		Run(func(args mock.Arguments) {
+			forwarded = args.Get(1).(*domain.Request)
+		}).
+		Return(expectedResponse, nil)
+
+	req, _ := http.NewRequest("GET", "/api/v1/users?id=1&id=2", nil)
+	req.Header.Add("Accept", "application/json")
+	req.Header.Add("Accept", "text/plain")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, []string{"a=1", "b=2"}, w.Result().Header.Values("Set-Cookie"))
+	if assert.NotNil(t, forwarded) {
+		assert.Equal(t, []string{"1", "2"}, forwarded.QueryParams["id"])
+		assert.Equal(t, []string{"application/json", "text/plain"}, forwarded.Headers.Values("Accept"))
+	}
+	mockBridge.AssertExpectations(t)
+}
+
+func TestProcessBridgeRequest_ForwardsContentType(t *testing.T) {
+	_, mockBridge, _, _, _, _, router := setupTestHandler()
+
+	mockBridge.On("ProcessRequest", mock.Anything, mock.AnythingOfType("*domain.Request")).Return(&domain.Response{
+		StatusCode:  http.StatusOK,
+		Headers:     http.Header{},
+		Body:        []byte("id,name\n1,john\n"),
+		ContentType: "text/csv; charset=utf-8",
+	}, nil)
+
+	req, _ := http.NewRequest("GET", "/api/v1/export", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/csv; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Equal(t, "id,name\n1,john\n", w.Body.String())
+	mockBridge.AssertExpectations(t)
+}
+
+func TestProcessBridgeRequest_ErrorMapping(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		retryAfter string
+	}{
+		{"route not found", fmt.Errorf("no routing rule found: %w", domain.ErrRouteNotFound), http.StatusNotFound, ""},
+		{"circuit open", &domain.CircuitOpenError{Name: "http-client-legacy", RetryAfter: 1500 * time.Millisecond}, http.StatusServiceUnavailable, "2"},
+		{"upstream timeout", fmt.Errorf("request failed after 1 attempts: %w", domain.ErrExternalAPITimeout), http.StatusGatewayTimeout, ""},
+		{"both parallel calls failed", fmt.Errorf("%w: legacy=a, modern=b", domain.ErrAllAPICallsFailed), http.StatusBadGateway, ""},
+		{"upstream failure", fmt.Errorf("request failed after 3 attempts: %w", domain.ErrExternalAPIFailed), http.StatusBadGateway, ""},
+		{"unknown error", assert.AnError, http.StatusInternalServerError, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, mockBridge, _, _, _, _, router := setupTestHandler()
+
+			var traceID string
+			mockBridge.On("ProcessRequest", mock.Anything, mock.AnythingOfType("*domain.Request")).
+				Run(func(args mock.Arguments) {
+					traceID = args.Get(1).(*domain.Request).ID
+				}).
+				Return((*domain.Response)(nil), tt.err)
+
+			req, _ := http.NewRequest("GET", "/api/v1/users", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.Equal(t, tt.retryAfter, w.Header().Get("Retry-After"))
+
+			var body ErrorResponse
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+			assert.True(t, body.Error)
+			assert.NotEmpty(t, body.Message)
+			assert.Empty(t, body.Details)
+			assert.Equal(t, traceID, body.TraceID)
+		})
+	}
+}
+
+func TestProcessBridgeRequest_ErrorBodyHidesUpstreamDetails(t *testing.T) {
+	_, mockBridge, _, _, _, _, router := setupTestHandler()
+
+	dialErr := &net.OpError{Op: "dial", Net: "tcp", Addr: &net.TCPAddr{IP: net.ParseIP("10.0.3.17"), Port: 8080}, Err: errors.New("connection refused")}
+	err := fmt.Errorf("request failed after 3 attempts: %w: %w", domain.ErrExternalAPIFailed, dialErr)
+
+	mockBridge.On("ProcessRequest", mock.Anything, mock.AnythingOfType("*domain.Request")).Return((*domain.Response)(nil), err)
+
+	req, _ := http.NewRequest("POST", "/api/v1/orders", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadGateway, w.Code)
+	assert.NotContains(t, w.Body.String(), "10.0.3.17")
+	assert.NotContains(t, w.Body.String(), "connection refused")
+	assert.NotContains(t, w.Body.String(), "dial tcp")
+
+	var body ErrorResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, "upstream request failed", body.Message)
+	assert.NotEmpty(t, body.TraceID)
+	assert.Empty(t, body.Details)
+}
+
 // Benchmark tests
 func BenchmarkHandleAPIRequest(b *testing.B) {
 	_, mockBridge, _, _, _, _, router := setupTestHandler()
@@ -512,7 +1091,7 @@ func BenchmarkHandleAPIRequest(b *testing.B) {
 	// Mock bridge service response
 	expectedResponse := &domain.Response{
 		StatusCode:  http.StatusOK,
-		Headers:     map[string]string{"Content-Type": "application/json"},
+		Headers:     http.Header{"Content-Type": {"application/json"}},
 		Body:        []byte(`{"message": "success"}`),
 		ContentType: "application/json",
 	}
@@ -542,3 +1121,268 @@ func BenchmarkHealthCheck(b *testing.B) {
 		router.ServeHTTP(w, req)
 	}
 }
+
+type MockCircuitBreakerService struct {
+	mock.Mock
+}
+
+func (m *MockCircuitBreakerService) GetOrCreateBreaker(name string, config domain.CircuitBreakerConfig) *gobreaker.CircuitBreaker {
+	args := m.Called(name, config)
+	return args.Get(0).(*gobreaker.CircuitBreaker)
+}
+
+func (m *MockCircuitBreakerService) Execute(ctx context.Context, breakerName string, config domain.CircuitBreakerConfig, fn func() (interface{}, error)) (interface{}, error) {
+	args := m.Called(ctx, breakerName, config, fn)
+	return args.Get(0), args.Error(1)
+}
+
+func (m *MockCircuitBreakerService) GetBreakerInfo(breakerName string) (*domain.CircuitBreakerInfo, error) {
+	args := m.Called(breakerName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.CircuitBreakerInfo), args.Error(1)
+}
+
+func (m *MockCircuitBreakerService) GetAllBreakerInfos() map[string]*domain.CircuitBreakerInfo {
+	args := m.Called()
+	return args.Get(0).(map[string]*domain.CircuitBreakerInfo)
+}
+
+func (m *MockCircuitBreakerService) ResetBreaker(breakerName string) error {
+	args := m.Called(breakerName)
+	return args.Error(0)
+}
+
+func (m *MockCircuitBreakerService) SetOverride(breakerName string, override domain.CircuitBreakerOverride, reason string, duration time.Duration) error {
+	args := m.Called(breakerName, override, reason, duration)
+	return args.Error(0)
+}
+
+func (m *MockCircuitBreakerService) ClearOverride(breakerName string) error {
+	args := m.Called(breakerName)
+	return args.Error(0)
+}
+
+func (m *MockCircuitBreakerService) Subscribe() (<-chan domain.CircuitBreakerEvent, func()) {
+	args := m.Called()
+	return args.Get(0).(chan domain.CircuitBreakerEvent), args.Get(1).(func())
+}
+
+func setupCircuitBreakerTestHandler(circuitBreakerService *MockCircuitBreakerService) *gin.Engine {
+	var opts []HandlerOption
+	if circuitBreakerService != nil {
+		opts = append(opts, WithCircuitBreakerService(circuitBreakerService))
+	}
+	handler := NewHandler(&MockBridgeService{}, &MockHealthService{}, &MockEndpointService{}, &MockRoutingService{}, &MockOrchestrationService{}, logger.NewLogger(), opts...)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	abs := router.Group("/abs")
+	{
+		abs.GET("/v1/circuit-breakers", handler.ListCircuitBreakers)
+		abs.GET("/v1/circuit-breakers/events", handler.StreamCircuitBreakerEvents)
+		abs.GET("/v1/circuit-breakers/:name", handler.GetCircuitBreaker)
+		abs.POST("/v1/circuit-breakers/:name/reset", handler.ResetCircuitBreaker)
+		abs.PUT("/v1/circuit-breakers/:name/override", handler.SetCircuitBreakerOverride)
+		abs.DELETE("/v1/circuit-breakers/:name/override", handler.ClearCircuitBreakerOverride)
+	}
+	return router
+}
+
+func TestListCircuitBreakers(t *testing.T) {
+	mockCircuitBreaker := &MockCircuitBreakerService{}
+	router := setupCircuitBreakerTestHandler(mockCircuitBreaker)
+
+	openedAt := time.Date(2025, 1, 1, 0, 0, 30, 0, time.UTC)
+	mockCircuitBreaker.On("GetAllBreakerInfos").Return(map[string]*domain.CircuitBreakerInfo{
+		"http-client-modern": {Name: "http-client-modern", State: domain.OPEN, Counts: domain.Counts{Requests: 5, TotalFailures: 5, ConsecutiveFailures: 5}, MaxRequests: 3, Expiry: openedAt, LastError: "upstream responded with status 503"},
+		"http-client-legacy": {Name: "http-client-legacy", State: domain.CLOSED, MaxRequests: 3},
+	})
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/abs/v1/circuit-breakers", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response struct {
+		CircuitBreakers []map[string]interface{} `json:"circuit_breakers"`
+		Count           int                      `json:"count"`
+	}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, 2, response.Count)
+	if assert.Len(t, response.CircuitBreakers, 2) {
+		// 이름순 정렬
+		assert.Equal(t, "http-client-legacy", response.CircuitBreakers[0]["name"])
+		assert.NotContains(t, response.CircuitBreakers[0], "expiry")
+
+		modern := response.CircuitBreakers[1]
+		assert.Equal(t, "OPEN", modern["state"])
+		assert.Equal(t, "2025-01-01T00:00:30Z", modern["expiry"])
+		assert.Equal(t, "upstream responded with status 503", modern["last_error"])
+		assert.Equal(t, float64(5), modern["counts"].(map[string]interface{})["consecutive_failures"])
+	}
+}
+
+func TestGetCircuitBreaker_NotFound(t *testing.T) {
+	mockCircuitBreaker := &MockCircuitBreakerService{}
+	router := setupCircuitBreakerTestHandler(mockCircuitBreaker)
+
+	mockCircuitBreaker.On("GetBreakerInfo", "unknown").Return(nil, fmt.Errorf("%w: 'unknown'", domain.ErrCircuitBreakerNotFound))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/abs/v1/circuit-breakers/unknown", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Body.String(), "circuit breaker not found")
+}
+
+func TestResetCircuitBreaker(t *testing.T) {
+	mockCircuitBreaker := &MockCircuitBreakerService{}
+	router := setupCircuitBreakerTestHandler(mockCircuitBreaker)
+
+	mockCircuitBreaker.On("ResetBreaker", "http-client-modern").Return(nil)
+	mockCircuitBreaker.On("GetBreakerInfo", "http-client-modern").Return(&domain.CircuitBreakerInfo{Name: "http-client-modern", State: domain.CLOSED}, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/abs/v1/circuit-breakers/http-client-modern/reset", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"state":"CLOSED"`)
+	mockCircuitBreaker.AssertExpectations(t)
+}
+
+func TestSetCircuitBreakerOverride(t *testing.T) {
+	mockCircuitBreaker := &MockCircuitBreakerService{}
+	router := setupCircuitBreakerTestHandler(mockCircuitBreaker)
+
+	mockCircuitBreaker.On("SetOverride", "http-client-modern", domain.OverrideForceOpen, "DB maintenance", 30*time.Minute).Return(nil)
+	mockCircuitBreaker.On("GetBreakerInfo", "http-client-modern").Return(&domain.CircuitBreakerInfo{
+		Name:           "http-client-modern",
+		State:          domain.OPEN,
+		Override:       domain.OverrideForceOpen,
+		OverrideReason: "DB maintenance",
+	}, nil)
+
+	w := httptest.NewRecorder()
+	body := `{"state":"OPEN","reason":"DB maintenance","duration":"30m"}`
+	req, _ := http.NewRequest("PUT", "/abs/v1/circuit-breakers/http-client-modern/override", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"override":"FORCED_OPEN"`)
+	mockCircuitBreaker.AssertExpectations(t)
+}
+
+func TestSetCircuitBreakerOverride_InvalidRequest(t *testing.T) {
+	router := setupCircuitBreakerTestHandler(&MockCircuitBreakerService{})
+
+	tests := []struct {
+		name string
+		body string
+	}{
+		{name: "missing state", body: `{"reason":"x"}`},
+		{name: "unknown state", body: `{"state":"HALF_OPEN"}`},
+		{name: "invalid duration", body: `{"state":"CLOSED","duration":"soon"}`},
+		{name: "negative duration", body: `{"state":"CLOSED","duration":"-5m"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("PUT", "/abs/v1/circuit-breakers/http-client-modern/override", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+		})
+	}
+}
+
+func TestClearCircuitBreakerOverride(t *testing.T) {
+	mockCircuitBreaker := &MockCircuitBreakerService{}
+	router := setupCircuitBreakerTestHandler(mockCircuitBreaker)
+
+	mockCircuitBreaker.On("ClearOverride", "http-client-modern").Return(nil)
+	mockCircuitBreaker.On("GetBreakerInfo", "http-client-modern").Return(&domain.CircuitBreakerInfo{Name: "http-client-modern", State: domain.CLOSED}, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("DELETE", "/abs/v1/circuit-breakers/http-client-modern/override", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.NotContains(t, w.Body.String(), "override")
+	mockCircuitBreaker.AssertExpectations(t)
+}
+
+func TestCircuitBreakerRoutes_ServiceNotConfigured(t *testing.T) {
+	router := setupCircuitBreakerTestHandler(nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/abs/v1/circuit-breakers", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+}
+
+func TestStreamCircuitBreakerEvents(t *testing.T) {
+	mockCircuitBreaker := &MockCircuitBreakerService{}
+	router := setupCircuitBreakerTestHandler(mockCircuitBreaker)
+
+	events := make(chan domain.CircuitBreakerEvent, 1)
+	unsubscribed := make(chan struct{})
+	mockCircuitBreaker.On("Subscribe").Return(events, func() { close(unsubscribed) })
+	mockCircuitBreaker.On("GetAllBreakerInfos").Return(map[string]*domain.CircuitBreakerInfo{
+		"http-client-modern": {Name: "http-client-modern", State: domain.CLOSED},
+	})
+
+	// SSE는 응답을 흘려보내야 하므로 실제 서버로 확인
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/abs/v1/circuit-breakers/events")
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, resp.Header.Get("Content-Type"), "text/event-stream")
+
+	reader := bufio.NewReader(resp.Body)
+	readEvent := func() string {
+		var lines []string
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil || line == "\n" {
+				return strings.Join(lines, "")
+			}
+			lines = append(lines, line)
+		}
+	}
+
+	snapshot := readEvent()
+	assert.Contains(t, snapshot, "event:snapshot")
+	assert.Contains(t, snapshot, `"name":"http-client-modern"`)
+
+	events <- domain.CircuitBreakerEvent{
+		Name:      "http-client-modern",
+		From:      domain.CLOSED,
+		To:        domain.OPEN,
+		Cause:     domain.CircuitBreakerCauseStateChange,
+		Timestamp: time.Now(),
+	}
+	change := readEvent()
+	assert.Contains(t, change, "event:state_change")
+	assert.Contains(t, change, `"from":"CLOSED","to":"OPEN","cause":"state_change"`)
+
+	// 클라이언트가 연결을 끊으면 구독 해지
+	resp.Body.Close()
+	select {
+	case <-unsubscribed:
+	case <-time.After(2 * time.Second):
+		t.Fatal("subscription was not cancelled after the client disconnected")
+	}
+}
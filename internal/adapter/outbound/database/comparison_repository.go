@@ -4,6 +4,7 @@ import (
 	"context"
 	"demo-api-bridge/internal/core/domain"
 	"demo-api-bridge/internal/core/port"
+	"fmt"
 	"sort"
 	"sync"
 	"time"
@@ -12,6 +13,7 @@ import (
 // mockComparisonRepository는 ComparisonRepository의 Mock 구현체입니다.
 type mockComparisonRepository struct {
 	comparisons map[string][]*domain.APIComparison
+	rollups     map[string]map[time.Time]*domain.ComparisonRollup // 라우팅 규칙 ID → 일자 → 요약
 	mutex       sync.RWMutex
 }
 
@@ -19,6 +21,7 @@ type mockComparisonRepository struct {
 func NewMockComparisonRepository() port.ComparisonRepository {
 	return &mockComparisonRepository{
 		comparisons: make(map[string][]*domain.APIComparison),
+		rollups:     make(map[string]map[time.Time]*domain.ComparisonRollup),
 	}
 }
 
@@ -27,9 +30,11 @@ func (r *mockComparisonRepository) SaveComparison(ctx context.Context, compariso
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
 
-	// 라우팅 규칙 ID를 키로 사용 (실제로는 비교 객체에서 추출해야 함)
-	// 임시로 request ID의 일부를 사용
-	key := r.extractRoutingRuleID(comparison.RequestID)
+	// 라우팅 규칙 ID를 키로 사용 (없으면 request ID에서 추출)
+	key := comparison.RoutingRuleID
+	if key == "" {
+		key = r.extractRoutingRuleID(comparison.RequestID)
+	}
 
 	// 복사본 생성 (원본 보호)
 	comparisonCopy := *comparison
@@ -94,66 +99,137 @@ func (r *mockComparisonRepository) GetRecentComparisons(ctx context.Context, rou
 	return result, nil
 }
 
-// GetComparisonStatistics는 비교 통계를 조회합니다.
-func (r *mockComparisonRepository) GetComparisonStatistics(ctx context.Context, routingRuleID string, from, to time.Time) (*port.ComparisonStatistics, error) {
+// GetComparison은 ID로 비교 결과를 조회합니다.
+func (r *mockComparisonRepository) GetComparison(ctx context.Context, id string) (*domain.APIComparison, error) {
 	r.mutex.RLock()
 	defer r.mutex.RUnlock()
 
-	comparisons, exists := r.comparisons[routingRuleID]
-	if !exists || len(comparisons) == 0 {
-		return &port.ComparisonStatistics{
-			RoutingRuleID:     routingRuleID,
-			TotalComparisons:  0,
-			SuccessfulMatches: 0,
-			AverageMatchRate:  0.0,
-			LastComparison:    time.Time{},
-		}, nil
+	for _, comparisons := range r.comparisons {
+		for _, comp := range comparisons {
+			if comp.ID != id {
+				continue
+			}
+			// 복사본 생성 (원본 보호)
+			compCopy := *comp
+			if comp.LegacyResponse != nil {
+				legacyCopy := *comp.LegacyResponse
+				compCopy.LegacyResponse = &legacyCopy
+			}
+			if comp.ModernResponse != nil {
+				modernCopy := *comp.ModernResponse
+				compCopy.ModernResponse = &modernCopy
+			}
+			return &compCopy, nil
+		}
 	}
 
-	// 기간 필터링
-	var filteredComparisons []*domain.APIComparison
-	for _, comp := range comparisons {
+	return nil, fmt.Errorf("comparison with ID %s not found", id)
+}
+
+// GetComparisonStatistics는 비교 통계를 조회합니다.
+// 보존 기간이 지나 요약된 비교 결과는 기간 안에 온전히 포함되는 날의 일별 요약만 포함합니다.
+func (r *mockComparisonRepository) GetComparisonStatistics(ctx context.Context, routingRuleID string, from, to time.Time) (*port.ComparisonStatistics, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	// 기간 필터링 후 집계
+	total := &domain.ComparisonRollup{RoutingRuleID: routingRuleID}
+	for _, comp := range r.comparisons[routingRuleID] {
 		if comp.Timestamp.After(from) && comp.Timestamp.Before(to) {
-			filteredComparisons = append(filteredComparisons, comp)
+			total.Add(comp)
 		}
 	}
-
-	if len(filteredComparisons) == 0 {
-		return &port.ComparisonStatistics{
-			RoutingRuleID:     routingRuleID,
-			TotalComparisons:  0,
-			SuccessfulMatches: 0,
-			AverageMatchRate:  0.0,
-			LastComparison:    time.Time{},
-		}, nil
+	first, end := domain.RollupDaysWithin(from, to)
+	for day, rollup := range r.rollups[routingRuleID] {
+		if !day.Before(first) && day.Before(end) {
+			total.Merge(rollup)
+		}
 	}
 
-	// 통계 계산
-	totalComparisons := len(filteredComparisons)
-	successfulMatches := 0
-	var totalMatchRate float64
+	return &port.ComparisonStatistics{
+		RoutingRuleID:     routingRuleID,
+		TotalComparisons:  total.TotalComparisons,
+		SuccessfulMatches: total.SuccessfulMatches,
+		AverageMatchRate:  total.AverageMatchRate(),
+		ModernErrors:      total.ModernErrors,
+		AverageLatency:    total.AverageModernLatency(),
+		LastComparison:    total.LastComparison,
+	}, nil
+}
+
+// GetComparisonInsights는 기간 내 비교 결과를 불일치 경로, 차이점 유형, 시간대별 일치율로 집계합니다.
+func (r *mockComparisonRepository) GetComparisonInsights(ctx context.Context, routingRuleID string, from, to time.Time, bucket domain.InsightBucket, limit int) (*domain.ComparisonInsights, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
 
-	var lastComparison time.Time
-	for _, comp := range filteredComparisons {
-		if comp.IsSuccessful() {
-			successfulMatches++
+	insights := domain.NewComparisonInsights(routingRuleID, from, to, bucket, limit)
+	for _, comp := range r.comparisons[routingRuleID] {
+		if comp.Timestamp.Before(from) || comp.Timestamp.After(to) {
+			continue
 		}
-		totalMatchRate += comp.MatchRate
+		insights.Add(comp.MatchRate, comp.Differences, comp.Timestamp)
+	}
+
+	return insights.Finalize(), nil
+}
 
-		if comp.Timestamp.After(lastComparison) {
-			lastComparison = comp.Timestamp
+// StripComparisonBodies는 before 이전 비교 결과의 응답 본문을 지웁니다.
+func (r *mockComparisonRepository) StripComparisonBodies(ctx context.Context, before time.Time) (int, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	stripped := 0
+	for _, comparisons := range r.comparisons {
+		for _, comp := range comparisons {
+			if !comp.Timestamp.Before(before) {
+				continue
+			}
+			changed := false
+			for _, response := range []*domain.Response{comp.LegacyResponse, comp.ModernResponse} {
+				if response != nil && response.Body != nil {
+					response.Body = nil
+					changed = true
+				}
+			}
+			if changed {
+				stripped++
+			}
 		}
 	}
 
-	averageMatchRate := totalMatchRate / float64(totalComparisons)
+	return stripped, nil
+}
 
-	return &port.ComparisonStatistics{
-		RoutingRuleID:     routingRuleID,
-		TotalComparisons:  totalComparisons,
-		SuccessfulMatches: successfulMatches,
-		AverageMatchRate:  averageMatchRate,
-		LastComparison:    lastComparison,
-	}, nil
+// RollupComparisons는 before 이전 비교 결과를 규칙별 일별 요약에 합산한 뒤 삭제합니다.
+func (r *mockComparisonRepository) RollupComparisons(ctx context.Context, before time.Time) (int, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	purged := 0
+	for key, comparisons := range r.comparisons {
+		kept := comparisons[:0]
+		for _, comp := range comparisons {
+			if !comp.Timestamp.Before(before) {
+				kept = append(kept, comp)
+				continue
+			}
+
+			day := domain.RollupDay(comp.Timestamp)
+			if r.rollups[key] == nil {
+				r.rollups[key] = make(map[time.Time]*domain.ComparisonRollup)
+			}
+			rollup, exists := r.rollups[key][day]
+			if !exists {
+				rollup = &domain.ComparisonRollup{RoutingRuleID: key, Day: day}
+				r.rollups[key][day] = rollup
+			}
+			rollup.Add(comp)
+			purged++
+		}
+		r.comparisons[key] = kept
+	}
+
+	return purged, nil
 }
 
 // extractRoutingRuleID는 요청 ID에서 라우팅 규칙 ID를 추출합니다.
@@ -3,6 +3,7 @@ package database
 import (
 	"context"
 	"demo-api-bridge/internal/core/domain"
+	"fmt"
 	"testing"
 	"time"
 )
@@ -227,3 +228,185 @@ func TestMockCacheRepository_GetOrSet(t *testing.T) {
 		t.Errorf("Expected cached value %s, got %s", string(value), string(retrieved))
 	}
 }
+
+func TestMockComparisonRepository_StatisticsByRoutingRule(t *testing.T) {
+	repo := NewMockComparisonRepository()
+	ctx := context.Background()
+	from := time.Now().Add(-time.Minute)
+
+	ok := domain.NewAPIComparison("cmp1", "request-000001", "route-1", &domain.Response{StatusCode: 200}, &domain.Response{StatusCode: 200})
+	ok.MatchRate = 1.0
+	failed := domain.NewAPIComparison("cmp2", "request-000002", "route-1", &domain.Response{StatusCode: 200}, nil)
+	other := domain.NewAPIComparison("cmp3", "request-000003", "route-2", &domain.Response{StatusCode: 200}, &domain.Response{StatusCode: 503})
+
+	for _, comparison := range []*domain.APIComparison{ok, failed, other} {
+		if err := repo.SaveComparison(ctx, comparison); err != nil {
+			t.Fatalf("SaveComparison failed: %v", err)
+		}
+	}
+
+	stats, err := repo.GetComparisonStatistics(ctx, "route-1", from, time.Now().Add(time.Minute))
+	if err != nil {
+		t.Fatalf("GetComparisonStatistics failed: %v", err)
+	}
+
+	if stats.TotalComparisons != 2 {
+		t.Errorf("Expected 2 comparisons, got %d", stats.TotalComparisons)
+	}
+	if stats.ModernErrors != 1 {
+		t.Errorf("Expected 1 modern error, got %d", stats.ModernErrors)
+	}
+	if stats.AverageMatchRate != 0.5 {
+		t.Errorf("Expected average match rate 0.5, got %f", stats.AverageMatchRate)
+	}
+}
+
+func TestMockComparisonRepository_Insights(t *testing.T) {
+	repo := NewMockComparisonRepository()
+	ctx := context.Background()
+	from := time.Now().Add(-time.Minute)
+
+	first := domain.NewAPIComparison("cmp1", "request-000001", "route-1", &domain.Response{StatusCode: 200}, &domain.Response{StatusCode: 200})
+	first.MatchRate = 0.5
+	first.Differences = []domain.ResponseDiff{{Type: domain.VALUE_MISMATCH, Dimension: domain.DimensionBody, Path: "items[0].price"}}
+	second := domain.NewAPIComparison("cmp2", "request-000002", "route-1", &domain.Response{StatusCode: 200}, &domain.Response{StatusCode: 200})
+	second.MatchRate = 0.7
+	second.Differences = []domain.ResponseDiff{{Type: domain.VALUE_MISMATCH, Dimension: domain.DimensionBody, Path: "items[2].price"}}
+	other := domain.NewAPIComparison("cmp3", "request-000003", "route-2", &domain.Response{StatusCode: 200}, &domain.Response{StatusCode: 200})
+	other.Differences = []domain.ResponseDiff{{Type: domain.MISSING, Dimension: domain.DimensionBody, Path: "name"}}
+
+	for _, comparison := range []*domain.APIComparison{first, second, other} {
+		if err := repo.SaveComparison(ctx, comparison); err != nil {
+			t.Fatalf("SaveComparison failed: %v", err)
+		}
+	}
+
+	insights, err := repo.GetComparisonInsights(ctx, "route-1", from, time.Now().Add(time.Minute), domain.InsightBucketDay, 10)
+	if err != nil {
+		t.Fatalf("GetComparisonInsights failed: %v", err)
+	}
+
+	if insights.TotalComparisons != 2 {
+		t.Errorf("Expected 2 comparisons, got %d", insights.TotalComparisons)
+	}
+	if len(insights.TopPaths) != 1 || insights.TopPaths[0].Path != "items[*].price" || insights.TopPaths[0].Count != 2 {
+		t.Errorf("Expected items[*].price twice, got %+v", insights.TopPaths)
+	}
+	if insights.DiffTypeCounts[domain.MISSING] != 0 {
+		t.Errorf("Expected no diffs from other routing rules, got %v", insights.DiffTypeCounts)
+	}
+}
+
+func TestMockComparisonRepository_Retention(t *testing.T) {
+	repo := NewMockComparisonRepository()
+	ctx := context.Background()
+	now := time.Now()
+	old := now.Add(-40 * 24 * time.Hour)
+
+	stale := domain.NewAPIComparison("cmp1", "request-000001", "route-1",
+		&domain.Response{StatusCode: 200, Body: []byte(`{"a":1}`)}, &domain.Response{StatusCode: 200, Body: []byte(`{"a":1}`)})
+	stale.MatchRate = 1.0
+	stale.Timestamp = old
+	aging := domain.NewAPIComparison("cmp2", "request-000002", "route-1",
+		&domain.Response{StatusCode: 200, Body: []byte(`{"a":1}`)}, &domain.Response{StatusCode: 200, Body: []byte(`{"a":2}`)})
+	aging.MatchRate = 0.5
+	aging.Timestamp = now.Add(-10 * 24 * time.Hour)
+	fresh := domain.NewAPIComparison("cmp3", "request-000003", "route-1",
+		&domain.Response{StatusCode: 200, Body: []byte(`{"a":1}`)}, &domain.Response{StatusCode: 200, Body: []byte(`{"a":1}`)})
+	fresh.MatchRate = 1.0
+
+	for _, comparison := range []*domain.APIComparison{stale, aging, fresh} {
+		if err := repo.SaveComparison(ctx, comparison); err != nil {
+			t.Fatalf("SaveComparison failed: %v", err)
+		}
+	}
+
+	stripped, err := repo.StripComparisonBodies(ctx, now.Add(-7*24*time.Hour))
+	if err != nil {
+		t.Fatalf("StripComparisonBodies failed: %v", err)
+	}
+	if stripped != 2 {
+		t.Errorf("Expected 2 stripped comparisons, got %d", stripped)
+	}
+
+	purged, err := repo.RollupComparisons(ctx, now.Add(-30*24*time.Hour))
+	if err != nil {
+		t.Fatalf("RollupComparisons failed: %v", err)
+	}
+	if purged != 1 {
+		t.Errorf("Expected 1 purged comparison, got %d", purged)
+	}
+
+	if _, err := repo.GetComparison(ctx, "cmp1"); err == nil {
+		t.Error("Expected purged comparison to be deleted")
+	}
+	kept, err := repo.GetComparison(ctx, "cmp2")
+	if err != nil {
+		t.Fatalf("GetComparison failed: %v", err)
+	}
+	if kept.ModernResponse.Body != nil {
+		t.Errorf("Expected stripped body, got %s", kept.ModernResponse.Body)
+	}
+
+	// 삭제된 비교 결과도 일별 요약으로 통계에 포함
+	stats, err := repo.GetComparisonStatistics(ctx, "route-1", domain.RollupDay(old), now.Add(time.Minute))
+	if err != nil {
+		t.Fatalf("GetComparisonStatistics failed: %v", err)
+	}
+	if stats.TotalComparisons != 3 {
+		t.Errorf("Expected 3 comparisons including rollups, got %d", stats.TotalComparisons)
+	}
+	if stats.SuccessfulMatches != 2 {
+		t.Errorf("Expected 2 successful matches, got %d", stats.SuccessfulMatches)
+	}
+
+	// 요약된 날을 일부만 포함하는 기간에는 해당 일별 요약을 포함하지 않음
+	partial, err := repo.GetComparisonStatistics(ctx, "route-1", old.Add(-time.Minute), old.Add(time.Minute))
+	if err != nil {
+		t.Fatalf("GetComparisonStatistics failed: %v", err)
+	}
+	if partial.TotalComparisons != 0 {
+		t.Errorf("Expected partially covered rollup day to be excluded, got %d comparisons", partial.TotalComparisons)
+	}
+}
+
+func TestMockTransitionHistoryRepository_FindByRuleID(t *testing.T) {
+	repo := NewMockTransitionHistoryRepository()
+	ctx := context.Background()
+	now := time.Now()
+
+	for i, percentage := range []int{1, 5, 25} {
+		event := &domain.TransitionEvent{
+			ID:           fmt.Sprintf("event%d", i),
+			RuleID:       "orch-1",
+			FromMode:     domain.CANARY,
+			ToMode:       domain.CANARY,
+			ToPercentage: percentage,
+			Trigger:      domain.TransitionTriggerAuto,
+			Timestamp:    now.Add(time.Duration(i) * time.Minute),
+		}
+		if err := repo.Save(ctx, event); err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+	}
+
+	events, err := repo.FindByRuleID(ctx, "orch-1", 2)
+	if err != nil {
+		t.Fatalf("FindByRuleID failed: %v", err)
+	}
+
+	if len(events) != 2 {
+		t.Fatalf("Expected 2 events, got %d", len(events))
+	}
+	if events[0].ToPercentage != 25 || events[1].ToPercentage != 5 {
+		t.Errorf("Expected newest first, got %d, %d", events[0].ToPercentage, events[1].ToPercentage)
+	}
+
+	empty, err := repo.FindByRuleID(ctx, "orch-unknown", 10)
+	if err != nil {
+		t.Fatalf("FindByRuleID failed: %v", err)
+	}
+	if len(empty) != 0 {
+		t.Errorf("Expected no events, got %d", len(empty))
+	}
+}
@@ -6,7 +6,9 @@ import (
 	"demo-api-bridge/internal/core/domain"
 	"demo-api-bridge/internal/core/port"
 	"demo-api-bridge/pkg/config"
+	"errors"
 	"fmt"
+	"math"
 	"time"
 
 	_ "github.com/sijms/go-ora/v2"
@@ -49,11 +51,12 @@ func NewOracleRoutingRepository(cfg *config.DatabaseConfig) (port.RoutingReposit
 func (r *oracleRoutingRepository) Create(ctx context.Context, rule *domain.RoutingRule) error {
 	query := `
 		INSERT INTO routing_rules (
-			id, name, description, method, path_pattern, 
+			id, name, description, method, path_pattern, rewrite_template,
 			headers, query_params, legacy_endpoint_id, modern_endpoint_id,
-			created_at, updated_at
+			mirror_allow_unsafe_methods, mirror_dry_run_endpoint_id, mirror_dry_run_header,
+			mirror_legacy_dry_run_endpoint_id, created_at, updated_at
 		) VALUES (
-			:1, :2, :3, :4, :5, :6, :7, :8, :9, :10, :11
+			:1, :2, :3, :4, :5, :6, :7, :8, :9, :10, :11, :12, :13, :14, :15, :16
 		)
 	`
 
@@ -63,10 +66,15 @@ func (r *oracleRoutingRepository) Create(ctx context.Context, rule *domain.Routi
 		rule.Description,
 		rule.Method,
 		rule.PathPattern,
+		rule.RewriteTemplate,
 		rule.Headers,
 		rule.QueryParams,
 		rule.LegacyEndpointID,
 		rule.ModernEndpointID,
+		rule.MirrorPolicy.AllowUnsafeMethods,
+		rule.MirrorPolicy.DryRunEndpointID,
+		rule.MirrorPolicy.DryRunHeader,
+		rule.MirrorPolicy.LegacyDryRunEndpointID,
 		time.Now(),
 		time.Now(),
 	)
@@ -86,12 +94,17 @@ func (r *oracleRoutingRepository) Update(ctx context.Context, rule *domain.Routi
 			description = :2,
 			method = :3,
 			path_pattern = :4,
-			headers = :5,
-			query_params = :6,
-			legacy_endpoint_id = :7,
-			modern_endpoint_id = :8,
-			updated_at = :9
-		WHERE id = :10
+			rewrite_template = :5,
+			headers = :6,
+			query_params = :7,
+			legacy_endpoint_id = :8,
+			modern_endpoint_id = :9,
+			mirror_allow_unsafe_methods = :10,
+			mirror_dry_run_endpoint_id = :11,
+			mirror_dry_run_header = :12,
+			mirror_legacy_dry_run_endpoint_id = :13,
+			updated_at = :14
+		WHERE id = :15
 	`
 
 	result, err := r.db.ExecContext(ctx, query,
@@ -99,10 +112,15 @@ func (r *oracleRoutingRepository) Update(ctx context.Context, rule *domain.Routi
 		rule.Description,
 		rule.Method,
 		rule.PathPattern,
+		rule.RewriteTemplate,
 		rule.Headers,
 		rule.QueryParams,
 		rule.LegacyEndpointID,
 		rule.ModernEndpointID,
+		rule.MirrorPolicy.AllowUnsafeMethods,
+		rule.MirrorPolicy.DryRunEndpointID,
+		rule.MirrorPolicy.DryRunHeader,
+		rule.MirrorPolicy.LegacyDryRunEndpointID,
 		time.Now(),
 		rule.ID,
 	)
@@ -147,15 +165,17 @@ func (r *oracleRoutingRepository) Delete(ctx context.Context, ruleID string) err
 // FindByID는 ID로 라우팅 규칙을 조회합니다.
 func (r *oracleRoutingRepository) FindByID(ctx context.Context, ruleID string) (*domain.RoutingRule, error) {
 	query := `
-		SELECT id, name, description, method, path_pattern,
+		SELECT id, name, description, method, path_pattern, rewrite_template,
 		       headers, query_params, legacy_endpoint_id, modern_endpoint_id,
-		       created_at, updated_at
+		       mirror_allow_unsafe_methods, mirror_dry_run_endpoint_id, mirror_dry_run_header,
+		       mirror_legacy_dry_run_endpoint_id, created_at, updated_at
 		FROM routing_rules
 		WHERE id = :1
 	`
 
 	var rule domain.RoutingRule
 	var createdAt, updatedAt time.Time
+	var rewriteTemplate, dryRunEndpointID, legacyDryRunEndpointID sql.NullString
 
 	err := r.db.QueryRowContext(ctx, query, ruleID).Scan(
 		&rule.ID,
@@ -163,10 +183,15 @@ func (r *oracleRoutingRepository) FindByID(ctx context.Context, ruleID string) (
 		&rule.Description,
 		&rule.Method,
 		&rule.PathPattern,
+		&rewriteTemplate,
 		&rule.Headers,
 		&rule.QueryParams,
 		&rule.LegacyEndpointID,
 		&rule.ModernEndpointID,
+		&rule.MirrorPolicy.AllowUnsafeMethods,
+		&dryRunEndpointID,
+		&rule.MirrorPolicy.DryRunHeader,
+		&legacyDryRunEndpointID,
 		&createdAt,
 		&updatedAt,
 	)
@@ -180,6 +205,9 @@ func (r *oracleRoutingRepository) FindByID(ctx context.Context, ruleID string) (
 
 	rule.CreatedAt = createdAt
 	rule.UpdatedAt = updatedAt
+	rule.RewriteTemplate = rewriteTemplate.String
+	rule.MirrorPolicy.DryRunEndpointID = dryRunEndpointID.String
+	rule.MirrorPolicy.LegacyDryRunEndpointID = legacyDryRunEndpointID.String
 
 	return &rule, nil
 }
@@ -187,9 +215,10 @@ func (r *oracleRoutingRepository) FindByID(ctx context.Context, ruleID string) (
 // FindAll은 모든 라우팅 규칙을 조회합니다.
 func (r *oracleRoutingRepository) FindAll(ctx context.Context) ([]*domain.RoutingRule, error) {
 	query := `
-		SELECT id, name, description, method, path_pattern,
+		SELECT id, name, description, method, path_pattern, rewrite_template,
 		       headers, query_params, legacy_endpoint_id, modern_endpoint_id,
-		       created_at, updated_at
+		       mirror_allow_unsafe_methods, mirror_dry_run_endpoint_id, mirror_dry_run_header,
+		       mirror_legacy_dry_run_endpoint_id, created_at, updated_at
 		FROM routing_rules
 		ORDER BY created_at DESC
 	`
@@ -204,6 +233,7 @@ func (r *oracleRoutingRepository) FindAll(ctx context.Context) ([]*domain.Routin
 	for rows.Next() {
 		var rule domain.RoutingRule
 		var createdAt, updatedAt time.Time
+		var rewriteTemplate, dryRunEndpointID, legacyDryRunEndpointID sql.NullString
 
 		err := rows.Scan(
 			&rule.ID,
@@ -211,10 +241,15 @@ func (r *oracleRoutingRepository) FindAll(ctx context.Context) ([]*domain.Routin
 			&rule.Description,
 			&rule.Method,
 			&rule.PathPattern,
+			&rewriteTemplate,
 			&rule.Headers,
 			&rule.QueryParams,
 			&rule.LegacyEndpointID,
 			&rule.ModernEndpointID,
+			&rule.MirrorPolicy.AllowUnsafeMethods,
+			&dryRunEndpointID,
+			&rule.MirrorPolicy.DryRunHeader,
+			&legacyDryRunEndpointID,
 			&createdAt,
 			&updatedAt,
 		)
@@ -225,6 +260,9 @@ func (r *oracleRoutingRepository) FindAll(ctx context.Context) ([]*domain.Routin
 
 		rule.CreatedAt = createdAt
 		rule.UpdatedAt = updatedAt
+		rule.RewriteTemplate = rewriteTemplate.String
+		rule.MirrorPolicy.DryRunEndpointID = dryRunEndpointID.String
+		rule.MirrorPolicy.LegacyDryRunEndpointID = legacyDryRunEndpointID.String
 		rules = append(rules, &rule)
 	}
 
@@ -238,9 +276,10 @@ func (r *oracleRoutingRepository) FindAll(ctx context.Context) ([]*domain.Routin
 // FindMatchingRules는 요청에 매칭되는 라우팅 규칙들을 조회합니다.
 func (r *oracleRoutingRepository) FindMatchingRules(ctx context.Context, request *domain.Request) ([]*domain.RoutingRule, error) {
 	query := `
-		SELECT id, name, description, method, path_pattern,
+		SELECT id, name, description, method, path_pattern, rewrite_template,
 		       headers, query_params, legacy_endpoint_id, modern_endpoint_id,
-		       created_at, updated_at
+		       mirror_allow_unsafe_methods, mirror_dry_run_endpoint_id, mirror_dry_run_header,
+		       mirror_legacy_dry_run_endpoint_id, created_at, updated_at
 		FROM routing_rules
 		WHERE method = :1 AND path_pattern LIKE :2
 		ORDER BY created_at DESC
@@ -259,6 +298,7 @@ func (r *oracleRoutingRepository) FindMatchingRules(ctx context.Context, request
 	for rows.Next() {
 		var rule domain.RoutingRule
 		var createdAt, updatedAt time.Time
+		var rewriteTemplate, dryRunEndpointID, legacyDryRunEndpointID sql.NullString
 
 		err := rows.Scan(
 			&rule.ID,
@@ -266,10 +306,15 @@ func (r *oracleRoutingRepository) FindMatchingRules(ctx context.Context, request
 			&rule.Description,
 			&rule.Method,
 			&rule.PathPattern,
+			&rewriteTemplate,
 			&rule.Headers,
 			&rule.QueryParams,
 			&rule.LegacyEndpointID,
 			&rule.ModernEndpointID,
+			&rule.MirrorPolicy.AllowUnsafeMethods,
+			&dryRunEndpointID,
+			&rule.MirrorPolicy.DryRunHeader,
+			&legacyDryRunEndpointID,
 			&createdAt,
 			&updatedAt,
 		)
@@ -280,6 +325,9 @@ func (r *oracleRoutingRepository) FindMatchingRules(ctx context.Context, request
 
 		rule.CreatedAt = createdAt
 		rule.UpdatedAt = updatedAt
+		rule.RewriteTemplate = rewriteTemplate.String
+		rule.MirrorPolicy.DryRunEndpointID = dryRunEndpointID.String
+		rule.MirrorPolicy.LegacyDryRunEndpointID = legacyDryRunEndpointID.String
 
 		// 실제 매칭 로직 확인
 		if match, err := rule.Matches(request); err != nil {
@@ -969,26 +1017,35 @@ func NewOracleComparisonRepository(cfg *config.DatabaseConfig) (port.ComparisonR
 	return &oracleComparisonRepository{db: db}, nil
 }
 
+// comparisonColumns는 comparison_logs 조회 시 scanComparison이 읽는 컬럼 목록입니다.
+const comparisonColumns = `id, request_id, routing_rule_id, orchestration_rule_id,
+		       old_response, new_response,
+		       match_rate, strict_mode, difference_details, comparison_duration_ms,
+		       created_at`
+
 // SaveComparison은 API 비교 결과를 저장합니다.
+// 응답 원문은 JSON으로, 통계에 쓰는 일치율/상태 코드/응답 시간은 컬럼으로 함께 저장합니다.
 func (r *oracleComparisonRepository) SaveComparison(ctx context.Context, comparison *domain.APIComparison) error {
 	query := `
-		INSERT INTO api_comparisons (
-			id, request_id, routing_rule_id,
-			legacy_response, modern_response,
-			match_rate, differences, comparison_duration,
-			created_at
+		INSERT INTO comparison_logs (
+			id, request_id, routing_rule_id, orchestration_rule_id,
+			old_response, new_response,
+			match_rate, is_matched, strict_mode, difference_details,
+			legacy_status_code, modern_status_code,
+			legacy_latency_ms, modern_latency_ms,
+			comparison_duration_ms, created_at
 		) VALUES (
-			:1, :2, :3, :4, :5, :6, :7, :8, :9
+			:1, :2, :3, :4, :5, :6, :7, :8, :9, :10, :11, :12, :13, :14, :15, :16
 		)
 	`
 
-	// 응답을 JSON으로 직렬화
-	legacyResponseJSON, err := comparison.LegacyResponse.ToJSON()
+	// 응답을 JSON으로 직렬화 (호출 실패로 응답이 없으면 NULL)
+	legacyResponseJSON, err := responseToJSON(comparison.LegacyResponse)
 	if err != nil {
 		return fmt.Errorf("failed to serialize legacy response: %w", err)
 	}
 
-	modernResponseJSON, err := comparison.ModernResponse.ToJSON()
+	modernResponseJSON, err := responseToJSON(comparison.ModernResponse)
 	if err != nil {
 		return fmt.Errorf("failed to serialize modern response: %w", err)
 	}
@@ -998,16 +1055,32 @@ func (r *oracleComparisonRepository) SaveComparison(ctx context.Context, compari
 		return fmt.Errorf("failed to serialize differences: %w", err)
 	}
 
+	legacyStatusCode, legacyLatencyMs := responseMetrics(comparison.LegacyResponse)
+	modernStatusCode, modernLatencyMs := responseMetrics(comparison.ModernResponse)
+
+	// 비교 시점을 저장 시간으로 사용 (기간 통계와 보존 정책 기준)
+	createdAt := comparison.Timestamp
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+
 	_, err = r.db.ExecContext(ctx, query,
 		comparison.ID,
 		comparison.RequestID,
 		comparison.RoutingRuleID,
+		comparison.OrchestrationRuleID,
 		legacyResponseJSON,
 		modernResponseJSON,
 		comparison.MatchRate,
-		differencesJSON,
-		comparison.ComparisonDuration.Milliseconds(),
-		time.Now(),
+		comparison.IsSuccessful(),
+		comparison.StrictMode,
+		string(differencesJSON),
+		legacyStatusCode,
+		modernStatusCode,
+		legacyLatencyMs,
+		modernLatencyMs,
+		durationToMillis(comparison.ComparisonDuration),
+		createdAt,
 	)
 
 	if err != nil {
@@ -1018,16 +1091,18 @@ func (r *oracleComparisonRepository) SaveComparison(ctx context.Context, compari
 }
 
 // GetRecentComparisons는 최근 비교 결과들을 조회합니다.
+// 최근 limit개를 시간 오름차순(최신이 뒤)으로 반환합니다.
 func (r *oracleComparisonRepository) GetRecentComparisons(ctx context.Context, routingRuleID string, limit int) ([]*domain.APIComparison, error) {
 	query := `
-		SELECT id, request_id, routing_rule_id,
-		       legacy_response, modern_response,
-		       match_rate, differences, comparison_duration,
-		       created_at
-		FROM api_comparisons
-		WHERE routing_rule_id = :1
-		ORDER BY created_at DESC
-		FETCH FIRST :2 ROWS ONLY
+		SELECT ` + comparisonColumns + `
+		FROM (
+			SELECT *
+			FROM comparison_logs
+			WHERE routing_rule_id = :1
+			ORDER BY created_at DESC
+			FETCH FIRST :2 ROWS ONLY
+		)
+		ORDER BY created_at ASC
 	`
 
 	rows, err := r.db.QueryContext(ctx, query, routingRuleID, limit)
@@ -1036,86 +1111,188 @@ func (r *oracleComparisonRepository) GetRecentComparisons(ctx context.Context, r
 	}
 	defer rows.Close()
 
-	var comparisons []*domain.APIComparison
+	comparisons := []*domain.APIComparison{}
 	for rows.Next() {
-		var comparison domain.APIComparison
-		var legacyResponseJSON, modernResponseJSON, differencesJSON string
-		var comparisonDurationMs int64
-		var createdAt time.Time
-
-		err := rows.Scan(
-			&comparison.ID,
-			&comparison.RequestID,
-			&comparison.RoutingRuleID,
-			&legacyResponseJSON,
-			&modernResponseJSON,
-			&comparison.MatchRate,
-			&differencesJSON,
-			&comparisonDurationMs,
-			&createdAt,
-		)
-
+		comparison, err := scanComparison(rows)
 		if err != nil {
-			return nil, fmt.Errorf("failed to scan comparison: %w", err)
+			return nil, err
 		}
+		comparisons = append(comparisons, comparison)
+	}
 
-		// JSON을 도메인 객체로 역직렬화
-		if legacyResponseJSON != "" {
-			comparison.LegacyResponse, err = domain.ResponseFromJSON([]byte(legacyResponseJSON))
-			if err != nil {
-				return nil, fmt.Errorf("failed to deserialize legacy response: %w", err)
-			}
-		}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating comparisons: %w", err)
+	}
 
-		if modernResponseJSON != "" {
-			comparison.ModernResponse, err = domain.ResponseFromJSON([]byte(modernResponseJSON))
-			if err != nil {
-				return nil, fmt.Errorf("failed to deserialize modern response: %w", err)
-			}
+	return comparisons, nil
+}
+
+// GetComparison은 ID로 비교 결과를 조회합니다.
+func (r *oracleComparisonRepository) GetComparison(ctx context.Context, id string) (*domain.APIComparison, error) {
+	query := `
+		SELECT ` + comparisonColumns + `
+		FROM comparison_logs
+		WHERE id = :1
+	`
+
+	comparison, err := scanComparison(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("comparison with ID %s not found", id)
 		}
+		return nil, err
+	}
+
+	return comparison, nil
+}
+
+// scanComparison은 comparisonColumns 순서의 comparison_logs 행 하나를 비교 결과로 변환합니다.
+func scanComparison(scanner interface {
+	Scan(dest ...interface{}) error
+}) (*domain.APIComparison, error) {
+	var comparison domain.APIComparison
+	var orchestrationRuleID, legacyResponseJSON, modernResponseJSON, differencesJSON sql.NullString
+	var matchRate, comparisonDurationMs sql.NullFloat64
+	var createdAt time.Time
+
+	err := scanner.Scan(
+		&comparison.ID,
+		&comparison.RequestID,
+		&comparison.RoutingRuleID,
+		&orchestrationRuleID,
+		&legacyResponseJSON,
+		&modernResponseJSON,
+		&matchRate,
+		&comparison.StrictMode,
+		&differencesJSON,
+		&comparisonDurationMs,
+		&createdAt,
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan comparison: %w", err)
+	}
+
+	// JSON을 도메인 객체로 역직렬화
+	comparison.LegacyResponse, err = responseFromJSON(legacyResponseJSON)
+	if err != nil {
+		return nil, fmt.Errorf("failed to deserialize legacy response: %w", err)
+	}
 
-		comparison.Differences, err = domain.ResponseDiffsFromJSON([]byte(differencesJSON))
+	comparison.ModernResponse, err = responseFromJSON(modernResponseJSON)
+	if err != nil {
+		return nil, fmt.Errorf("failed to deserialize modern response: %w", err)
+	}
+
+	if differencesJSON.Valid && differencesJSON.String != "" {
+		comparison.Differences, err = domain.ResponseDiffsFromJSON([]byte(differencesJSON.String))
 		if err != nil {
 			return nil, fmt.Errorf("failed to deserialize differences: %w", err)
 		}
+	}
 
-		comparison.ComparisonDuration = time.Duration(comparisonDurationMs) * time.Millisecond
-		comparison.CreatedAt = createdAt
+	comparison.OrchestrationRuleID = orchestrationRuleID.String
+	comparison.MatchRate = matchRate.Float64
+	comparison.ComparisonDuration = millisToDuration(comparisonDurationMs.Float64)
+	comparison.Timestamp = createdAt
+	comparison.CreatedAt = createdAt
 
-		comparisons = append(comparisons, &comparison)
+	return &comparison, nil
+}
+
+// responseToJSON은 응답을 JSON으로 직렬화합니다. 응답이 없으면 NULL로 저장하도록 nil을 반환합니다.
+func responseToJSON(response *domain.Response) (interface{}, error) {
+	if response == nil {
+		return nil, nil
 	}
+	data, err := response.ToJSON()
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
 
-	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("error iterating comparisons: %w", err)
+// responseFromJSON은 저장된 응답 JSON을 역직렬화합니다. NULL이면 응답이 없었던 것으로 nil을 반환합니다.
+func responseFromJSON(data sql.NullString) (*domain.Response, error) {
+	if !data.Valid || data.String == "" || data.String == "null" {
+		return nil, nil
+	}
+	return domain.ResponseFromJSON([]byte(data.String))
+}
+
+// responseMetrics는 응답의 상태 코드와 응답 시간(밀리초) 컬럼 값을 반환합니다. 응답이 없으면 둘 다 NULL입니다.
+func responseMetrics(response *domain.Response) (interface{}, interface{}) {
+	if response == nil {
+		return nil, nil
 	}
+	return response.StatusCode, durationToMillis(response.Duration)
+}
 
-	return comparisons, nil
+// durationToMillis는 time.Duration을 소수점 이하(마이크로초)까지 포함한 밀리초로 변환합니다.
+func durationToMillis(d time.Duration) float64 {
+	return float64(d.Microseconds()) / 1000
 }
 
-// GetComparisonStatistics는 비교 통계를 조회합니다.
+// millisToDuration은 밀리초 값을 time.Duration으로 변환합니다.
+func millisToDuration(ms float64) time.Duration {
+	return time.Duration(math.Round(ms*1000)) * time.Microsecond
+}
+
+// GetComparisonStatistics는 비교 통계를 조회합니다. 기간은 양 끝을 포함하지 않습니다 (from < created_at < to).
+// 보존 기간이 지나 삭제된 비교 결과는 기간 안에 온전히 포함되는 날의 일별 요약(comparison_daily_rollups)만 포함합니다.
 func (r *oracleComparisonRepository) GetComparisonStatistics(ctx context.Context, routingRuleID string, from, to time.Time) (*port.ComparisonStatistics, error) {
 	query := `
-		SELECT 
-			COUNT(*) as total_comparisons,
-			COUNT(CASE WHEN match_rate >= 0.95 THEN 1 END) as successful_matches,
-			AVG(match_rate) as average_match_rate,
-			MAX(created_at) as last_comparison
-		FROM api_comparisons
-		WHERE routing_rule_id = :1
-		AND created_at BETWEEN :2 AND :3
+		SELECT
+			NVL(SUM(total_comparisons), 0),
+			NVL(SUM(successful_matches), 0),
+			SUM(match_rate_sum),
+			NVL(SUM(modern_errors), 0),
+			NVL(SUM(modern_responses), 0),
+			SUM(modern_latency_ms_sum),
+			MAX(last_comparison)
+		FROM (
+			SELECT
+				COUNT(*) as total_comparisons,
+				SUM(is_matched) as successful_matches,
+				SUM(match_rate) as match_rate_sum,
+				COUNT(CASE WHEN modern_status_code IS NULL OR modern_status_code >= 500 THEN 1 END) as modern_errors,
+				COUNT(modern_latency_ms) as modern_responses,
+				SUM(modern_latency_ms) as modern_latency_ms_sum,
+				MAX(created_at) as last_comparison
+			FROM comparison_logs
+			WHERE routing_rule_id = :1
+			AND created_at > :2 AND created_at < :3
+			UNION ALL
+			SELECT
+				SUM(total_comparisons),
+				SUM(successful_matches),
+				SUM(match_rate_sum),
+				SUM(modern_errors),
+				SUM(modern_responses),
+				SUM(modern_latency_ms_sum),
+				MAX(last_comparison)
+			FROM comparison_daily_rollups
+			WHERE routing_rule_id = :4
+			AND rollup_date >= :5
+			AND rollup_date < :6
+		)
 	`
 
 	var stats port.ComparisonStatistics
 	stats.RoutingRuleID = routingRuleID
 
-	var totalComparisons, successfulMatches int
-	var averageMatchRate sql.NullFloat64
+	var totalComparisons, successfulMatches, modernErrors, modernResponses int
+	var matchRateSum, latencyMsSum sql.NullFloat64
 	var lastComparison sql.NullTime
 
-	err := r.db.QueryRowContext(ctx, query, routingRuleID, from, to).Scan(
+	firstRollupDay, endRollupDay := domain.RollupDaysWithin(from, to)
+	err := r.db.QueryRowContext(ctx, query, routingRuleID, from, to, routingRuleID, firstRollupDay, endRollupDay).Scan(
 		&totalComparisons,
 		&successfulMatches,
-		&averageMatchRate,
+		&matchRateSum,
+		&modernErrors,
+		&modernResponses,
+		&latencyMsSum,
 		&lastComparison,
 	)
 
@@ -1125,9 +1302,14 @@ func (r *oracleComparisonRepository) GetComparisonStatistics(ctx context.Context
 
 	stats.TotalComparisons = totalComparisons
 	stats.SuccessfulMatches = successfulMatches
+	stats.ModernErrors = modernErrors
+
+	if matchRateSum.Valid && totalComparisons > 0 {
+		stats.AverageMatchRate = matchRateSum.Float64 / float64(totalComparisons)
+	}
 
-	if averageMatchRate.Valid {
-		stats.AverageMatchRate = averageMatchRate.Float64
+	if latencyMsSum.Valid && modernResponses > 0 {
+		stats.AverageLatency = millisToDuration(latencyMsSum.Float64 / float64(modernResponses))
 	}
 
 	if lastComparison.Valid {
@@ -1137,6 +1319,139 @@ func (r *oracleComparisonRepository) GetComparisonStatistics(ctx context.Context
 	return &stats, nil
 }
 
+// GetComparisonInsights는 기간 내 비교 결과를 불일치 경로, 차이점 유형, 시간대별 일치율로 집계합니다.
+// 차이점은 JSON CLOB으로 저장되므로 기간 내 행을 읽어 애플리케이션에서 집계합니다.
+func (r *oracleComparisonRepository) GetComparisonInsights(ctx context.Context, routingRuleID string, from, to time.Time, bucket domain.InsightBucket, limit int) (*domain.ComparisonInsights, error) {
+	query := `
+		SELECT match_rate, difference_details, created_at
+		FROM comparison_logs
+		WHERE routing_rule_id = :1
+		AND created_at BETWEEN :2 AND :3
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, routingRuleID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query comparison insights: %w", err)
+	}
+	defer rows.Close()
+
+	insights := domain.NewComparisonInsights(routingRuleID, from, to, bucket, limit)
+	for rows.Next() {
+		var matchRate sql.NullFloat64
+		var differencesJSON sql.NullString
+		var createdAt time.Time
+
+		if err := rows.Scan(&matchRate, &differencesJSON, &createdAt); err != nil {
+			return nil, fmt.Errorf("failed to scan comparison insights: %w", err)
+		}
+
+		var differences []domain.ResponseDiff
+		if differencesJSON.Valid && differencesJSON.String != "" {
+			differences, err = domain.ResponseDiffsFromJSON([]byte(differencesJSON.String))
+			if err != nil {
+				return nil, fmt.Errorf("failed to deserialize differences: %w", err)
+			}
+		}
+		insights.Add(matchRate.Float64, differences, createdAt)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating comparison insights: %w", err)
+	}
+
+	return insights.Finalize(), nil
+}
+
+// StripComparisonBodies는 before 이전 비교 결과의 응답 본문을 지웁니다.
+// 응답 JSON에서 Body만 제거하므로 상태 코드와 응답 시간 기반 통계는 유지됩니다.
+func (r *oracleComparisonRepository) StripComparisonBodies(ctx context.Context, before time.Time) (int, error) {
+	query := `
+		UPDATE comparison_logs
+		SET old_response = JSON_MERGEPATCH(old_response, '{"Body":null}' RETURNING CLOB),
+		    new_response = JSON_MERGEPATCH(new_response, '{"Body":null}' RETURNING CLOB)
+		WHERE created_at < :1
+		AND (JSON_EXISTS(old_response, '$.Body') OR JSON_EXISTS(new_response, '$.Body'))
+	`
+
+	result, err := r.db.ExecContext(ctx, query, before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to strip comparison bodies: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return int(rows), nil
+}
+
+// RollupComparisons는 before 이전 비교 결과를 규칙별 일별 요약에 합산한 뒤 삭제합니다.
+// 요약과 삭제는 하나의 트랜잭션으로 처리해 중간에 실패해도 통계가 유실되거나 중복되지 않습니다.
+func (r *oracleComparisonRepository) RollupComparisons(ctx context.Context, before time.Time) (int, error) {
+	mergeQuery := `
+		MERGE INTO comparison_daily_rollups t
+		USING (
+			SELECT
+				routing_rule_id,
+				TRUNC(CAST(created_at AS DATE)) as rollup_date,
+				COUNT(*) as total_comparisons,
+				SUM(is_matched) as successful_matches,
+				NVL(SUM(match_rate), 0) as match_rate_sum,
+				COUNT(CASE WHEN modern_status_code IS NULL OR modern_status_code >= 500 THEN 1 END) as modern_errors,
+				COUNT(modern_latency_ms) as modern_responses,
+				NVL(SUM(modern_latency_ms), 0) as modern_latency_ms_sum,
+				MAX(created_at) as last_comparison
+			FROM comparison_logs
+			WHERE created_at < :1
+			GROUP BY routing_rule_id, TRUNC(CAST(created_at AS DATE))
+		) s
+		ON (t.routing_rule_id = s.routing_rule_id AND t.rollup_date = s.rollup_date)
+		WHEN MATCHED THEN UPDATE SET
+			t.total_comparisons = t.total_comparisons + s.total_comparisons,
+			t.successful_matches = t.successful_matches + s.successful_matches,
+			t.match_rate_sum = t.match_rate_sum + s.match_rate_sum,
+			t.modern_errors = t.modern_errors + s.modern_errors,
+			t.modern_responses = t.modern_responses + s.modern_responses,
+			t.modern_latency_ms_sum = t.modern_latency_ms_sum + s.modern_latency_ms_sum,
+			t.last_comparison = GREATEST(t.last_comparison, s.last_comparison)
+		WHEN NOT MATCHED THEN INSERT (
+			routing_rule_id, rollup_date, total_comparisons, successful_matches, match_rate_sum,
+			modern_errors, modern_responses, modern_latency_ms_sum, last_comparison
+		) VALUES (
+			s.routing_rule_id, s.rollup_date, s.total_comparisons, s.successful_matches, s.match_rate_sum,
+			s.modern_errors, s.modern_responses, s.modern_latency_ms_sum, s.last_comparison
+		)
+	`
+	deleteQuery := `DELETE FROM comparison_logs WHERE created_at < :1`
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, mergeQuery, before); err != nil {
+		return 0, fmt.Errorf("failed to roll up comparisons: %w", err)
+	}
+
+	result, err := tx.ExecContext(ctx, deleteQuery, before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge comparisons: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit comparison rollup: %w", err)
+	}
+
+	return int(rows), nil
+}
+
 // Close는 데이터베이스 연결을 닫습니다.
 func (r *oracleComparisonRepository) Close() error {
 	if r.db != nil {
@@ -1144,3 +1459,145 @@ func (r *oracleComparisonRepository) Close() error {
 	}
 	return nil
 }
+
+// oracleTransitionHistoryRepository는 OracleDB 기반 TransitionHistoryRepository 구현체입니다.
+type oracleTransitionHistoryRepository struct {
+	db *sql.DB
+}
+
+// NewOracleTransitionHistoryRepository는 새로운 Oracle 전환 이력 레포지토리를 생성합니다.
+func NewOracleTransitionHistoryRepository(cfg *config.DatabaseConfig) (port.TransitionHistoryRepository, error) {
+	// DSN 생성
+	dsn := cfg.GetDSN()
+
+	// 데이터베이스 연결
+	db, err := sql.Open("oracle", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	// 연결 설정
+	db.SetMaxOpenConns(cfg.MaxOpenConns)
+	db.SetMaxIdleConns(cfg.MaxIdleConns)
+	db.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+
+	// 연결 테스트
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.ConnectionTimeout)
+	defer cancel()
+
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to ping database: %w", err)
+	}
+
+	return &oracleTransitionHistoryRepository{db: db}, nil
+}
+
+// Save는 전환 이벤트를 저장합니다.
+func (r *oracleTransitionHistoryRepository) Save(ctx context.Context, event *domain.TransitionEvent) error {
+	query := `
+		INSERT INTO orchestration_transition_events (
+			id, rule_id, from_mode, to_mode,
+			from_percentage, to_percentage, trigger_type,
+			match_rate, error_rate, sample_size,
+			reason, actor, created_at
+		) VALUES (
+			:1, :2, :3, :4, :5, :6, :7, :8, :9, :10, :11, :12, :13
+		)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		event.ID,
+		event.RuleID,
+		string(event.FromMode),
+		string(event.ToMode),
+		event.FromPercentage,
+		event.ToPercentage,
+		string(event.Trigger),
+		event.MatchRate,
+		event.ErrorRate,
+		event.SampleSize,
+		event.Reason,
+		event.Actor,
+		event.Timestamp,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to save transition event: %w", err)
+	}
+
+	return nil
+}
+
+// FindByRuleID는 규칙의 전환 이력을 최신순으로 최대 limit개 조회합니다.
+func (r *oracleTransitionHistoryRepository) FindByRuleID(ctx context.Context, ruleID string, limit int) ([]*domain.TransitionEvent, error) {
+	query := `
+		SELECT id, rule_id, from_mode, to_mode,
+		       from_percentage, to_percentage, trigger_type,
+		       match_rate, error_rate, sample_size,
+		       reason, actor, created_at
+		FROM orchestration_transition_events
+		WHERE rule_id = :1
+		ORDER BY created_at DESC
+	`
+	args := []interface{}{ruleID}
+	if limit > 0 {
+		query += ` FETCH FIRST :2 ROWS ONLY`
+		args = append(args, limit)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query transition events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []*domain.TransitionEvent
+	for rows.Next() {
+		var event domain.TransitionEvent
+		var fromMode, toMode, trigger string
+		var reason, actor sql.NullString
+
+		err := rows.Scan(
+			&event.ID,
+			&event.RuleID,
+			&fromMode,
+			&toMode,
+			&event.FromPercentage,
+			&event.ToPercentage,
+			&trigger,
+			&event.MatchRate,
+			&event.ErrorRate,
+			&event.SampleSize,
+			&reason,
+			&actor,
+			&event.Timestamp,
+		)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan transition event: %w", err)
+		}
+
+		event.FromMode = domain.APIMode(fromMode)
+		event.ToMode = domain.APIMode(toMode)
+		event.Trigger = domain.TransitionTrigger(trigger)
+		event.Reason = reason.String
+		event.Actor = actor.String
+
+		events = append(events, &event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating transition events: %w", err)
+	}
+
+	return events, nil
+}
+
+// Close는 데이터베이스 연결을 닫습니다.
+func (r *oracleTransitionHistoryRepository) Close() error {
+	if r.db != nil {
+		return r.db.Close()
+	}
+	return nil
+}
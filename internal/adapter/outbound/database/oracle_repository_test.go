@@ -0,0 +1,60 @@
+package database
+
+import (
+	"database/sql"
+	"demo-api-bridge/internal/core/domain"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestResponseJSON_RoundTrip(t *testing.T) {
+	// 응답이 없으면 NULL로 저장하고 nil로 복원
+	stored, err := responseToJSON(nil)
+	if err != nil || stored != nil {
+		t.Fatalf("Expected NULL for missing response, got %v (err=%v)", stored, err)
+	}
+	for _, data := range []sql.NullString{{}, {String: "null", Valid: true}} {
+		response, err := responseFromJSON(data)
+		if err != nil || response != nil {
+			t.Errorf("Expected nil response for %+v, got %+v (err=%v)", data, response, err)
+		}
+	}
+
+	// 에러가 있는 응답도 복원 가능해야 함
+	original := &domain.Response{
+		StatusCode:  502,
+		Headers:     http.Header{"Content-Type": {"application/json"}},
+		Body:        []byte(`{"error":"bad gateway"}`),
+		ContentType: "application/json",
+		Duration:    1500 * time.Microsecond,
+		Error:       errors.New("upstream closed connection"),
+	}
+	stored, err = responseToJSON(original)
+	if err != nil {
+		t.Fatalf("responseToJSON failed: %v", err)
+	}
+	restored, err := responseFromJSON(sql.NullString{String: stored.(string), Valid: true})
+	if err != nil {
+		t.Fatalf("responseFromJSON failed: %v", err)
+	}
+	if restored.StatusCode != original.StatusCode || restored.Duration != original.Duration || string(restored.Body) != string(original.Body) {
+		t.Errorf("Expected %+v, got %+v", original, restored)
+	}
+}
+
+func TestResponseMetrics(t *testing.T) {
+	status, latency := responseMetrics(nil)
+	if status != nil || latency != nil {
+		t.Errorf("Expected NULL columns for missing response, got %v, %v", status, latency)
+	}
+
+	status, latency = responseMetrics(&domain.Response{StatusCode: 200, Duration: 12345 * time.Microsecond})
+	if status != 200 || latency != 12.345 {
+		t.Errorf("Expected 200 and 12.345ms, got %v, %v", status, latency)
+	}
+	if got := millisToDuration(latency.(float64)); got != 12345*time.Microsecond {
+		t.Errorf("Expected latency to round-trip, got %v", got)
+	}
+}
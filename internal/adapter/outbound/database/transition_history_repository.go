@@ -0,0 +1,59 @@
+package database
+
+import (
+	"context"
+	"demo-api-bridge/internal/core/domain"
+	"demo-api-bridge/internal/core/port"
+	"sync"
+)
+
+// mockTransitionHistoryRepository는 TransitionHistoryRepository의 Mock 구현체입니다.
+type mockTransitionHistoryRepository struct {
+	events map[string][]*domain.TransitionEvent
+	mutex  sync.RWMutex
+}
+
+// NewMockTransitionHistoryRepository는 새로운 Mock TransitionHistoryRepository를 생성합니다.
+func NewMockTransitionHistoryRepository() port.TransitionHistoryRepository {
+	return &mockTransitionHistoryRepository{
+		events: make(map[string][]*domain.TransitionEvent),
+	}
+}
+
+// Save는 전환 이벤트를 저장합니다.
+func (r *mockTransitionHistoryRepository) Save(ctx context.Context, event *domain.TransitionEvent) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	// 복사본 생성 (원본 보호)
+	eventCopy := *event
+	r.events[event.RuleID] = append(r.events[event.RuleID], &eventCopy)
+
+	// 최대 저장 개수 제한 (메모리 관리)
+	maxEvents := 1000
+	if len(r.events[event.RuleID]) > maxEvents {
+		r.events[event.RuleID] = r.events[event.RuleID][len(r.events[event.RuleID])-maxEvents:]
+	}
+
+	return nil
+}
+
+// FindByRuleID는 규칙의 전환 이력을 최신순으로 최대 limit개 조회합니다.
+func (r *mockTransitionHistoryRepository) FindByRuleID(ctx context.Context, ruleID string, limit int) ([]*domain.TransitionEvent, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	events := r.events[ruleID]
+	if limit <= 0 || limit > len(events) {
+		limit = len(events)
+	}
+
+	// 저장 순서의 역순 (최신이 앞)
+	result := make([]*domain.TransitionEvent, 0, limit)
+	for i := len(events) - 1; i >= 0 && len(result) < limit; i-- {
+		eventCopy := *events[i]
+		result = append(result, &eventCopy)
+	}
+
+	return result, nil
+}
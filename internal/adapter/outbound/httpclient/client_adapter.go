@@ -1,26 +1,65 @@
 package httpclient
 
 import (
+	"bytes"
 	"context"
 	"demo-api-bridge/internal/core/domain"
 	"demo-api-bridge/internal/core/port"
+	"errors"
 	"fmt"
 	"io"
+	"math/rand"
+	"net"
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
+	"syscall"
 	"time"
 )
 
 // httpClientAdapter는 HTTP 기반 ExternalAPIClient 구현체입니다.
 type httpClientAdapter struct {
 	client         *http.Client
+	streamClient   *http.Client // SendStream용 클라이언트 (Timeout 없음, newStreamClient 참고)
 	timeout        time.Duration
 	circuitBreaker port.CircuitBreakerService
+	metrics        port.MetricsCollector
+	random         func() float64              // 재시도 jitter용 [0, 1) 난수
+	maxBodySize    int64                       // 응답 본문 최대 크기 (0이면 제한 없음)
+	breakerPolicy  domain.CircuitBreakerPolicy // 엔드포인트 정책에 없는 항목에 적용할 Circuit Breaker 정책
+}
+
+// ClientOption은 httpClientAdapter의 선택적 설정을 지정합니다.
+type ClientOption func(*httpClientAdapter)
+
+// WithMaxBodySize는 응답 본문의 최대 크기를 지정합니다.
+// 이를 넘는 응답은 domain.ErrResponseBodyTooLarge로 실패합니다.
+func WithMaxBodySize(maxBodySize int64) ClientOption {
+	return func(h *httpClientAdapter) {
+		h.maxBodySize = maxBodySize
+	}
+}
+
+// WithCircuitBreakerPolicy는 엔드포인트별 정책에 없는 항목에 적용할 전역 Circuit Breaker 정책을 지정합니다.
+// 전역 정책에도 없는 항목은 domain.DefaultCircuitBreakerPolicy 값을 사용합니다.
+func WithCircuitBreakerPolicy(policy domain.CircuitBreakerPolicy) ClientOption {
+	return func(h *httpClientAdapter) {
+		h.breakerPolicy = policy.WithDefaults(domain.DefaultCircuitBreakerPolicy())
+	}
+}
+
+// applyOptions는 선택적 설정을 적용합니다.
+func (h *httpClientAdapter) applyOptions(opts []ClientOption) *httpClientAdapter {
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
 }
 
 // NewHTTPClientAdapter는 새로운 HTTP 클라이언트 어댑터를 생성합니다.
-func NewHTTPClientAdapter(timeout time.Duration) port.ExternalAPIClient {
-	return &httpClientAdapter{
+func NewHTTPClientAdapter(timeout time.Duration, opts ...ClientOption) port.ExternalAPIClient {
+	adapter := &httpClientAdapter{
 		client: &http.Client{
 			Timeout: timeout,
 			Transport: &http.Transport{
@@ -29,6 +68,8 @@ func NewHTTPClientAdapter(timeout time.Duration) port.ExternalAPIClient {
 				MaxIdleConnsPerHost: 50,               // 호스트당 유휴 연결 수 (기존 10 → 50)
 				MaxConnsPerHost:     100,              // 호스트당 최대 연결 수 (신규)
 				IdleConnTimeout:     90 * time.Second, // 유휴 연결 타임아웃
+				// 응답 헤더 대기 제한 (Timeout이 없는 스트리밍 클라이언트에도 적용)
+				ResponseHeaderTimeout: timeout,
 				// 추가 최적화 설정
 				DisableKeepAlives:      false,     // Keep-Alive 활성화
 				DisableCompression:     false,     // 압축 활성화
@@ -38,13 +79,18 @@ func NewHTTPClientAdapter(timeout time.Duration) port.ExternalAPIClient {
 				ReadBufferSize:         32 * 1024, // 32KB 읽기 버퍼
 			},
 		},
-		timeout: timeout,
+		timeout:       timeout,
+		random:        rand.Float64,
+		breakerPolicy: domain.DefaultCircuitBreakerPolicy(),
 	}
+	adapter.streamClient = newStreamClient(adapter.client, timeout)
+	return adapter.applyOptions(opts)
 }
 
 // NewHTTPClientAdapterWithCircuitBreaker는 Circuit Breaker가 포함된 HTTP 클라이언트 어댑터를 생성합니다.
-func NewHTTPClientAdapterWithCircuitBreaker(timeout time.Duration, circuitBreaker port.CircuitBreakerService) port.ExternalAPIClient {
-	return &httpClientAdapter{
+// metrics가 있으면 재시도 메트릭을 기록합니다.
+func NewHTTPClientAdapterWithCircuitBreaker(timeout time.Duration, circuitBreaker port.CircuitBreakerService, metrics port.MetricsCollector, opts ...ClientOption) port.ExternalAPIClient {
+	adapter := &httpClientAdapter{
 		client: &http.Client{
 			Timeout: timeout,
 			Transport: &http.Transport{
@@ -53,6 +99,8 @@ func NewHTTPClientAdapterWithCircuitBreaker(timeout time.Duration, circuitBreake
 				MaxIdleConnsPerHost: 50,               // 호스트당 유휴 연결 수 (기존 10 → 50)
 				MaxConnsPerHost:     100,              // 호스트당 최대 연결 수 (신규)
 				IdleConnTimeout:     90 * time.Second, // 유휴 연결 타임아웃
+				// 응답 헤더 대기 제한 (Timeout이 없는 스트리밍 클라이언트에도 적용)
+				ResponseHeaderTimeout: timeout,
 				// 추가 최적화 설정
 				DisableKeepAlives:      false,     // Keep-Alive 활성화
 				DisableCompression:     false,     // 압축 활성화
@@ -64,69 +112,189 @@ func NewHTTPClientAdapterWithCircuitBreaker(timeout time.Duration, circuitBreake
 		},
 		timeout:        timeout,
 		circuitBreaker: circuitBreaker,
+		metrics:        metrics,
+		random:         rand.Float64,
+		breakerPolicy:  domain.DefaultCircuitBreakerPolicy(),
 	}
+	adapter.streamClient = newStreamClient(adapter.client, timeout)
+	return adapter.applyOptions(opts)
 }
 
 // NewHTTPClientAdapterWithClient는 기존 HTTP 클라이언트로 어댑터를 생성합니다.
-func NewHTTPClientAdapterWithClient(client *http.Client) port.ExternalAPIClient {
-	return &httpClientAdapter{
-		client:  client,
-		timeout: client.Timeout,
+func NewHTTPClientAdapterWithClient(client *http.Client, opts ...ClientOption) port.ExternalAPIClient {
+	adapter := &httpClientAdapter{
+		client:        client,
+		timeout:       client.Timeout,
+		random:        rand.Float64,
+		breakerPolicy: domain.DefaultCircuitBreakerPolicy(),
 	}
+	adapter.streamClient = newStreamClient(client, client.Timeout)
+	return adapter.applyOptions(opts)
+}
+
+// newStreamClient는 client와 같은 Transport를 쓰되 Timeout이 없는 스트리밍용 클라이언트를 생성합니다.
+//
+// http.Client.Timeout은 응답 본문을 읽는 시간까지 포함하므로, 본문 전송이 오래 걸리는
+// 스트리밍 응답을 중간에 끊습니다. 스트리밍 클라이언트는 Transport.ResponseHeaderTimeout으로
+// 응답 헤더 대기만 제한하고, 본문 전송은 요청 컨텍스트로 제한합니다.
+// Transport에 ResponseHeaderTimeout이 없으면 복제한 Transport에 timeout을 지정합니다.
+func newStreamClient(client *http.Client, timeout time.Duration) *http.Client {
+	stream := *client
+	stream.Timeout = 0
+
+	transport := client.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	if t, ok := transport.(*http.Transport); ok && t.ResponseHeaderTimeout == 0 && timeout > 0 {
+		t = t.Clone()
+		t.ResponseHeaderTimeout = timeout
+		stream.Transport = t
+	}
+
+	return &stream
 }
 
 // SendRequest는 외부 API에 요청을 전송하고 응답을 받습니다.
 func (h *httpClientAdapter) SendRequest(ctx context.Context, endpoint *domain.APIEndpoint, request *domain.Request) (*domain.Response, error) {
 	start := time.Now()
 
+	httpResp, err := h.do(ctx, h.client, endpoint, request)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	// 응답 본문 읽기 (최대 크기 제한)
+	body, err := io.ReadAll(h.limitBody(httpResp.Body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	response := h.buildResponse(request, httpResp, start)
+	response.Body = body
+
+	return response, nil
+}
+
+// sendStreamRequest는 응답 본문을 읽지 않고 BodyStream으로 담아 반환합니다.
+// 본문을 읽는 동안 클라이언트 Timeout에 끊기지 않도록 스트리밍 클라이언트로 전송합니다.
+func (h *httpClientAdapter) sendStreamRequest(ctx context.Context, endpoint *domain.APIEndpoint, request *domain.Request) (*domain.Response, error) {
+	start := time.Now()
+
+	httpResp, err := h.do(ctx, h.streamClient, endpoint, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// 길이를 알 수 있으면 본문을 보내기 전에 거부
+	if h.maxBodySize > 0 && httpResp.ContentLength > h.maxBodySize {
+		httpResp.Body.Close()
+		return nil, fmt.Errorf("failed to read response body: %w", domain.ErrResponseBodyTooLarge)
+	}
+
+	response := h.buildResponse(request, httpResp, start)
+	response.BodyStream = h.limitBody(httpResp.Body)
+
+	return response, nil
+}
+
+// do는 HTTP 요청을 생성하여 client로 전송합니다. 반환된 응답 본문은 호출자가 닫아야 합니다.
+func (h *httpClientAdapter) do(ctx context.Context, client *http.Client, endpoint *domain.APIEndpoint, request *domain.Request) (*http.Response, error) {
 	// URL 구성
-	url := h.buildURL(endpoint, request)
+	target, err := h.buildURL(endpoint, request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request URL: %w", err)
+	}
 
 	// HTTP 요청 생성
-	httpReq, err := h.buildHTTPRequest(ctx, request, url)
+	httpReq, err := h.buildHTTPRequest(ctx, request, target)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build HTTP request: %w", err)
 	}
 
 	// 요청 전송
-	httpResp, err := h.client.Do(httpReq)
+	httpResp, err := client.Do(httpReq)
 	if err != nil {
 		return nil, fmt.Errorf("failed to send HTTP request: %w", err)
 	}
-	defer httpResp.Body.Close()
 
-	// 응답 본문 읽기
-	body, err := io.ReadAll(httpResp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
-	}
+	return httpResp, nil
+}
 
-	// 응답 생성
+// buildResponse는 HTTP 응답의 상태 코드와 헤더로 도메인 응답을 생성합니다 (본문 제외).
+func (h *httpClientAdapter) buildResponse(request *domain.Request, httpResp *http.Response, start time.Time) *domain.Response {
 	response := domain.NewResponse(request.ID)
 	response.StatusCode = httpResp.StatusCode
-	response.Body = body
+	response.ContentType = httpResp.Header.Get("Content-Type")
 	response.SetDuration(start)
 	response.Source = "external-api"
 
-	// 응답 헤더 복사
-	for key, values := range httpResp.Header {
-		if len(values) > 0 {
-			response.SetHeader(key, values[0])
+	// 응답 헤더 복사 (같은 이름의 여러 값 유지, hop-by-hop 헤더는 클라이언트로 전달하지 않음)
+	response.Headers = httpResp.Header.Clone()
+	domain.RemoveHopByHopHeaders(response.Headers)
+
+	return response
+}
+
+// limitBody는 최대 크기를 넘으면 domain.ErrResponseBodyTooLarge를 반환하도록 응답 본문을 감쌉니다.
+func (h *httpClientAdapter) limitBody(body io.ReadCloser) io.ReadCloser {
+	if h.maxBodySize <= 0 {
+		return body
+	}
+	return &limitedBody{ReadCloser: body, remaining: h.maxBodySize}
+}
+
+// limitedBody는 remaining 바이트까지만 읽을 수 있는 응답 본문입니다.
+type limitedBody struct {
+	io.ReadCloser
+	remaining int64
+}
+
+// Read는 남은 한도까지 읽고, 한도를 넘는 데이터가 있으면 domain.ErrResponseBodyTooLarge를 반환합니다.
+func (b *limitedBody) Read(p []byte) (int, error) {
+	if b.remaining <= 0 {
+		var probe [1]byte
+		n, err := b.ReadCloser.Read(probe[:])
+		if n > 0 {
+			return 0, domain.ErrResponseBodyTooLarge
 		}
+		return 0, err
 	}
 
-	return response, nil
+	if int64(len(p)) > b.remaining {
+		p = p[:b.remaining]
+	}
+	n, err := b.ReadCloser.Read(p)
+	b.remaining -= int64(n)
+	return n, err
 }
 
 // SendWithRetry는 재시도 로직과 Circuit Breaker를 포함하여 외부 API에 요청을 전송합니다.
 func (h *httpClientAdapter) SendWithRetry(ctx context.Context, endpoint *domain.APIEndpoint, request *domain.Request) (*domain.Response, error) {
+	return h.execute(ctx, endpoint, request, h.SendRequest)
+}
+
+// SendStream은 요청/응답 본문을 버퍼링하지 않고 외부 API에 요청을 전송합니다.
+//
+// 요청 본문이 스트림(request.BodyStream)이면 한 번만 보낼 수 있으므로 재시도하지 않습니다.
+// 본문이 없거나 버퍼링된 요청은 SendWithRetry와 같이 재시도하며, 재시도하는 응답의 본문은 읽지 않고 닫습니다.
+func (h *httpClientAdapter) SendStream(ctx context.Context, endpoint *domain.APIEndpoint, request *domain.Request) (*domain.Response, error) {
+	return h.execute(ctx, endpoint, request, h.sendStreamRequest)
+}
+
+// sendFunc는 한 번의 요청 전송을 수행하는 함수입니다.
+type sendFunc func(ctx context.Context, endpoint *domain.APIEndpoint, request *domain.Request) (*domain.Response, error)
+
+// execute는 Circuit Breaker가 있으면 이를 거쳐 재시도 로직을 수행합니다.
+func (h *httpClientAdapter) execute(ctx context.Context, endpoint *domain.APIEndpoint, request *domain.Request, send sendFunc) (*domain.Response, error) {
 	// Circuit Breaker가 있는 경우 사용
 	if h.circuitBreaker != nil {
 		breakerName := fmt.Sprintf("http-client-%s", endpoint.ID)
-		config := domain.NewCircuitBreakerConfig(breakerName)
+		config := endpoint.CircuitBreakerPolicy.WithDefaults(h.breakerPolicy).Config(breakerName)
 
 		result, err := h.circuitBreaker.Execute(ctx, breakerName, config, func() (interface{}, error) {
-			return h.sendWithRetryInternal(ctx, endpoint, request)
+			return h.sendWithRetryInternal(ctx, endpoint, request, send)
 		})
 
 		if err != nil {
@@ -141,100 +309,197 @@ func (h *httpClientAdapter) SendWithRetry(ctx context.Context, endpoint *domain.
 	}
 
 	// Circuit Breaker가 없는 경우 기본 재시도 로직 사용
-	return h.sendWithRetryInternal(ctx, endpoint, request)
+	return h.sendWithRetryInternal(ctx, endpoint, request, send)
 }
 
-// sendWithRetryInternal은 내부 재시도 로직을 수행합니다.
-func (h *httpClientAdapter) sendWithRetryInternal(ctx context.Context, endpoint *domain.APIEndpoint, request *domain.Request) (*domain.Response, error) {
-	var lastErr error
-	attempt := 0
+// sendWithRetryInternal은 엔드포인트의 재시도 정책에 따라 요청을 전송합니다.
+//
+// 재시도 대상 에러나 재시도 대상 상태 코드 응답이면 지수 백오프(full jitter) 후 다시 시도합니다.
+// 컨텍스트 deadline 전에 대기를 마칠 수 없으면 더 기다리지 않고 마지막 결과를 반환합니다.
+// 재시도를 모두 소진한 상태 코드 응답은 에러 없이 그대로 반환합니다.
+func (h *httpClientAdapter) sendWithRetryInternal(ctx context.Context, endpoint *domain.APIEndpoint, request *domain.Request, send sendFunc) (*domain.Response, error) {
+	policy := endpoint.EffectiveRetryPolicy()
+
+	var (
+		response *domain.Response
+		err      error
+		attempt  int
+	)
+
+	for attempt = 1; ; attempt++ {
+		response, err = send(ctx, endpoint, request)
+
+		reason := h.retryReason(ctx, policy, request, response, err)
+		if reason == "" {
+			break
+		}
 
-	for attempt <= endpoint.RetryCount {
-		if attempt > 0 {
-			// 재시도 전 대기
-			select {
-			case <-ctx.Done():
-				return nil, ctx.Err()
-			case <-time.After(time.Duration(attempt) * time.Second):
-			}
+		if attempt >= policy.MaxAttempts {
+			h.recordRetryExhausted(endpoint, "max_attempts")
+			break
 		}
 
-		response, err := h.SendRequest(ctx, endpoint, request)
-		if err == nil {
-			return response, nil
+		// 재시도 전 대기 (컨텍스트 deadline을 넘기지 않음)
+		delay := policy.Backoff(attempt, h.random)
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) <= delay {
+			h.recordRetryExhausted(endpoint, "deadline")
+			break
 		}
 
-		lastErr = err
-		attempt++
+		h.recordRetry(endpoint, reason)
+		if response != nil && response.BodyStream != nil {
+			response.BodyStream.Close()
+		}
 
-		// 재시도 가능한 에러인지 확인
-		if !h.isRetryableError(err) {
-			break
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
 		}
+	}
 
-		// 최대 재시도 횟수 확인
-		if !endpoint.ShouldRetry(attempt) {
-			break
+	// 게이트웨이 응답 상태를 결정할 수 있도록 타임아웃과 그 외 실패를 도메인 에러로 구분
+	if err != nil {
+		cause := domain.ErrExternalAPIFailed
+		if classifyError(err) == domain.RetryErrorTimeout {
+			cause = domain.ErrExternalAPITimeout
 		}
+		return nil, fmt.Errorf("request failed after %d attempts: %w: %w", attempt, cause, err)
 	}
 
-	return nil, fmt.Errorf("request failed after %d attempts: %w", attempt, lastErr)
+	return response, nil
+}
+
+// retryReason은 시도 결과를 재시도해야 하면 그 사유를 반환합니다. 재시도하지 않으면 빈 문자열입니다.
+func (h *httpClientAdapter) retryReason(ctx context.Context, policy domain.RetryPolicy, request *domain.Request, response *domain.Response, err error) string {
+	// 호출자가 취소했거나 deadline이 지났으면 재시도하지 않음
+	if ctx.Err() != nil {
+		return ""
+	}
+
+	// 스트림 본문은 이미 보냈으므로 다시 보낼 수 없음
+	if request.BodyStream != nil {
+		return ""
+	}
+
+	if err != nil {
+		kind := classifyError(err)
+		if policy.ShouldRetryError(request.Method, kind) {
+			return kind
+		}
+		return ""
+	}
+
+	if policy.ShouldRetryStatus(request.Method, response.StatusCode) {
+		return "status_" + strconv.Itoa(response.StatusCode)
+	}
+
+	return ""
+}
+
+// classifyError는 전송 에러를 재시도 정책의 에러 종류로 분류합니다. 분류할 수 없으면 빈 문자열입니다.
+func classifyError(err error) string {
+	var netErr net.Error
+	switch {
+	case errors.Is(err, syscall.ECONNREFUSED):
+		return domain.RetryErrorConnectionRefused
+	case errors.Is(err, syscall.ECONNRESET), errors.Is(err, io.ErrUnexpectedEOF), errors.Is(err, io.EOF):
+		return domain.RetryErrorConnectionReset
+	case errors.Is(err, context.DeadlineExceeded), errors.As(err, &netErr) && netErr.Timeout():
+		return domain.RetryErrorTimeout
+	}
+	return ""
+}
+
+// recordRetry는 재시도 메트릭을 기록합니다.
+func (h *httpClientAdapter) recordRetry(endpoint *domain.APIEndpoint, reason string) {
+	if h.metrics == nil {
+		return
+	}
+	h.metrics.IncrementCounter("external_api_retries", map[string]string{
+		"endpoint_id": endpoint.ID,
+		"reason":      reason,
+	})
+}
+
+// recordRetryExhausted는 재시도를 더 할 수 없어 마지막 결과를 반환한 경우의 메트릭을 기록합니다.
+func (h *httpClientAdapter) recordRetryExhausted(endpoint *domain.APIEndpoint, reason string) {
+	if h.metrics == nil {
+		return
+	}
+	h.metrics.IncrementCounter("external_api_retries_exhausted", map[string]string{
+		"endpoint_id": endpoint.ID,
+		"reason":      reason,
+	})
 }
 
 // buildURL은 엔드포인트와 요청으로부터 URL을 구성합니다.
-func (h *httpClientAdapter) buildURL(endpoint *domain.APIEndpoint, request *domain.Request) string {
-	baseURL := endpoint.GetFullURL()
+// 경로와 쿼리 파라미터는 URL 인코딩하며, 같은 이름의 쿼리 파라미터는 모든 값을 전달합니다.
+func (h *httpClientAdapter) buildURL(endpoint *domain.APIEndpoint, request *domain.Request) (string, error) {
+	target, err := url.Parse(endpoint.GetFullURL())
+	if err != nil {
+		return "", err
+	}
 
-	// 요청 경로가 있으면 추가
-	if request.Path != "" && request.Path != "/" {
-		baseURL = strings.TrimSuffix(baseURL, "/") + request.Path
+	// 요청 경로가 있으면 추가 (라우팅 규칙의 재작성 경로 우선)
+	if path := request.TargetPath(); path != "" && path != "/" {
+		target.Path = strings.TrimSuffix(target.Path, "/") + path
+		target.RawPath = ""
 	}
 
-	// 쿼리 파라미터 추가
+	// 쿼리 파라미터 추가 (엔드포인트 URL에 있던 쿼리는 유지)
 	if len(request.QueryParams) > 0 {
-		baseURL += "?"
-		params := make([]string, 0, len(request.QueryParams))
-		for key, value := range request.QueryParams {
-			params = append(params, fmt.Sprintf("%s=%s", key, value))
+		query := target.Query()
+		for key, values := range request.QueryParams {
+			for _, value := range values {
+				query.Add(key, value)
+			}
 		}
-		baseURL += strings.Join(params, "&")
+		target.RawQuery = query.Encode()
 	}
 
-	return baseURL
+	return target.String(), nil
 }
 
 // buildHTTPRequest는 HTTP 요청을 생성합니다.
-func (h *httpClientAdapter) buildHTTPRequest(ctx context.Context, request *domain.Request, url string) (*http.Request, error) {
+func (h *httpClientAdapter) buildHTTPRequest(ctx context.Context, request *domain.Request, target string) (*http.Request, error) {
 	var body io.Reader
-	if len(request.Body) > 0 {
-		body = strings.NewReader(string(request.Body))
+	switch {
+	case request.BodyStream != nil:
+		body = request.BodyStream
+	case len(request.Body) > 0:
+		body = bytes.NewReader(request.Body)
 	}
 
-	httpReq, err := http.NewRequestWithContext(ctx, request.Method, url, body)
+	httpReq, err := http.NewRequestWithContext(ctx, request.Method, target, body)
 	if err != nil {
 		return nil, err
 	}
 
-	// 요청 헤더 복사
-	for key, value := range request.Headers {
-		httpReq.Header.Set(key, value)
+	// 스트림 본문은 길이를 알 때만 Content-Length로 보내고, 모르면 chunked로 전송
+	if request.BodyStream != nil && request.ContentLength > 0 {
+		httpReq.ContentLength = request.ContentLength
 	}
 
-	return httpReq, nil
-}
+	// 요청 헤더 복사 (같은 이름의 여러 값 유지, hop-by-hop 헤더는 업스트림으로 전달하지 않음)
+	for key, values := range request.Headers {
+		for _, value := range values {
+			httpReq.Header.Add(key, value)
+		}
+	}
+	domain.RemoveHopByHopHeaders(httpReq.Header)
 
-// isRetryableError는 재시도 가능한 에러인지 확인합니다.
-func (h *httpClientAdapter) isRetryableError(err error) bool {
-	// 네트워크 타임아웃, 연결 실패 등은 재시도 가능
-	return strings.Contains(err.Error(), "timeout") ||
-		strings.Contains(err.Error(), "connection refused") ||
-		strings.Contains(err.Error(), "connection reset")
+	return httpReq, nil
 }
 
 // Close는 HTTP 클라이언트를 종료합니다.
 func (h *httpClientAdapter) Close() error {
-	if transport, ok := h.client.Transport.(*http.Transport); ok {
-		transport.CloseIdleConnections()
+	for _, client := range []*http.Client{h.client, h.streamClient} {
+		if transport, ok := client.Transport.(*http.Transport); ok {
+			transport.CloseIdleConnections()
+		}
 	}
 	return nil
 }
@@ -0,0 +1,417 @@
+package httpclient
+
+import (
+	"context"
+	"demo-api-bridge/internal/core/domain"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingMetrics는 카운터 호출만 기록하는 테스트용 메트릭 수집기입니다.
+type recordingMetrics struct {
+	mu       sync.Mutex
+	counters map[string][]map[string]string
+}
+
+func newRecordingMetrics() *recordingMetrics {
+	return &recordingMetrics{counters: make(map[string][]map[string]string)}
+}
+
+func (m *recordingMetrics) RecordRequest(method, path string, statusCode int, duration time.Duration) {
+}
+func (m *recordingMetrics) RecordExternalAPICall(endpoint string, success bool, duration time.Duration) {
+}
+func (m *recordingMetrics) RecordCacheHit(hit bool)                                              {}
+func (m *recordingMetrics) RecordDefaultRoutingUsed(method, path string)                         {}
+func (m *recordingMetrics) RecordDefaultOrchestrationUsed(method, path string)                   {}
+func (m *recordingMetrics) RecordGauge(name string, value float64, labels map[string]string)     {}
+func (m *recordingMetrics) RecordHistogram(name string, value float64, labels map[string]string) {}
+
+func (m *recordingMetrics) IncrementCounter(name string, labels map[string]string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.counters[name] = append(m.counters[name], labels)
+}
+
+func (m *recordingMetrics) count(name string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.counters[name])
+}
+
+func newTestAdapter(metrics *recordingMetrics) *httpClientAdapter {
+	adapter := NewHTTPClientAdapterWithCircuitBreaker(5*time.Second, nil, metrics).(*httpClientAdapter)
+	adapter.random = func() float64 { return 0 }
+	return adapter
+}
+
+func newTestEndpoint(baseURL string, policy domain.RetryPolicy) *domain.APIEndpoint {
+	endpoint := domain.NewAPIEndpoint("test-api", "Test API", baseURL, "", "GET")
+	endpoint.RetryPolicy = policy
+	return endpoint
+}
+
+func TestSendWithRetry_RetriesRetryableStatus(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	metrics := newRecordingMetrics()
+	adapter := newTestAdapter(metrics)
+	endpoint := newTestEndpoint(server.URL, domain.RetryPolicy{MaxAttempts: 3, RetryableHTTPCodes: []int{503}})
+	request := domain.NewRequest("req-1", "GET", "/test")
+
+	response, err := adapter.SendWithRetry(context.Background(), endpoint, request)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+	assert.Equal(t, 2, metrics.count("external_api_retries"))
+	assert.Equal(t, "status_503", metrics.counters["external_api_retries"][0]["reason"])
+}
+
+func TestSendWithRetry_ReturnsLastResponseWhenExhausted(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	metrics := newRecordingMetrics()
+	adapter := newTestAdapter(metrics)
+	endpoint := newTestEndpoint(server.URL, domain.RetryPolicy{MaxAttempts: 2})
+	request := domain.NewRequest("req-1", "GET", "/test")
+
+	response, err := adapter.SendWithRetry(context.Background(), endpoint, request)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadGateway, response.StatusCode)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+	assert.Equal(t, 1, metrics.count("external_api_retries"))
+	assert.Equal(t, 1, metrics.count("external_api_retries_exhausted"))
+}
+
+func TestSendWithRetry_DoesNotRetryNonIdempotentStatus(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	metrics := newRecordingMetrics()
+	adapter := newTestAdapter(metrics)
+	endpoint := newTestEndpoint(server.URL, domain.RetryPolicy{MaxAttempts: 3})
+	request := domain.NewRequest("req-1", "POST", "/test")
+
+	response, err := adapter.SendWithRetry(context.Background(), endpoint, request)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, response.StatusCode)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	assert.Equal(t, 0, metrics.count("external_api_retries"))
+}
+
+func TestSendWithRetry_RetriesConnectionRefused(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	baseURL := server.URL
+	server.Close()
+
+	metrics := newRecordingMetrics()
+	adapter := newTestAdapter(metrics)
+	endpoint := newTestEndpoint(baseURL, domain.RetryPolicy{MaxAttempts: 3})
+	request := domain.NewRequest("req-1", "GET", "/test")
+
+	_, err := adapter.SendWithRetry(context.Background(), endpoint, request)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "after 3 attempts")
+	assert.ErrorIs(t, err, domain.ErrExternalAPIFailed)
+	assert.Equal(t, 2, metrics.count("external_api_retries"))
+	assert.Equal(t, domain.RetryErrorConnectionRefused, metrics.counters["external_api_retries"][0]["reason"])
+}
+
+func TestSendWithRetry_DoesNotRetryNonIdempotentTimeout(t *testing.T) {
+	var calls int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	metrics := newRecordingMetrics()
+	adapter := NewHTTPClientAdapterWithCircuitBreaker(50*time.Millisecond, nil, metrics).(*httpClientAdapter)
+	adapter.random = func() float64 { return 0 }
+	endpoint := newTestEndpoint(server.URL, domain.RetryPolicy{MaxAttempts: 3})
+	request := domain.NewRequest("req-1", "POST", "/orders")
+
+	_, err := adapter.SendWithRetry(context.Background(), endpoint, request)
+
+	// 서버가 이미 받았을 수 있는 쓰기 요청은 다시 보내지 않음
+	require.Error(t, err)
+	assert.ErrorIs(t, err, domain.ErrExternalAPITimeout)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	assert.Equal(t, 0, metrics.count("external_api_retries"))
+}
+
+func TestSendWithRetry_StopsBeforeContextDeadline(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	metrics := newRecordingMetrics()
+	adapter := newTestAdapter(metrics)
+	adapter.random = func() float64 { return 0.99 }
+	endpoint := newTestEndpoint(server.URL, domain.RetryPolicy{MaxAttempts: 5, InitialDelay: time.Second})
+	request := domain.NewRequest("req-1", "GET", "/test")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	response, err := adapter.SendWithRetry(ctx, endpoint, request)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, response.StatusCode)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	assert.Less(t, time.Since(start), 200*time.Millisecond)
+	assert.Equal(t, "deadline", metrics.counters["external_api_retries_exhausted"][0]["reason"])
+}
+
+func TestSendStream_StreamsRequestAndResponseBody(t *testing.T) {
+	var received []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(strings.Repeat("x", 1024)))
+	}))
+	defer server.Close()
+
+	adapter := newTestAdapter(newRecordingMetrics())
+	endpoint := newTestEndpoint(server.URL, domain.RetryPolicy{MaxAttempts: 1})
+	request := domain.NewRequest("req-1", "POST", "/upload")
+	request.BodyStream = strings.NewReader("streamed payload")
+	request.ContentLength = -1
+
+	response, err := adapter.SendStream(context.Background(), endpoint, request)
+
+	require.NoError(t, err)
+	require.NotNil(t, response.BodyStream)
+	assert.Nil(t, response.Body)
+	defer response.BodyStream.Close()
+
+	body, err := io.ReadAll(response.BodyStream)
+	require.NoError(t, err)
+	assert.Len(t, body, 1024)
+	assert.Equal(t, "streamed payload", string(received))
+}
+
+func TestSendStream_SlowBodyNotBoundByClientTimeout(t *testing.T) {
+	const chunks = 4
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		for i := 0; i < chunks; i++ {
+			w.Write([]byte("chunk"))
+			w.(http.Flusher).Flush()
+			time.Sleep(50 * time.Millisecond)
+		}
+	}))
+	defer server.Close()
+
+	// 본문 전송(약 200ms)이 클라이언트 Timeout(100ms)보다 오래 걸림
+	adapter := NewHTTPClientAdapterWithCircuitBreaker(100*time.Millisecond, nil, nil)
+	endpoint := newTestEndpoint(server.URL, domain.RetryPolicy{MaxAttempts: 1})
+
+	t.Run("streamed response reads every chunk", func(t *testing.T) {
+		response, err := adapter.SendStream(context.Background(), endpoint, domain.NewRequest("req-1", "GET", "/events"))
+		require.NoError(t, err)
+		defer response.BodyStream.Close()
+
+		body, err := io.ReadAll(response.BodyStream)
+		require.NoError(t, err)
+		assert.Equal(t, strings.Repeat("chunk", chunks), string(body))
+	})
+
+	t.Run("request context still bounds streamed body", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 75*time.Millisecond)
+		defer cancel()
+
+		response, err := adapter.SendStream(ctx, endpoint, domain.NewRequest("req-1", "GET", "/events"))
+		require.NoError(t, err)
+		defer response.BodyStream.Close()
+
+		_, err = io.ReadAll(response.BodyStream)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("buffered response is bound by client timeout", func(t *testing.T) {
+		_, err := adapter.SendWithRetry(context.Background(), endpoint, domain.NewRequest("req-1", "GET", "/events"))
+		assert.Error(t, err)
+	})
+}
+
+func TestSendStream_ResponseHeaderTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(300 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	adapter := NewHTTPClientAdapterWithCircuitBreaker(100*time.Millisecond, nil, nil)
+	endpoint := newTestEndpoint(server.URL, domain.RetryPolicy{MaxAttempts: 1})
+
+	_, err := adapter.SendStream(context.Background(), endpoint, domain.NewRequest("req-1", "GET", "/events"))
+	assert.Error(t, err)
+}
+
+func TestSendStream_DoesNotRetryStreamedRequestBody(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	metrics := newRecordingMetrics()
+	adapter := newTestAdapter(metrics)
+	endpoint := newTestEndpoint(server.URL, domain.RetryPolicy{MaxAttempts: 3})
+	request := domain.NewRequest("req-1", "PUT", "/upload")
+	request.BodyStream = strings.NewReader("streamed payload")
+
+	response, err := adapter.SendStream(context.Background(), endpoint, request)
+
+	require.NoError(t, err)
+	defer response.BodyStream.Close()
+	assert.Equal(t, http.StatusServiceUnavailable, response.StatusCode)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	assert.Equal(t, 0, metrics.count("external_api_retries"))
+}
+
+func TestMaxBodySize(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Content-Length 없이 chunked로 전송
+		w.(http.Flusher).Flush()
+		w.Write([]byte(strings.Repeat("x", 2048)))
+	}))
+	defer server.Close()
+
+	adapter := NewHTTPClientAdapterWithCircuitBreaker(5*time.Second, nil, nil, WithMaxBodySize(1024)).(*httpClientAdapter)
+	endpoint := newTestEndpoint(server.URL, domain.RetryPolicy{MaxAttempts: 1})
+
+	t.Run("buffered response", func(t *testing.T) {
+		_, err := adapter.SendWithRetry(context.Background(), endpoint, domain.NewRequest("req-1", "GET", "/download"))
+		assert.ErrorIs(t, err, domain.ErrResponseBodyTooLarge)
+	})
+
+	t.Run("streamed response", func(t *testing.T) {
+		response, err := adapter.SendStream(context.Background(), endpoint, domain.NewRequest("req-1", "GET", "/download"))
+		require.NoError(t, err)
+		defer response.BodyStream.Close()
+
+		body, err := io.ReadAll(response.BodyStream)
+		assert.ErrorIs(t, err, domain.ErrResponseBodyTooLarge)
+		assert.Len(t, body, 1024)
+	})
+}
+
+func TestSendStream_RejectsOversizedContentLength(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(strings.Repeat("x", 2048)))
+	}))
+	defer server.Close()
+
+	adapter := NewHTTPClientAdapterWithCircuitBreaker(5*time.Second, nil, nil, WithMaxBodySize(1024))
+	endpoint := newTestEndpoint(server.URL, domain.RetryPolicy{MaxAttempts: 1})
+
+	_, err := adapter.SendStream(context.Background(), endpoint, domain.NewRequest("req-1", "GET", "/download"))
+
+	assert.ErrorIs(t, err, domain.ErrResponseBodyTooLarge)
+}
+
+func TestBuildURL_EscapesPathAndKeepsRepeatedQueryParams(t *testing.T) {
+	adapter := newTestAdapter(newRecordingMetrics())
+	endpoint := newTestEndpoint("http://legacy.example.com/api?tenant=a", domain.RetryPolicy{})
+	request := domain.NewRequest("req-1", "GET", "/users/john doe")
+	request.AddQueryParam("id", "1")
+	request.AddQueryParam("id", "2")
+	request.AddQueryParam("q", "a&b=c")
+
+	target, err := adapter.buildURL(endpoint, request)
+
+	require.NoError(t, err)
+	assert.Equal(t, "http://legacy.example.com/api/users/john%20doe?id=1&id=2&q=a%26b%3Dc&tenant=a", target)
+}
+
+func TestSendWithRetry_PreservesMultiValueHeadersAndStripsHopByHop(t *testing.T) {
+	var received http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Clone()
+		w.Header().Add("Set-Cookie", "a=1")
+		w.Header().Add("Set-Cookie", "b=2")
+		w.Header().Set("Connection", "X-Upstream-Hint")
+		w.Header().Set("X-Upstream-Hint", "internal")
+		w.Header().Set("Keep-Alive", "timeout=5")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	adapter := newTestAdapter(newRecordingMetrics())
+	endpoint := newTestEndpoint(server.URL, domain.RetryPolicy{MaxAttempts: 1})
+	request := domain.NewRequest("req-1", "GET", "/users")
+	request.AddHeader("Accept", "application/json")
+	request.AddHeader("Accept", "text/plain")
+	request.SetHeader("Connection", "X-Client-Hint")
+	request.SetHeader("X-Client-Hint", "private")
+	request.SetHeader("Proxy-Authorization", "Basic Zm9vOmJhcg==")
+
+	response, err := adapter.SendWithRetry(context.Background(), endpoint, request)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"application/json", "text/plain"}, received.Values("Accept"))
+	assert.Empty(t, received.Get("X-Client-Hint"))
+	assert.Empty(t, received.Get("Proxy-Authorization"))
+
+	assert.Equal(t, []string{"a=1", "b=2"}, response.Headers.Values("Set-Cookie"))
+	assert.Empty(t, response.Headers.Get("Connection"))
+	assert.Empty(t, response.Headers.Get("X-Upstream-Hint"))
+	assert.Empty(t, response.Headers.Get("Keep-Alive"))
+}
+
+func TestSendWithRetry_TimeoutWrapsDomainError(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	adapter := NewHTTPClientAdapterWithCircuitBreaker(50*time.Millisecond, nil, nil).(*httpClientAdapter)
+	endpoint := newTestEndpoint(server.URL, domain.RetryPolicy{MaxAttempts: 1})
+	request := domain.NewRequest("req-1", "GET", "/slow")
+
+	_, err := adapter.SendWithRetry(context.Background(), endpoint, request)
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, domain.ErrExternalAPITimeout)
+}
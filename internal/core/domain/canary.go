@@ -0,0 +1,97 @@
+package domain
+
+import (
+	"hash/fnv"
+)
+
+// StickyKeySource는 카나리 그룹 배정에 사용할 키의 출처입니다.
+type StickyKeySource string
+
+const (
+	StickyKeyHeader   StickyKeySource = "header"    // 요청 헤더 값 (예: X-User-ID)
+	StickyKeyClientIP StickyKeySource = "client_ip" // 클라이언트 IP
+	StickyKeyQuery    StickyKeySource = "query"     // 쿼리 파라미터 값
+)
+
+// CanaryGroup은 CANARY 모드에서 요청이 배정된 그룹입니다.
+type CanaryGroup string
+
+const (
+	CanaryGroupLegacy CanaryGroup = "legacy" // 레거시 API로 처리되는 대조군
+	CanaryGroupModern CanaryGroup = "modern" // 모던 API로 처리되는 카나리 그룹
+)
+
+// CanaryConfig는 CANARY 모드의 트래픽 분배 설정을 나타냅니다.
+//
+// 같은 키를 가진 요청은 항상 같은 그룹에 배정됩니다(sticky).
+// 키 값을 구할 수 없는 요청은 레거시 그룹으로 처리합니다.
+type CanaryConfig struct {
+	Percentage      int             // 모던 API로 보낼 트래픽 비율 (0 ~ 100)
+	StickyKeySource StickyKeySource // 그룹 배정 키의 출처 (비어 있으면 client_ip)
+	StickyKeyName   string          // header / query 출처일 때의 헤더 또는 파라미터 이름
+}
+
+// AssignGroup은 요청을 레거시 또는 모던 그룹에 배정합니다.
+//
+// 규칙 ID와 키 값을 FNV-1a로 해시한 값을 0 ~ 99 버킷으로 나누고,
+// 버킷이 Percentage 미만이면 모던 그룹에 배정합니다. 규칙 ID를 함께
+// 해시하므로 같은 클라이언트라도 규칙마다 다른 버킷에 배정됩니다.
+func (c CanaryConfig) AssignGroup(ruleID string, request *Request) CanaryGroup {
+	if c.Percentage <= 0 {
+		return CanaryGroupLegacy
+	}
+	if c.Percentage >= 100 {
+		return CanaryGroupModern
+	}
+
+	key, ok := c.StickyKey(request)
+	if !ok {
+		return CanaryGroupLegacy
+	}
+
+	if canaryBucket(ruleID, key) < c.Percentage {
+		return CanaryGroupModern
+	}
+	return CanaryGroupLegacy
+}
+
+// StickyKey는 요청에서 그룹 배정 키 값을 추출합니다.
+func (c CanaryConfig) StickyKey(request *Request) (string, bool) {
+	var value string
+	switch c.StickyKeySource {
+	case StickyKeyHeader:
+		value, _ = lookupHeader(request.Headers, c.StickyKeyName)
+	case StickyKeyQuery:
+		value = request.QueryParams.Get(c.StickyKeyName)
+	default:
+		value = request.ClientIP
+	}
+	return value, value != ""
+}
+
+// IsValid는 카나리 설정이 유효한지 검증합니다.
+func (c CanaryConfig) IsValid() error {
+	if c.Percentage < 0 || c.Percentage > 100 {
+		return NewValidationError("Canary.Percentage", "canary percentage must be between 0 and 100")
+	}
+
+	switch c.StickyKeySource {
+	case "", StickyKeyClientIP:
+	case StickyKeyHeader, StickyKeyQuery:
+		if c.StickyKeyName == "" {
+			return NewValidationError("Canary.StickyKeyName", "sticky key name is required for header or query source")
+		}
+	default:
+		return NewValidationError("Canary.StickyKeySource", "sticky key source must be one of header, client_ip, query")
+	}
+	return nil
+}
+
+// canaryBucket은 규칙 ID와 키 값을 0 ~ 99 범위의 버킷으로 변환합니다.
+func canaryBucket(ruleID, key string) int {
+	h := fnv.New32a()
+	h.Write([]byte(ruleID))
+	h.Write([]byte{0})
+	h.Write([]byte(key))
+	return int(h.Sum32() % 100)
+}
@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestCanaryConfig_AssignGroup_Boundaries(t *testing.T) {
+	request := NewRequest("id", "GET", "/api/users")
+	request.ClientIP = "10.0.0.1"
+
+	tests := []struct {
+		name       string
+		percentage int
+		want       CanaryGroup
+	}{
+		{"zero percent", 0, CanaryGroupLegacy},
+		{"full percent", 100, CanaryGroupModern},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := CanaryConfig{Percentage: tt.percentage}
+			if got := config.AssignGroup("orch-1", request); got != tt.want {
+				t.Errorf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestCanaryConfig_AssignGroup_Sticky(t *testing.T) {
+	config := CanaryConfig{Percentage: 30, StickyKeySource: StickyKeyHeader, StickyKeyName: "X-User-ID"}
+
+	for i := 0; i < 50; i++ {
+		first := NewRequest("a", "GET", "/api/users")
+		first.SetHeader("x-user-id", fmt.Sprintf("user-%d", i))
+		second := NewRequest("b", "POST", "/api/orders")
+		second.SetHeader("X-User-ID", fmt.Sprintf("user-%d", i))
+
+		if config.AssignGroup("orch-1", first) != config.AssignGroup("orch-1", second) {
+			t.Fatalf("user-%d assigned to different groups", i)
+		}
+	}
+}
+
+func TestCanaryConfig_AssignGroup_Distribution(t *testing.T) {
+	config := CanaryConfig{Percentage: 20, StickyKeySource: StickyKeyQuery, StickyKeyName: "uid"}
+
+	modern := 0
+	const total = 10000
+	for i := 0; i < total; i++ {
+		request := NewRequest("id", "GET", "/api/users")
+		request.SetQueryParam("uid", fmt.Sprintf("%d", i))
+		if config.AssignGroup("orch-1", request) == CanaryGroupModern {
+			modern++
+		}
+	}
+
+	ratio := float64(modern) / total
+	if ratio < 0.17 || ratio > 0.23 {
+		t.Errorf("expected about 20%% modern traffic, got %.2f%%", ratio*100)
+	}
+}
+
+func TestCanaryConfig_AssignGroup_MissingKey(t *testing.T) {
+	config := CanaryConfig{Percentage: 99, StickyKeySource: StickyKeyHeader, StickyKeyName: "X-User-ID"}
+
+	request := NewRequest("id", "GET", "/api/users")
+	request.ClientIP = "10.0.0.1"
+	if got := config.AssignGroup("orch-1", request); got != CanaryGroupLegacy {
+		t.Errorf("request without sticky key should stay on legacy, got %s", got)
+	}
+}
+
+func TestCanaryConfig_IsValid(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  CanaryConfig
+		wantErr bool
+	}{
+		{"zero value", CanaryConfig{}, false},
+		{"client ip", CanaryConfig{Percentage: 10, StickyKeySource: StickyKeyClientIP}, false},
+		{"header with name", CanaryConfig{Percentage: 10, StickyKeySource: StickyKeyHeader, StickyKeyName: "X-User-ID"}, false},
+		{"header without name", CanaryConfig{Percentage: 10, StickyKeySource: StickyKeyHeader}, true},
+		{"query without name", CanaryConfig{Percentage: 10, StickyKeySource: StickyKeyQuery}, true},
+		{"unknown source", CanaryConfig{Percentage: 10, StickyKeySource: "cookie"}, true},
+		{"negative percentage", CanaryConfig{Percentage: -1}, true},
+		{"percentage over 100", CanaryConfig{Percentage: 101}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.IsValid()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error=%v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"fmt"
 	"time"
 )
 
@@ -23,6 +24,7 @@ type CircuitBreakerConfig struct {
 	Interval      time.Duration                                                       // 상태 변경을 확인하는 간격
 	Timeout       time.Duration                                                       // OPEN 상태에서 HALF_OPEN으로 전환하기까지의 대기 시간
 	ReadyToTrip   func(counts Counts) bool                                            // OPEN 상태로 전환할 조건
+	IsFailure     func(result interface{}, duration time.Duration) bool               // 에러 없이 끝난 호출을 실패로 집계할 조건 (nil이면 에러만 실패)
 	OnStateChange func(name string, from CircuitBreakerState, to CircuitBreakerState) // 상태 변경 콜백
 }
 
@@ -53,31 +55,83 @@ func (c Counts) IsSuccessful() bool {
 	return float64(c.TotalSuccesses)/float64(c.TotalRequests()) >= 0.5 // 50% 이상 성공 시 성공으로 간주
 }
 
-// NewCircuitBreakerConfig는 기본 Circuit Breaker 설정을 생성합니다.
+// NewCircuitBreakerConfig는 기본 Circuit Breaker 설정을 생성합니다 (DefaultCircuitBreakerPolicy 참고).
 func NewCircuitBreakerConfig(name string) CircuitBreakerConfig {
-	return CircuitBreakerConfig{
-		Name:        name,
-		MaxRequests: 3,
-		Interval:    10 * time.Second,
-		Timeout:     30 * time.Second,
-		ReadyToTrip: func(counts Counts) bool {
-			// 연속 5회 실패 시 OPEN 상태로 전환
-			return counts.ConsecutiveFailures >= 5
-		},
-		OnStateChange: func(name string, from CircuitBreakerState, to CircuitBreakerState) {
-			// 상태 변경 로깅 (기본 구현)
-		},
+	return DefaultCircuitBreakerPolicy().Config(name)
+}
+
+// CircuitOpenError는 Circuit Breaker가 열려 있어 요청을 보내지 않았음을 나타냅니다.
+// errors.Is(err, ErrCircuitOpen)으로 확인할 수 있습니다.
+type CircuitOpenError struct {
+	Name       string        // Circuit Breaker 이름
+	RetryAfter time.Duration // 요청을 다시 시도할 수 있을 때까지 남은 예상 시간
+}
+
+// Error는 error 인터페이스를 구현합니다.
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("circuit breaker '%s' is open", e.Name)
+}
+
+// Unwrap은 ErrCircuitOpen을 반환합니다.
+func (e *CircuitOpenError) Unwrap() error {
+	return ErrCircuitOpen
+}
+
+// CircuitBreakerOverride는 운영자가 수동으로 고정한 Circuit Breaker 상태입니다 (유지보수 등).
+type CircuitBreakerOverride string
+
+const (
+	// OverrideNone: 수동 고정 없음 (실패 집계에 따라 자동 전환)
+	OverrideNone CircuitBreakerOverride = ""
+	// OverrideForceOpen: 모든 요청 차단
+	OverrideForceOpen CircuitBreakerOverride = "FORCED_OPEN"
+	// OverrideForceClosed: 모든 요청 허용 (실패를 집계하지 않음)
+	OverrideForceClosed CircuitBreakerOverride = "FORCED_CLOSED"
+)
+
+// State는 수동 고정 상태에 해당하는 Circuit Breaker 상태를 반환합니다.
+func (o CircuitBreakerOverride) State() (CircuitBreakerState, bool) {
+	switch o {
+	case OverrideForceOpen:
+		return OPEN, true
+	case OverrideForceClosed:
+		return CLOSED, true
+	default:
+		return "", false
 	}
 }
 
+// Circuit Breaker 상태 전환 원인입니다.
+const (
+	CircuitBreakerCauseStateChange     = "state_change"     // 실패 집계에 따른 자동 전환
+	CircuitBreakerCauseReset           = "reset"            // 수동 리셋
+	CircuitBreakerCauseOverride        = "override"         // 수동 상태 고정
+	CircuitBreakerCauseOverrideCleared = "override_cleared" // 수동 상태 고정 해제
+	CircuitBreakerCauseOverrideExpired = "override_expired" // 수동 상태 고정 만료
+)
+
+// CircuitBreakerEvent는 Circuit Breaker 상태 전환 이벤트입니다.
+type CircuitBreakerEvent struct {
+	Name      string              // Circuit Breaker 이름
+	From      CircuitBreakerState // 전환 전 상태
+	To        CircuitBreakerState // 전환 후 상태
+	Cause     string              // 전환 원인 (CircuitBreakerCause* 상수)
+	Reason    string              // 수동 상태 고정 사유
+	Timestamp time.Time           // 전환 시각
+}
+
 // CircuitBreakerInfo는 Circuit Breaker의 현재 상태 정보를 나타냅니다.
+// 수동 고정 상태(Override)가 있으면 State는 고정된 상태입니다.
 type CircuitBreakerInfo struct {
-	Name        string              `json:"name"`
-	State       CircuitBreakerState `json:"state"`
-	Counts      Counts              `json:"counts"`
-	MaxRequests uint32              `json:"max_requests"`
-	Expiry      time.Time           `json:"expiry"`
-	LastError   error               `json:"last_error,omitempty"`
+	Name           string                 `json:"name"`
+	State          CircuitBreakerState    `json:"state"`
+	Counts         Counts                 `json:"counts"`
+	MaxRequests    uint32                 `json:"max_requests"`
+	Expiry         time.Time              `json:"expiry,omitzero"`           // OPEN 상태가 끝나는 시각 (수동 고정이면 만료 시각)
+	LastError      string                 `json:"last_error,omitempty"`      // 마지막으로 실패로 집계된 호출의 에러
+	LastErrorAt    time.Time              `json:"last_error_at,omitzero"`    // 마지막 에러 시각
+	Override       CircuitBreakerOverride `json:"override,omitempty"`        // 수동 고정 상태
+	OverrideReason string                 `json:"override_reason,omitempty"` // 수동 고정 사유
 }
 
 // IsHealthy는 Circuit Breaker가 건강한 상태인지 확인합니다.
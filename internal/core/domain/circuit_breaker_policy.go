@@ -0,0 +1,170 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// CircuitBreakerStrategy는 Circuit Breaker를 OPEN 상태로 전환하는 조건입니다.
+type CircuitBreakerStrategy string
+
+const (
+	// TripConsecutiveFailures: 연속 실패 횟수가 임계값 이상이면 OPEN
+	TripConsecutiveFailures CircuitBreakerStrategy = "consecutive_failures"
+	// TripFailureRatio: 집계 구간의 요청 수가 최소 요청 수 이상이고 실패 비율이 임계값 이상이면 OPEN
+	TripFailureRatio CircuitBreakerStrategy = "failure_ratio"
+	// TripSlowCallRatio: 집계 구간의 요청 수가 최소 요청 수 이상이고 "느리거나 실패한 호출"의 비율이 임계값 이상이면 OPEN.
+	// 느린 호출은 실패와 같은 카운터로 집계하므로, 에러와 5xx 응답도 비율에 포함됩니다.
+	TripSlowCallRatio CircuitBreakerStrategy = "slow_call_ratio"
+)
+
+// Circuit Breaker 정책 기본값입니다. 설정에 값이 없는 항목에 적용합니다.
+const (
+	DefaultCircuitBreakerMaxRequests         = 3
+	DefaultCircuitBreakerInterval            = 10 * time.Second
+	DefaultCircuitBreakerTimeout             = 30 * time.Second
+	DefaultCircuitBreakerConsecutiveFailures = 5
+	DefaultCircuitBreakerMinRequests         = 10
+	DefaultCircuitBreakerFailureRatio        = 0.5
+	DefaultCircuitBreakerSlowCallRatio       = 0.5
+	DefaultCircuitBreakerSlowCallThreshold   = 5 * time.Second
+)
+
+// CircuitBreakerPolicy는 엔드포인트별 Circuit Breaker 정책입니다.
+//
+// 전송 에러뿐 아니라 5xx 응답도 실패로 집계합니다.
+// 느린 호출 비율 전략에서는 SlowCallThreshold 이상 걸린 호출도 실패로 집계하므로,
+// SlowCallRatio는 느린 호출만이 아니라 느리거나 실패한 호출의 비율에 대한 임계값입니다.
+// 값이 없는(0) 항목은 WithDefaults로 전역 정책이나 기본값을 채웁니다.
+type CircuitBreakerPolicy struct {
+	Strategy            CircuitBreakerStrategy // OPEN 전환 조건 (Trip* 상수)
+	MaxRequests         uint32                 // HALF_OPEN 상태에서 허용할 최대 요청 수
+	Interval            time.Duration          // CLOSED 상태에서 카운터를 초기화하는 주기 (비율 전략의 집계 구간)
+	Timeout             time.Duration          // OPEN 상태에서 HALF_OPEN으로 전환하기까지의 대기 시간
+	ConsecutiveFailures uint32                 // 연속 실패 임계값 (consecutive_failures)
+	MinRequests         uint32                 // 비율을 평가하기 위한 최소 요청 수 (failure_ratio, slow_call_ratio)
+	FailureRatio        float64                // 실패 비율 임계값 (failure_ratio, 0~1)
+	SlowCallRatio       float64                // 느리거나 실패한 호출의 비율 임계값 (slow_call_ratio, 0~1)
+	SlowCallThreshold   time.Duration          // 느린 호출로 간주할 응답 시간 (slow_call_ratio)
+}
+
+// DefaultCircuitBreakerPolicy는 기본 Circuit Breaker 정책을 반환합니다 (연속 5회 실패 시 30초 동안 OPEN).
+func DefaultCircuitBreakerPolicy() CircuitBreakerPolicy {
+	return CircuitBreakerPolicy{
+		Strategy:            TripConsecutiveFailures,
+		MaxRequests:         DefaultCircuitBreakerMaxRequests,
+		Interval:            DefaultCircuitBreakerInterval,
+		Timeout:             DefaultCircuitBreakerTimeout,
+		ConsecutiveFailures: DefaultCircuitBreakerConsecutiveFailures,
+		MinRequests:         DefaultCircuitBreakerMinRequests,
+		FailureRatio:        DefaultCircuitBreakerFailureRatio,
+		SlowCallRatio:       DefaultCircuitBreakerSlowCallRatio,
+		SlowCallThreshold:   DefaultCircuitBreakerSlowCallThreshold,
+	}
+}
+
+// WithDefaults는 값이 없는 항목을 defaults로 채운 정책을 반환합니다.
+// 엔드포인트 정책에 전역 정책을, 전역 정책에 DefaultCircuitBreakerPolicy를 적용할 때 사용합니다.
+func (p CircuitBreakerPolicy) WithDefaults(defaults CircuitBreakerPolicy) CircuitBreakerPolicy {
+	if p.Strategy == "" {
+		p.Strategy = defaults.Strategy
+	}
+	if p.MaxRequests == 0 {
+		p.MaxRequests = defaults.MaxRequests
+	}
+	if p.Interval <= 0 {
+		p.Interval = defaults.Interval
+	}
+	if p.Timeout <= 0 {
+		p.Timeout = defaults.Timeout
+	}
+	if p.ConsecutiveFailures == 0 {
+		p.ConsecutiveFailures = defaults.ConsecutiveFailures
+	}
+	if p.MinRequests == 0 {
+		p.MinRequests = defaults.MinRequests
+	}
+	if p.FailureRatio <= 0 {
+		p.FailureRatio = defaults.FailureRatio
+	}
+	if p.SlowCallRatio <= 0 {
+		p.SlowCallRatio = defaults.SlowCallRatio
+	}
+	if p.SlowCallThreshold <= 0 {
+		p.SlowCallThreshold = defaults.SlowCallThreshold
+	}
+	return p
+}
+
+// Validate는 정책 값이 유효한지 검증합니다. 값이 없는(0) 항목은 기본값을 사용하므로 허용합니다.
+func (p CircuitBreakerPolicy) Validate() error {
+	switch p.Strategy {
+	case "", TripConsecutiveFailures, TripFailureRatio, TripSlowCallRatio:
+	default:
+		return fmt.Errorf("unknown circuit breaker strategy '%s'", p.Strategy)
+	}
+	if p.FailureRatio < 0 || p.FailureRatio > 1 {
+		return fmt.Errorf("circuit breaker failure_ratio must be between 0 and 1, got %v", p.FailureRatio)
+	}
+	if p.SlowCallRatio < 0 || p.SlowCallRatio > 1 {
+		return fmt.Errorf("circuit breaker slow_call_ratio must be between 0 and 1, got %v", p.SlowCallRatio)
+	}
+	if p.Interval < 0 || p.Timeout < 0 || p.SlowCallThreshold < 0 {
+		return fmt.Errorf("circuit breaker durations must not be negative")
+	}
+	return nil
+}
+
+// ReadyToTrip은 현재 카운터로 Circuit Breaker를 OPEN 상태로 전환해야 하는지 확인합니다.
+func (p CircuitBreakerPolicy) ReadyToTrip(counts Counts) bool {
+	switch p.Strategy {
+	case TripFailureRatio:
+		return p.exceedsRatio(counts, p.FailureRatio)
+	case TripSlowCallRatio:
+		return p.exceedsRatio(counts, p.SlowCallRatio)
+	default:
+		return counts.ConsecutiveFailures >= p.ConsecutiveFailures
+	}
+}
+
+// exceedsRatio는 완료된 요청 수가 최소 요청 수 이상이고 실패 비율이 ratio 이상인지 확인합니다.
+// 느린 호출 비율 전략에서는 느린 호출도 TotalFailures에 포함되어 있습니다.
+func (p CircuitBreakerPolicy) exceedsRatio(counts Counts, ratio float64) bool {
+	total := counts.TotalRequests()
+	if total == 0 || total < p.MinRequests {
+		return false
+	}
+	return float64(counts.TotalFailures)/float64(total) >= ratio
+}
+
+// IsFailure는 에러 없이 끝난 호출을 실패로 집계해야 하는지 확인합니다.
+// 5xx 응답은 항상 실패이고, 느린 호출 비율 전략에서는 SlowCallThreshold 이상 걸린 호출도 실패입니다.
+//
+// 결과가 응답이면 duration 대신 응답을 받은 시도 한 번의 처리 시간(Response.Duration)으로
+// 판단하므로, 앞선 시도와 재시도 대기 시간은 느린 호출 판단에 포함되지 않습니다.
+func (p CircuitBreakerPolicy) IsFailure(result interface{}, duration time.Duration) bool {
+	if response, ok := result.(*Response); ok && response != nil {
+		if response.StatusCode >= 500 {
+			return true
+		}
+		if response.Duration > 0 {
+			duration = response.Duration
+		}
+	}
+	return p.Strategy == TripSlowCallRatio && p.SlowCallThreshold > 0 && duration >= p.SlowCallThreshold
+}
+
+// Config는 정책을 name Circuit Breaker의 설정으로 변환합니다.
+func (p CircuitBreakerPolicy) Config(name string) CircuitBreakerConfig {
+	return CircuitBreakerConfig{
+		Name:        name,
+		MaxRequests: p.MaxRequests,
+		Interval:    p.Interval,
+		Timeout:     p.Timeout,
+		ReadyToTrip: p.ReadyToTrip,
+		IsFailure:   p.IsFailure,
+		OnStateChange: func(name string, from CircuitBreakerState, to CircuitBreakerState) {
+			// 상태 변경 로깅 (기본 구현)
+		},
+	}
+}
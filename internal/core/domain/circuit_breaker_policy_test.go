@@ -0,0 +1,95 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCircuitBreakerPolicy_ReadyToTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy CircuitBreakerPolicy
+		counts Counts
+		want   bool
+	}{
+		{"consecutive below threshold", CircuitBreakerPolicy{Strategy: TripConsecutiveFailures, ConsecutiveFailures: 3}, Counts{ConsecutiveFailures: 2}, false},
+		{"consecutive at threshold", CircuitBreakerPolicy{Strategy: TripConsecutiveFailures, ConsecutiveFailures: 3}, Counts{ConsecutiveFailures: 3}, true},
+		{"ratio below min requests", CircuitBreakerPolicy{Strategy: TripFailureRatio, MinRequests: 10, FailureRatio: 0.5}, Counts{TotalSuccesses: 1, TotalFailures: 8}, false},
+		{"ratio below threshold", CircuitBreakerPolicy{Strategy: TripFailureRatio, MinRequests: 10, FailureRatio: 0.5}, Counts{TotalSuccesses: 6, TotalFailures: 4}, false},
+		{"ratio at threshold", CircuitBreakerPolicy{Strategy: TripFailureRatio, MinRequests: 10, FailureRatio: 0.5}, Counts{TotalSuccesses: 5, TotalFailures: 5}, true},
+		{"slow call ratio", CircuitBreakerPolicy{Strategy: TripSlowCallRatio, MinRequests: 4, SlowCallRatio: 0.75}, Counts{TotalSuccesses: 1, TotalFailures: 3}, true},
+		{"slow call ratio ignores failure ratio", CircuitBreakerPolicy{Strategy: TripSlowCallRatio, MinRequests: 4, FailureRatio: 0.1, SlowCallRatio: 0.75}, Counts{TotalSuccesses: 2, TotalFailures: 2}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.ReadyToTrip(tt.counts); got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestCircuitBreakerPolicy_IsFailure(t *testing.T) {
+	consecutive := DefaultCircuitBreakerPolicy()
+	slow := CircuitBreakerPolicy{Strategy: TripSlowCallRatio, SlowCallThreshold: time.Second}
+
+	if !consecutive.IsFailure(&Response{StatusCode: 503}, 0) {
+		t.Error("expected 5xx response to count as failure")
+	}
+	if consecutive.IsFailure(&Response{StatusCode: 404}, 0) {
+		t.Error("expected 4xx response not to count as failure")
+	}
+	if consecutive.IsFailure(&Response{StatusCode: 200}, time.Minute) {
+		t.Error("expected slow call not to count as failure outside slow_call_ratio strategy")
+	}
+	if !slow.IsFailure(&Response{StatusCode: 200}, time.Second) {
+		t.Error("expected slow call to count as failure in slow_call_ratio strategy")
+	}
+	if slow.IsFailure(&Response{StatusCode: 200}, 500*time.Millisecond) {
+		t.Error("expected fast call not to count as failure")
+	}
+	if slow.IsFailure(&Response{StatusCode: 200, Duration: 200 * time.Millisecond}, 3*time.Second) {
+		t.Error("expected retry backoff not to count toward a fast attempt")
+	}
+	if !slow.IsFailure(&Response{StatusCode: 200, Duration: 2 * time.Second}, 2*time.Second) {
+		t.Error("expected slow attempt to count as failure")
+	}
+}
+
+func TestCircuitBreakerPolicy_WithDefaults(t *testing.T) {
+	global := CircuitBreakerPolicy{Strategy: TripFailureRatio, Timeout: 5 * time.Second}.WithDefaults(DefaultCircuitBreakerPolicy())
+	policy := CircuitBreakerPolicy{MinRequests: 20}.WithDefaults(global)
+
+	if policy.Strategy != TripFailureRatio || policy.Timeout != 5*time.Second {
+		t.Errorf("expected global strategy and timeout, got %s / %s", policy.Strategy, policy.Timeout)
+	}
+	if policy.MinRequests != 20 {
+		t.Errorf("expected endpoint min requests 20, got %d", policy.MinRequests)
+	}
+	if policy.MaxRequests != DefaultCircuitBreakerMaxRequests || policy.FailureRatio != DefaultCircuitBreakerFailureRatio {
+		t.Errorf("expected defaults for unset fields, got %+v", policy)
+	}
+}
+
+func TestCircuitBreakerPolicy_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  CircuitBreakerPolicy
+		wantErr bool
+	}{
+		{"empty", CircuitBreakerPolicy{}, false},
+		{"valid ratio", CircuitBreakerPolicy{Strategy: TripFailureRatio, FailureRatio: 0.3}, false},
+		{"unknown strategy", CircuitBreakerPolicy{Strategy: "error_count"}, true},
+		{"ratio over 1", CircuitBreakerPolicy{Strategy: TripSlowCallRatio, SlowCallRatio: 1.5}, true},
+		{"negative timeout", CircuitBreakerPolicy{Timeout: -time.Second}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.policy.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
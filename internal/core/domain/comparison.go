@@ -4,28 +4,62 @@ import (
 	"encoding/json"
 	"reflect"
 	"strconv"
-	"strings"
 )
 
-// ComparisonEngine은 JSON 응답 비교를 수행하는 엔진입니다.
+// ComparisonEngine은 API 응답 비교를 수행하는 엔진입니다.
 type ComparisonEngine struct {
-	config ComparisonConfig
+	config      ComparisonConfig
+	ignores     []PathSelector        // 무시할 경로 선택자
+	normalizers []compiledNormalizer  // 경로별 정규화 규칙
+	arrayRules  []compiledArrayRule   // 경로별 배열 비교 방식
+	weights     []compiledFieldWeight // 경로별 리프 가중치
 }
 
 // NewComparisonEngine은 새로운 비교 엔진을 생성합니다.
+// 해석할 수 없는 선택자는 무시합니다 (규칙 저장 시 ComparisonConfig.IsValid로 검증).
 func NewComparisonEngine(config ComparisonConfig) *ComparisonEngine {
-	return &ComparisonEngine{
+	engine := &ComparisonEngine{
 		config: config,
 	}
+
+	for _, field := range config.IgnoreFields {
+		if selector, err := ParsePathSelector(field); err == nil {
+			engine.ignores = append(engine.ignores, selector)
+		}
+	}
+	for _, normalizer := range config.Normalizers {
+		if selector, err := ParsePathSelector(normalizer.Path); err == nil {
+			engine.normalizers = append(engine.normalizers, compiledNormalizer{
+				selector:    selector,
+				normalizers: normalizer.Normalizers,
+			})
+		}
+	}
+	for _, weight := range config.FieldWeights {
+		if selector, err := ParsePathSelector(weight.Path); err == nil && weight.Weight > 0 {
+			engine.weights = append(engine.weights, compiledFieldWeight{selector: selector, weight: weight.Weight})
+		}
+	}
+	for _, rule := range config.ArrayRules {
+		if selector, err := ParsePathSelector(rule.Path); err == nil {
+			engine.arrayRules = append(engine.arrayRules, compiledArrayRule{
+				selector:  selector,
+				strategy:  rule.Strategy,
+				keyFields: rule.KeyFields,
+			})
+		}
+	}
+
+	return engine
 }
 
 // CompareResponses는 두 응답을 비교하고 결과를 반환합니다.
+// 상태 코드, 헤더, 본문을 각각 비교하고 항목별 가중치로 최종 일치율을 계산합니다.
 func (e *ComparisonEngine) CompareResponses(legacyResponse, modernResponse *Response) *ComparisonResult {
 	result := &ComparisonResult{
-		MatchRate:     0.0,
-		Differences:   []ResponseDiff{},
-		TotalFields:   0,
-		MatchedFields: 0,
+		MatchRate:   0.0,
+		Differences: []ResponseDiff{},
+		StrictMode:  e.config.StrictMode,
 	}
 
 	if legacyResponse == nil || modernResponse == nil {
@@ -37,196 +71,186 @@ func (e *ComparisonEngine) CompareResponses(legacyResponse, modernResponse *Resp
 		return result
 	}
 
-	// JSON 파싱
-	var legacyData, modernData interface{}
-	var err error
-
-	if len(legacyResponse.Body) > 0 {
-		err = json.Unmarshal(legacyResponse.Body, &legacyData)
-		if err != nil {
-			result.Differences = append(result.Differences, ResponseDiff{
-				Type:        TYPE_MISMATCH,
-				Path:        "legacy_response.body",
-				LegacyValue: "invalid JSON",
-				ModernValue: legacyResponse.Body,
-				Message:     "Legacy response is not valid JSON",
-			})
-		}
+	// 항목별 비교 (가중치가 0인 항목은 비교하지 않음)
+	weights := e.effectiveWeights()
+	if weights.StatusCode > 0 {
+		result.Dimensions = append(result.Dimensions, DimensionResult{
+			Dimension: DimensionStatusCode,
+			MatchRate: e.compareStatusCode(legacyResponse, modernResponse, result),
+			Weight:    weights.StatusCode,
+		})
 	}
-
-	if len(modernResponse.Body) > 0 {
-		err = json.Unmarshal(modernResponse.Body, &modernData)
-		if err != nil {
-			result.Differences = append(result.Differences, ResponseDiff{
-				Type:        TYPE_MISMATCH,
-				Path:        "modern_response.body",
-				LegacyValue: modernResponse.Body,
-				ModernValue: "invalid JSON",
-				Message:     "Modern response is not valid JSON",
+	if weights.Headers > 0 {
+		if rate, compared := e.compareHeaders(legacyResponse, modernResponse, result); compared {
+			result.Dimensions = append(result.Dimensions, DimensionResult{
+				Dimension: DimensionHeaders,
+				MatchRate: rate,
+				Weight:    weights.Headers,
 			})
 		}
 	}
+	if weights.Body > 0 {
+		result.Dimensions = append(result.Dimensions, DimensionResult{
+			Dimension: DimensionBody,
+			MatchRate: e.compareBody(legacyResponse, modernResponse, result),
+			Weight:    weights.Body,
+		})
+	}
 
-	// JSON 비교 수행
-	e.compareJSON(legacyData, modernData, "", result)
-
-	// 일치율 계산
-	if result.TotalFields > 0 {
-		result.MatchRate = float64(result.MatchedFields) / float64(result.TotalFields)
+	// 일치율 계산 (비교한 항목의 가중 평균)
+	var weighted, totalWeight float64
+	for _, dimension := range result.Dimensions {
+		weighted += dimension.MatchRate * dimension.Weight
+		totalWeight += dimension.Weight
+	}
+	if totalWeight > 0 {
+		result.MatchRate = weighted / totalWeight
 	} else {
-		result.MatchRate = 1.0 // 빈 응답인 경우
+		result.MatchRate = 1.0 // 비교한 항목이 없는 경우
 	}
 
 	return result
 }
 
 // compareJSON는 JSON 객체를 재귀적으로 비교합니다.
-func (e *ComparisonEngine) compareJSON(legacy, modern interface{}, path string, result *ComparisonResult) {
+// 비교 전에 경로에 해당하는 정규화 규칙을 두 값에 적용합니다.
+//
+// 점수는 리프(스칼라 값, 빈 객체, 빈 배열) 단위로 집계합니다 (scoreLeaf 참고).
+// 한쪽에만 있는 필드와 타입이 다른 노드는 하위 리프를 모두 불일치로 집계합니다.
+// weight는 호출자가 정한 path 노드의 가중치이며, 하위 노드의 가중치는 내려가면서 정합니다 (fieldWeight 참고).
+func (e *ComparisonEngine) compareJSON(legacy, modern interface{}, path jsonPath, weight float64, result *ComparisonResult) {
 	// 무시할 필드 확인
 	if e.shouldIgnoreField(path) {
 		return
 	}
 
-	result.TotalFields++
+	legacy = e.normalizeValue(path, legacy)
+	modern = e.normalizeValue(path, modern)
 
 	// 타입이 다른 경우
 	if reflect.TypeOf(legacy) != reflect.TypeOf(modern) {
 		result.Differences = append(result.Differences, ResponseDiff{
 			Type:        TYPE_MISMATCH,
-			Path:        path,
+			Path:        path.String(),
 			LegacyValue: e.formatValue(legacy),
 			ModernValue: e.formatValue(modern),
 			Message:     "Type mismatch",
 		})
+		e.scoreTypeMismatch(legacy, modern, path, weight, result)
 		return
 	}
 
 	switch legacyVal := legacy.(type) {
 	case map[string]interface{}:
-		modernVal, ok := modern.(map[string]interface{})
-		if !ok {
-			result.Differences = append(result.Differences, ResponseDiff{
-				Type:        TYPE_MISMATCH,
-				Path:        path,
-				LegacyValue: "object",
-				ModernValue: e.formatValue(modern),
-				Message:     "Type mismatch",
-			})
+		modernVal := modern.(map[string]interface{})
+		if len(legacyVal) == 0 && len(modernVal) == 0 {
+			e.scoreLeaf(weight, true, result)
 			return
 		}
 
-		// 모든 키 비교
-		allKeys := make(map[string]bool)
-		for k := range legacyVal {
-			allKeys[k] = true
-		}
-		for k := range modernVal {
-			allKeys[k] = true
-		}
+		// 모든 키 비교 (차이점 순서가 일정하도록 정렬)
+		for _, key := range unionKeys(legacyVal, modernVal) {
+			childPath := path.child(key)
+			if e.shouldIgnoreField(childPath) {
+				continue
+			}
 
-		for key := range allKeys {
-			newPath := e.buildPath(path, key)
-			legacyValue, legacyExists := legacyVal[key]
-			modernValue, modernExists := modernVal[key]
+			childWeight := e.fieldWeight(childPath, weight)
+			legacyValue, legacyExists := e.fieldValue(legacyVal, key, childPath)
+			modernValue, modernExists := e.fieldValue(modernVal, key, childPath)
 
-			if !legacyExists {
+			switch {
+			case !legacyExists && !modernExists:
+				continue
+			case !modernExists:
 				result.Differences = append(result.Differences, ResponseDiff{
 					Type:        MISSING,
-					Path:        newPath,
-					ModernValue: e.formatValue(modernValue),
-					Message:     "Field missing in legacy response",
+					Path:        childPath.String(),
+					LegacyValue: e.formatValue(legacyValue),
+					Message:     "Field missing in modern response",
 				})
-			} else if !modernExists {
+				e.scoreUnmatched(legacyValue, childPath, childWeight, result)
+			case !legacyExists:
 				result.Differences = append(result.Differences, ResponseDiff{
 					Type:        EXTRA,
-					Path:        newPath,
-					LegacyValue: e.formatValue(legacyValue),
-					Message:     "Extra field in legacy response",
+					Path:        childPath.String(),
+					ModernValue: e.formatValue(modernValue),
+					Message:     "Extra field in modern response",
 				})
-			} else {
-				e.compareJSON(legacyValue, modernValue, newPath, result)
+				e.scoreUnmatched(modernValue, childPath, childWeight, result)
+			default:
+				e.compareJSON(legacyValue, modernValue, childPath, childWeight, result)
 			}
 		}
 
 	case []interface{}:
-		modernVal, ok := modern.([]interface{})
-		if !ok {
-			result.Differences = append(result.Differences, ResponseDiff{
-				Type:        TYPE_MISMATCH,
-				Path:        path,
-				LegacyValue: "array",
-				ModernValue: e.formatValue(modern),
-				Message:     "Type mismatch",
-			})
+		modernVal := modern.([]interface{})
+		if len(legacyVal) == 0 && len(modernVal) == 0 {
+			e.scoreLeaf(weight, true, result)
 			return
 		}
 
-		// 배열 길이 비교
-		if len(legacyVal) != len(modernVal) {
-			result.Differences = append(result.Differences, ResponseDiff{
-				Type:        VALUE_MISMATCH,
-				Path:        path,
-				LegacyValue: len(legacyVal),
-				ModernValue: len(modernVal),
-				Message:     "Array length mismatch",
-			})
-		}
-
-		// 배열 요소 비교 (최대 10개까지만)
-		maxLen := len(legacyVal)
-		if len(modernVal) > maxLen {
-			maxLen = len(modernVal)
-		}
-		if maxLen > 10 {
-			maxLen = 10 // 성능을 위해 제한
-		}
-
-		for i := 0; i < maxLen; i++ {
-			newPath := e.buildPath(path, "["+strconv.Itoa(i)+"]")
-			if i < len(legacyVal) && i < len(modernVal) {
-				e.compareJSON(legacyVal[i], modernVal[i], newPath, result)
-			} else if i < len(legacyVal) {
-				result.Differences = append(result.Differences, ResponseDiff{
-					Type:        MISSING,
-					Path:        newPath,
-					LegacyValue: e.formatValue(legacyVal[i]),
-					Message:     "Array element missing in modern response",
-				})
-			} else {
-				result.Differences = append(result.Differences, ResponseDiff{
-					Type:        EXTRA,
-					Path:        newPath,
-					ModernValue: e.formatValue(modernVal[i]),
-					Message:     "Extra array element in modern response",
-				})
-			}
-		}
+		e.compareArrays(legacyVal, modernVal, path, weight, result)
 
 	default:
 		// 기본값 비교
-		if !e.valuesEqual(legacyVal, modern.(interface{})) {
+		equal := e.valuesEqual(legacyVal, modern)
+		if !equal {
 			result.Differences = append(result.Differences, ResponseDiff{
 				Type:        VALUE_MISMATCH,
-				Path:        path,
+				Path:        path.String(),
 				LegacyValue: e.formatValue(legacyVal),
-				ModernValue: e.formatValue(modern.(interface{})),
+				ModernValue: e.formatValue(modern),
 				Message:     "Value mismatch",
 			})
-			return
 		}
+		e.scoreLeaf(weight, equal, result)
 	}
+}
 
-	// 차이점이 없으면 매치된 필드로 카운트
-	if len(result.Differences) == 0 || !e.hasDifferenceAtPath(result.Differences, path) {
-		result.MatchedFields++
+// shouldIgnoreField는 경로가 무시할 필드 선택자와 정확히 일치하는지 확인합니다.
+func (e *ComparisonEngine) shouldIgnoreField(path jsonPath) bool {
+	for _, selector := range e.ignores {
+		if selector.matches(path) {
+			return true
+		}
 	}
+	return false
 }
 
-// shouldIgnoreField는 필드를 무시해야 하는지 확인합니다.
-func (e *ComparisonEngine) shouldIgnoreField(path string) bool {
-	for _, ignoreField := range e.config.IgnoreFields {
-		if strings.Contains(path, ignoreField) {
-			return true
+// normalizeValue는 경로에 해당하는 정규화 규칙을 규칙 순서대로 값에 적용합니다.
+func (e *ComparisonEngine) normalizeValue(path jsonPath, value interface{}) interface{} {
+	for _, rule := range e.normalizers {
+		if !rule.selector.matches(path) {
+			continue
+		}
+		for _, normalizer := range rule.normalizers {
+			value = normalizer.apply(value)
+		}
+	}
+	return value
+}
+
+// fieldValue는 객체의 필드 값을 조회합니다.
+// 값이 null이고 경로에 NormalizeNullAsMissing 규칙이 있으면 없는 필드로 취급합니다.
+func (e *ComparisonEngine) fieldValue(object map[string]interface{}, key string, path jsonPath) (interface{}, bool) {
+	value, exists := object[key]
+	if exists && value == nil && e.treatsNullAsMissing(path) {
+		return nil, false
+	}
+	return value, exists
+}
+
+// treatsNullAsMissing은 경로에 NormalizeNullAsMissing 규칙이 있는지 확인합니다.
+func (e *ComparisonEngine) treatsNullAsMissing(path jsonPath) bool {
+	for _, rule := range e.normalizers {
+		if !rule.selector.matches(path) {
+			continue
+		}
+		for _, normalizer := range rule.normalizers {
+			if normalizer == NormalizeNullAsMissing {
+				return true
+			}
 		}
 	}
 	return false
@@ -309,33 +333,20 @@ func (e *ComparisonEngine) formatValue(value interface{}) interface{} {
 	return value
 }
 
-// buildPath는 경로를 구성합니다.
-func (e *ComparisonEngine) buildPath(basePath, key string) string {
-	if basePath == "" {
-		return key
-	}
-	return basePath + "." + key
-}
-
-// hasDifferenceAtPath는 특정 경로에 차이점이 있는지 확인합니다.
-func (e *ComparisonEngine) hasDifferenceAtPath(differences []ResponseDiff, path string) bool {
-	for _, diff := range differences {
-		if diff.Path == path {
-			return true
-		}
-	}
-	return false
-}
-
 // ComparisonResult는 비교 결과를 나타냅니다.
 type ComparisonResult struct {
-	MatchRate     float64        `json:"match_rate"`
-	Differences   []ResponseDiff `json:"differences"`
-	TotalFields   int            `json:"total_fields"`
-	MatchedFields int            `json:"matched_fields"`
+	MatchRate     float64           `json:"match_rate"`
+	Differences   []ResponseDiff    `json:"differences"`
+	TotalFields   int               `json:"total_fields"`   // 비교한 JSON 본문 리프 수
+	MatchedFields int               `json:"matched_fields"` // 일치한 JSON 본문 리프 수
+	TotalWeight   float64           `json:"total_weight"`   // 비교한 리프의 가중치 합
+	MatchedWeight float64           `json:"matched_weight"` // 일치한 리프의 가중치 합
+	Dimensions    []DimensionResult `json:"dimensions"`     // 항목별 일치율
+	StrictMode    bool              `json:"strict_mode"`
 }
 
 // IsSuccessful은 비교가 성공적인지 확인합니다.
+// 엄격 모드에서는 차이점이 하나도 없어야 성공입니다.
 func (r *ComparisonResult) IsSuccessful() bool {
-	return r.MatchRate >= 0.95 // 95% 이상 일치 시 성공
+	return comparisonSucceeded(r.StrictMode, r.MatchRate, len(r.Differences))
 }
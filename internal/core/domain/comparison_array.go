@@ -0,0 +1,352 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// ArrayStrategy는 배열 요소를 짝지어 비교하는 방식입니다.
+type ArrayStrategy string
+
+const (
+	ArrayOrdered   ArrayStrategy = "ordered"   // 같은 인덱스끼리 비교 (기본값)
+	ArrayUnordered ArrayStrategy = "unordered" // 순서를 무시하고 같은 요소끼리 비교 (중복 개수까지 비교하는 multiset)
+	ArrayKeyed     ArrayStrategy = "keyed"     // KeyFields 값이 같은 요소끼리 비교
+)
+
+// ArraySamplingMode는 배열 요소 수가 MaxArrayElements를 넘을 때 비교할 요소를 고르는 방식입니다.
+type ArraySamplingMode string
+
+const (
+	ArraySamplingHead    ArraySamplingMode = "head"    // 앞에서부터 MaxArrayElements개 (기본값)
+	ArraySamplingUniform ArraySamplingMode = "uniform" // 배열 전체에서 같은 간격으로 MaxArrayElements개
+)
+
+// DefaultMaxArrayElements는 MaxArrayElements가 0일 때 배열 하나에서 비교하는 최대 요소 수입니다.
+const DefaultMaxArrayElements = 100
+
+// ArrayRule은 경로 선택자에 해당하는 배열의 비교 방식입니다.
+type ArrayRule struct {
+	Path      string        // 대상 배열의 경로 선택자 (예: $.items)
+	Strategy  ArrayStrategy // 비교 방식
+	KeyFields []string      // ArrayKeyed일 때 요소를 식별하는 필드 목록 (예: id)
+}
+
+// IsValid는 배열 비교 방식이 지원되는지 확인합니다.
+func (s ArrayStrategy) IsValid() bool {
+	switch s {
+	case ArrayOrdered, ArrayUnordered, ArrayKeyed:
+		return true
+	}
+	return false
+}
+
+// IsValid는 배열 샘플링 방식이 지원되는지 확인합니다.
+func (m ArraySamplingMode) IsValid() bool {
+	switch m {
+	case "", ArraySamplingHead, ArraySamplingUniform:
+		return true
+	}
+	return false
+}
+
+// validate는 배열 비교 규칙이 유효한지 검증합니다.
+func (r ArrayRule) validate() error {
+	if _, err := ParsePathSelector(r.Path); err != nil {
+		return err
+	}
+	if !r.Strategy.IsValid() {
+		return fmt.Errorf("unsupported array strategy %q for %q", r.Strategy, r.Path)
+	}
+	if r.Strategy == ArrayKeyed && len(r.KeyFields) == 0 {
+		return fmt.Errorf("keyed array strategy for %q requires key fields", r.Path)
+	}
+	return nil
+}
+
+// compiledArrayRule은 선택자를 미리 해석해 둔 ArrayRule입니다.
+type compiledArrayRule struct {
+	selector  PathSelector
+	strategy  ArrayStrategy
+	keyFields []string
+}
+
+// arrayRule은 경로에 적용할 배열 비교 규칙을 반환합니다. 일치하는 규칙이 없으면 ArrayOrdered입니다.
+func (e *ComparisonEngine) arrayRule(path jsonPath) compiledArrayRule {
+	for _, rule := range e.arrayRules {
+		if rule.selector.matches(path) {
+			return rule
+		}
+	}
+	return compiledArrayRule{strategy: ArrayOrdered}
+}
+
+// sampleIndices는 길이 n인 배열에서 비교할 인덱스를 고릅니다.
+// n이 limit 이하이면 모든 인덱스를 반환하며, 두 번째 반환값은 샘플링 여부입니다.
+func sampleIndices(n, limit int, mode ArraySamplingMode) ([]int, bool) {
+	if n <= limit {
+		indices := make([]int, n)
+		for i := range indices {
+			indices[i] = i
+		}
+		return indices, false
+	}
+
+	indices := make([]int, limit)
+	for i := range indices {
+		if mode == ArraySamplingUniform && limit > 1 {
+			// 첫 요소와 마지막 요소를 포함해 같은 간격으로 선택
+			indices[i] = i * (n - 1) / (limit - 1)
+		} else {
+			indices[i] = i
+		}
+	}
+	return indices, true
+}
+
+// maxArrayElements는 배열 하나에서 비교할 최대 요소 수를 반환합니다.
+func (e *ComparisonEngine) maxArrayElements() int {
+	if e.config.MaxArrayElements > 0 {
+		return e.config.MaxArrayElements
+	}
+	return DefaultMaxArrayElements
+}
+
+// compareArrays는 경로에 해당하는 배열 비교 방식으로 두 배열을 비교합니다.
+// weight는 배열 노드의 가중치이며, 요소의 가중치는 요소 경로마다 정합니다.
+func (e *ComparisonEngine) compareArrays(legacy, modern []interface{}, path jsonPath, weight float64, result *ComparisonResult) {
+	// 배열 길이 비교
+	if len(legacy) != len(modern) {
+		result.Differences = append(result.Differences, ResponseDiff{
+			Type:        VALUE_MISMATCH,
+			Path:        path.String(),
+			LegacyValue: len(legacy),
+			ModernValue: len(modern),
+			Message:     "Array length mismatch",
+		})
+	}
+
+	rule := e.arrayRule(path)
+	switch rule.strategy {
+	case ArrayUnordered:
+		e.compareUnorderedArrays(legacy, modern, path, weight, result)
+	case ArrayKeyed:
+		e.compareKeyedArrays(legacy, modern, path, rule.keyFields, weight, result)
+	default:
+		e.compareOrderedArrays(legacy, modern, path, weight, result)
+	}
+}
+
+// compareOrderedArrays는 같은 인덱스의 요소끼리 비교합니다.
+func (e *ComparisonEngine) compareOrderedArrays(legacy, modern []interface{}, path jsonPath, weight float64, result *ComparisonResult) {
+	maxLen := len(legacy)
+	if len(modern) > maxLen {
+		maxLen = len(modern)
+	}
+
+	indices, _ := sampleIndices(maxLen, e.maxArrayElements(), e.config.ArraySampling)
+	for _, i := range indices {
+		elemPath := path.at(i)
+		elemWeight := e.fieldWeight(elemPath, weight)
+		if i < len(legacy) && i < len(modern) {
+			e.compareJSON(legacy[i], modern[i], elemPath, elemWeight, result)
+		} else if i < len(legacy) {
+			e.reportMissingElement(legacy[i], elemPath, elemWeight, result)
+		} else {
+			e.reportExtraElement(modern[i], elemPath, elemWeight, result)
+		}
+	}
+}
+
+// compareUnorderedArrays는 순서를 무시하고 같은 요소끼리 짝지어 비교합니다.
+//
+// 정규화와 무시 규칙을 적용한 요소의 정규 표현(fingerprint)으로 먼저 짝을 찾고,
+// 남은 요소끼리는 허용 오차(AllowableDifference)를 고려해 한 번 더 짝을 찾습니다.
+// 요소의 위치는 의미가 없으므로 fingerprint는 양쪽 모두 인덱스와 무관한 경로(items[*])로 계산합니다.
+// 레거시 배열을 샘플링한 경우 비교하지 않은 레거시 요소와 짝일 수 있으므로 모던 쪽의 남은 요소는 보고하지 않습니다.
+func (e *ComparisonEngine) compareUnorderedArrays(legacy, modern []interface{}, path jsonPath, weight float64, result *ComparisonResult) {
+	indices, sampled := sampleIndices(len(legacy), e.maxArrayElements(), e.config.ArraySampling)
+
+	elemPath := path.anyElement()
+	available := make(map[string][]int, len(modern))
+	for j, elem := range modern {
+		key := e.fingerprint(elem, elemPath)
+		available[key] = append(available[key], j)
+	}
+
+	matched := make([]bool, len(modern))
+	var unmatched []int
+	for _, i := range indices {
+		key := e.fingerprint(legacy[i], elemPath)
+		if candidates := available[key]; len(candidates) > 0 {
+			j := candidates[0]
+			available[key] = candidates[1:]
+			matched[j] = true
+			e.compareJSON(legacy[i], modern[j], path.at(i), e.fieldWeight(path.at(i), weight), result)
+			continue
+		}
+		unmatched = append(unmatched, i)
+	}
+
+	var remaining []int
+	for j := range modern {
+		if !matched[j] {
+			remaining = append(remaining, j)
+		}
+	}
+	if len(remaining) > e.maxArrayElements() {
+		remaining = remaining[:e.maxArrayElements()]
+	}
+
+	for _, i := range unmatched {
+		found := false
+		for k, j := range remaining {
+			if scratch, ok := e.equivalent(legacy[i], modern[j], path.at(i), e.fieldWeight(path.at(i), weight)); ok {
+				result.addScore(scratch)
+				matched[j] = true
+				remaining = append(remaining[:k], remaining[k+1:]...)
+				found = true
+				break
+			}
+		}
+		if !found {
+			e.reportMissingElement(legacy[i], path.at(i), e.fieldWeight(path.at(i), weight), result)
+		}
+	}
+
+	if sampled {
+		return
+	}
+	for _, j := range remaining {
+		e.reportExtraElement(modern[j], path.at(j), e.fieldWeight(path.at(j), weight), result)
+	}
+}
+
+// compareKeyedArrays는 키 필드 값이 같은 요소끼리 짝지어 비교합니다.
+// 차이점 경로는 레거시(모던에만 있으면 모던) 요소의 키로 표시합니다 (예: items[id=42].name).
+func (e *ComparisonEngine) compareKeyedArrays(legacy, modern []interface{}, path jsonPath, keyFields []string, weight float64, result *ComparisonResult) {
+	modernByKey := make(map[string][]int, len(modern))
+	for j, elem := range modern {
+		key := e.elementKey(elem, path.at(j), keyFields)
+		modernByKey[key] = append(modernByKey[key], j)
+	}
+
+	legacyKeys := make(map[string]int, len(legacy))
+	for i, elem := range legacy {
+		legacyKeys[e.elementKey(elem, path.at(i), keyFields)]++
+	}
+
+	limit := e.maxArrayElements()
+	indices, _ := sampleIndices(len(legacy), limit, e.config.ArraySampling)
+	for _, i := range indices {
+		key := e.elementKey(legacy[i], path.at(i), keyFields)
+		elemPath := path.atKey(i, key)
+		elemWeight := e.fieldWeight(elemPath, weight)
+		if candidates := modernByKey[key]; len(candidates) > 0 {
+			modernByKey[key] = candidates[1:]
+			e.compareJSON(legacy[i], modern[candidates[0]], elemPath, elemWeight, result)
+			continue
+		}
+		e.reportMissingElement(legacy[i], elemPath, elemWeight, result)
+	}
+
+	// 레거시에 없는 키를 가진 모던 요소 (샘플링 여부와 관계없이 레거시 전체 키와 비교)
+	modernIndices, _ := sampleIndices(len(modern), limit, e.config.ArraySampling)
+	seen := make(map[string]int, len(modernIndices))
+	for _, j := range modernIndices {
+		key := e.elementKey(modern[j], path.at(j), keyFields)
+		seen[key]++
+		if seen[key] > legacyKeys[key] {
+			elemPath := path.atKey(j, key)
+			e.reportExtraElement(modern[j], elemPath, e.fieldWeight(elemPath, weight), result)
+		}
+	}
+}
+
+// reportMissingElement는 모던 응답에 없는 레거시 배열 요소를 차이점으로 기록합니다.
+func (e *ComparisonEngine) reportMissingElement(value interface{}, path jsonPath, weight float64, result *ComparisonResult) {
+	if e.shouldIgnoreField(path) {
+		return
+	}
+	result.Differences = append(result.Differences, ResponseDiff{
+		Type:        MISSING,
+		Path:        path.String(),
+		LegacyValue: e.formatValue(value),
+		Message:     "Array element missing in modern response",
+	})
+	e.scoreUnmatched(value, path, weight, result)
+}
+
+// reportExtraElement는 레거시 응답에 없는 모던 배열 요소를 차이점으로 기록합니다.
+func (e *ComparisonEngine) reportExtraElement(value interface{}, path jsonPath, weight float64, result *ComparisonResult) {
+	if e.shouldIgnoreField(path) {
+		return
+	}
+	result.Differences = append(result.Differences, ResponseDiff{
+		Type:        EXTRA,
+		Path:        path.String(),
+		ModernValue: e.formatValue(value),
+		Message:     "Extra array element in modern response",
+	})
+	e.scoreUnmatched(value, path, weight, result)
+}
+
+// equivalent는 두 값이 비교 규칙상 차이가 없는지 확인합니다.
+// 차이가 없으면 별도로 집계한 비교 결과를 함께 반환합니다.
+func (e *ComparisonEngine) equivalent(legacy, modern interface{}, path jsonPath, weight float64) (*ComparisonResult, bool) {
+	scratch := &ComparisonResult{}
+	e.compareJSON(legacy, modern, path, weight, scratch)
+	return scratch, len(scratch.Differences) == 0
+}
+
+// elementKey는 ArrayKeyed 배열 요소의 키 필드 값을 정규화해 하나의 키로 만듭니다 (예: id=42).
+// 키 필드가 없는 요소는 null 값으로 취급합니다.
+func (e *ComparisonEngine) elementKey(elem interface{}, path jsonPath, keyFields []string) string {
+	object, _ := elem.(map[string]interface{})
+	parts := make([]string, len(keyFields))
+	for k, field := range keyFields {
+		value := e.normalizeValue(path.child(field), object[field])
+		encoded, _ := json.Marshal(value)
+		parts[k] = field + "=" + strings.Trim(string(encoded), `"`)
+	}
+	return strings.Join(parts, ",")
+}
+
+// fingerprint는 무시 규칙과 정규화를 적용한 값의 정규 표현을 반환합니다.
+// 객체 키는 정렬되므로 같은 값은 필드 순서와 관계없이 같은 표현을 가집니다.
+func (e *ComparisonEngine) fingerprint(value interface{}, path jsonPath) string {
+	encoded, err := json.Marshal(e.canonicalize(value, path))
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(encoded)
+}
+
+// canonicalize는 무시할 필드를 제거하고 정규화를 적용한 값을 반환합니다.
+func (e *ComparisonEngine) canonicalize(value interface{}, path jsonPath) interface{} {
+	value = e.normalizeValue(path, value)
+	switch v := value.(type) {
+	case map[string]interface{}:
+		canonical := make(map[string]interface{}, len(v))
+		for key := range v {
+			childPath := path.child(key)
+			if e.shouldIgnoreField(childPath) {
+				continue
+			}
+			if fieldValue, ok := e.fieldValue(v, key, childPath); ok {
+				canonical[key] = e.canonicalize(fieldValue, childPath)
+			}
+		}
+		return canonical
+	case []interface{}:
+		canonical := make([]interface{}, 0, len(v))
+		for i, elem := range v {
+			if !e.shouldIgnoreField(path.at(i)) {
+				canonical = append(canonical, e.canonicalize(elem, path.at(i)))
+			}
+		}
+		return canonical
+	}
+	return value
+}
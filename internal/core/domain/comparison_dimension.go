@@ -0,0 +1,389 @@
+package domain
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"mime"
+	"net/http"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// ComparisonDimension은 응답 비교 항목입니다.
+type ComparisonDimension string
+
+const (
+	DimensionStatusCode ComparisonDimension = "status_code" // HTTP 상태 코드
+	DimensionHeaders    ComparisonDimension = "headers"     // 비교 대상 응답 헤더
+	DimensionBody       ComparisonDimension = "body"        // 응답 본문
+)
+
+// ComparisonWeights는 비교 항목별 일치율 가중치입니다.
+// 최종 일치율은 비교한 항목의 가중 평균이며, 가중치가 0인 항목은 비교하지 않습니다.
+type ComparisonWeights struct {
+	StatusCode float64 // 상태 코드 가중치
+	Headers    float64 // 헤더 가중치
+	Body       float64 // 본문 가중치
+}
+
+// DefaultComparisonWeights는 가중치가 지정되지 않았을 때 사용하는 비교 항목별 가중치입니다.
+func DefaultComparisonWeights() ComparisonWeights {
+	return ComparisonWeights{StatusCode: 0.2, Headers: 0.1, Body: 0.7}
+}
+
+// IsZero는 가중치가 전혀 지정되지 않았는지 확인합니다.
+func (w ComparisonWeights) IsZero() bool {
+	return w.StatusCode == 0 && w.Headers == 0 && w.Body == 0
+}
+
+// DefaultComparedHeaders는 CompareHeaders가 지정되지 않았을 때 비교하는 응답 헤더입니다.
+var DefaultComparedHeaders = []string{"Content-Type", "Cache-Control"}
+
+// DimensionResult는 비교 항목 하나의 일치율입니다.
+type DimensionResult struct {
+	Dimension ComparisonDimension `json:"dimension"`
+	MatchRate float64             `json:"match_rate"`
+	Weight    float64             `json:"weight"`
+}
+
+// bodyKind는 본문 비교 방식을 결정하는 본문 형식입니다.
+type bodyKind string
+
+const (
+	bodyEmpty  bodyKind = "empty"
+	bodyJSON   bodyKind = "json"
+	bodyXML    bodyKind = "xml"
+	bodyText   bodyKind = "text"
+	bodyBinary bodyKind = "binary"
+)
+
+// maxBodyPreview는 차이점에 기록하는 본문 미리보기의 최대 바이트 수입니다.
+const maxBodyPreview = 512
+
+// effectiveWeights는 비교 설정의 항목별 가중치를 반환합니다.
+func (e *ComparisonEngine) effectiveWeights() ComparisonWeights {
+	if e.config.Weights.IsZero() {
+		return DefaultComparisonWeights()
+	}
+	return e.config.Weights
+}
+
+// comparedHeaders는 비교할 응답 헤더 목록을 반환합니다.
+func (e *ComparisonEngine) comparedHeaders() []string {
+	if e.config.CompareHeaders == nil {
+		return DefaultComparedHeaders
+	}
+	return e.config.CompareHeaders
+}
+
+// compareStatusCode는 상태 코드를 비교하고 일치율(0 또는 1)을 반환합니다.
+func (e *ComparisonEngine) compareStatusCode(legacy, modern *Response, result *ComparisonResult) float64 {
+	if legacy.StatusCode == modern.StatusCode {
+		return 1.0
+	}
+	result.Differences = append(result.Differences, ResponseDiff{
+		Type:        VALUE_MISMATCH,
+		Dimension:   DimensionStatusCode,
+		Path:        "status_code",
+		LegacyValue: legacy.StatusCode,
+		ModernValue: modern.StatusCode,
+		Message:     "Status code mismatch",
+	})
+	return 0.0
+}
+
+// compareHeaders는 비교 대상 헤더를 비교하고 일치율을 반환합니다.
+// 양쪽 모두에 없는 헤더는 비교하지 않으며, 비교한 헤더가 없으면 두 번째 반환값이 false입니다.
+func (e *ComparisonEngine) compareHeaders(legacy, modern *Response, result *ComparisonResult) (float64, bool) {
+	compared, matched := 0, 0
+	for _, name := range e.comparedHeaders() {
+		legacyValue, legacyExists := joinedHeader(legacy.Headers, name)
+		modernValue, modernExists := joinedHeader(modern.Headers, name)
+		if !legacyExists && !modernExists {
+			continue
+		}
+		compared++
+
+		switch {
+		case !modernExists:
+			result.Differences = append(result.Differences, ResponseDiff{
+				Type:        MISSING,
+				Dimension:   DimensionHeaders,
+				Path:        http.CanonicalHeaderKey(name),
+				LegacyValue: legacyValue,
+				Message:     "Header missing in modern response",
+			})
+		case !legacyExists:
+			result.Differences = append(result.Differences, ResponseDiff{
+				Type:        EXTRA,
+				Dimension:   DimensionHeaders,
+				Path:        http.CanonicalHeaderKey(name),
+				ModernValue: modernValue,
+				Message:     "Extra header in modern response",
+			})
+		case normalizeHeaderValue(name, legacyValue) != normalizeHeaderValue(name, modernValue):
+			result.Differences = append(result.Differences, ResponseDiff{
+				Type:        VALUE_MISMATCH,
+				Dimension:   DimensionHeaders,
+				Path:        http.CanonicalHeaderKey(name),
+				LegacyValue: legacyValue,
+				ModernValue: modernValue,
+				Message:     "Header value mismatch",
+			})
+		default:
+			matched++
+		}
+	}
+
+	if compared == 0 {
+		return 0, false
+	}
+	return float64(matched) / float64(compared), true
+}
+
+// compareBody는 본문 형식에 따라 본문을 비교하고 일치율을 반환합니다.
+//
+// JSON은 리프 단위 가중 점수로 비교하고, XML은 정규화(공백, 주석, 속성 순서, 네임스페이스 접두사 무시) 후,
+// 텍스트는 줄바꿈과 앞뒤 공백을 정규화한 후, 바이너리는 SHA-256 해시로 비교합니다.
+func (e *ComparisonEngine) compareBody(legacy, modern *Response, result *ComparisonResult) float64 {
+	legacyKind, legacyData := detectBody(legacy)
+	modernKind, modernData := detectBody(modern)
+
+	if legacyKind != modernKind {
+		result.Differences = append(result.Differences, ResponseDiff{
+			Type:        TYPE_MISMATCH,
+			Dimension:   DimensionBody,
+			LegacyValue: bodyPreview(legacyKind, legacy.Body),
+			ModernValue: bodyPreview(modernKind, modern.Body),
+			Message:     "Body format mismatch: legacy " + string(legacyKind) + ", modern " + string(modernKind),
+		})
+		return 0.0
+	}
+
+	switch legacyKind {
+	case bodyEmpty:
+		return 1.0
+
+	case bodyJSON:
+		body := &ComparisonResult{}
+		e.compareJSON(legacyData, modernData, nil, e.fieldWeight(nil, 1.0), body)
+		for i := range body.Differences {
+			body.Differences[i].Dimension = DimensionBody
+		}
+		result.Differences = append(result.Differences, body.Differences...)
+		result.addScore(body)
+		if body.TotalWeight == 0 {
+			return 1.0
+		}
+		return body.MatchedWeight / body.TotalWeight
+
+	case bodyXML:
+		legacyXML, legacyErr := canonicalXML(legacy.Body)
+		modernXML, modernErr := canonicalXML(modern.Body)
+		if legacyErr == nil && modernErr == nil {
+			return e.compareBodyContent(legacyKind, legacyXML == modernXML, legacy, modern, result)
+		}
+		return e.compareBodyContent(bodyText, canonicalText(legacy.Body) == canonicalText(modern.Body), legacy, modern, result)
+
+	case bodyText:
+		return e.compareBodyContent(legacyKind, canonicalText(legacy.Body) == canonicalText(modern.Body), legacy, modern, result)
+
+	default:
+		return e.compareBodyContent(legacyKind, bodyHash(legacy.Body) == bodyHash(modern.Body), legacy, modern, result)
+	}
+}
+
+// compareBodyContent는 본문 전체 비교 결과를 기록하고 일치율(0 또는 1)을 반환합니다.
+func (e *ComparisonEngine) compareBodyContent(kind bodyKind, equal bool, legacy, modern *Response, result *ComparisonResult) float64 {
+	if equal {
+		return 1.0
+	}
+	result.Differences = append(result.Differences, ResponseDiff{
+		Type:        VALUE_MISMATCH,
+		Dimension:   DimensionBody,
+		LegacyValue: bodyPreview(kind, legacy.Body),
+		ModernValue: bodyPreview(kind, modern.Body),
+		Message:     "Body mismatch (" + string(kind) + ")",
+	})
+	return 0.0
+}
+
+// detectBody는 콘텐츠 타입과 본문 내용으로 본문 형식을 판별합니다.
+// JSON 본문은 파싱한 값을 함께 반환하며, JSON으로 선언되었지만 파싱할 수 없는 본문은 텍스트로 취급합니다.
+func detectBody(response *Response) (bodyKind, interface{}) {
+	if len(response.Body) == 0 {
+		return bodyEmpty, nil
+	}
+
+	kind := bodyKindFromContentType(responseContentType(response))
+	if kind == "" {
+		switch {
+		case json.Valid(response.Body):
+			kind = bodyJSON
+		case utf8.Valid(response.Body):
+			kind = bodyText
+		default:
+			kind = bodyBinary
+		}
+	}
+
+	if kind == bodyJSON {
+		var data interface{}
+		if err := json.Unmarshal(response.Body, &data); err != nil {
+			return bodyText, nil
+		}
+		return bodyJSON, data
+	}
+	return kind, nil
+}
+
+// bodyKindFromContentType은 콘텐츠 타입으로 본문 형식을 결정합니다. 알 수 없으면 빈 값을 반환합니다.
+func bodyKindFromContentType(contentType string) bodyKind {
+	if contentType == "" {
+		return ""
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return ""
+	}
+
+	switch {
+	case mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"):
+		return bodyJSON
+	case mediaType == "application/xml" || mediaType == "text/xml" || strings.HasSuffix(mediaType, "+xml"):
+		return bodyXML
+	case strings.HasPrefix(mediaType, "text/"),
+		mediaType == "application/javascript",
+		mediaType == "application/x-www-form-urlencoded":
+		return bodyText
+	}
+	return bodyBinary
+}
+
+// responseContentType은 응답의 콘텐츠 타입을 반환합니다.
+func responseContentType(response *Response) string {
+	if response.ContentType != "" {
+		return response.ContentType
+	}
+	value, _ := lookupHeader(response.Headers, "Content-Type")
+	return value
+}
+
+// joinedHeader는 대소문자를 구분하지 않고 헤더를 조회해 여러 값을 ", "로 이어 반환합니다.
+func joinedHeader(header http.Header, name string) (string, bool) {
+	values := headerValues(header, name)
+	if len(values) == 0 {
+		return "", false
+	}
+	return strings.Join(values, ", "), true
+}
+
+// normalizeHeaderValue는 헤더 값을 비교용으로 정규화합니다.
+// Content-Type은 미디어 타입과 파라미터를, Cache-Control은 지시어 순서와 대소문자를 무시합니다.
+func normalizeHeaderValue(name, value string) string {
+	value = strings.TrimSpace(value)
+	switch http.CanonicalHeaderKey(name) {
+	case "Content-Type":
+		if mediaType, params, err := mime.ParseMediaType(value); err == nil {
+			for key, param := range params {
+				params[key] = strings.ToLower(param)
+			}
+			return mime.FormatMediaType(mediaType, params)
+		}
+	case "Cache-Control":
+		directives := strings.Split(strings.ToLower(value), ",")
+		for i := range directives {
+			directives[i] = strings.TrimSpace(directives[i])
+		}
+		sort.Strings(directives)
+		return strings.Join(directives, ",")
+	}
+	return value
+}
+
+// canonicalText는 줄바꿈 형식과 앞뒤 공백을 정규화한 텍스트를 반환합니다.
+func canonicalText(body []byte) string {
+	return strings.TrimSpace(strings.ReplaceAll(string(body), "\r\n", "\n"))
+}
+
+// canonicalXML은 비교용으로 정규화한 XML을 반환합니다.
+// 선언, 주석, 처리 명령, 공백만 있는 텍스트를 제거하고, 속성을 정렬하며, 네임스페이스 접두사 대신 URI를 사용합니다.
+func canonicalXML(body []byte) (string, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(body))
+	var b strings.Builder
+
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			b.WriteString("<" + xmlName(t.Name))
+			attrs := make([]string, 0, len(t.Attr))
+			for _, attr := range t.Attr {
+				if attr.Name.Space == "xmlns" || (attr.Name.Space == "" && attr.Name.Local == "xmlns") {
+					continue
+				}
+				var value strings.Builder
+				_ = xml.EscapeText(&value, []byte(attr.Value))
+				attrs = append(attrs, xmlName(attr.Name)+`="`+value.String()+`"`)
+			}
+			sort.Strings(attrs)
+			for _, attr := range attrs {
+				b.WriteString(" " + attr)
+			}
+			b.WriteString(">")
+		case xml.EndElement:
+			b.WriteString("</" + xmlName(t.Name) + ">")
+		case xml.CharData:
+			if text := bytes.TrimSpace(t); len(text) > 0 {
+				_ = xml.EscapeText(&b, text)
+			}
+		}
+	}
+
+	return b.String(), nil
+}
+
+// xmlName은 네임스페이스 URI를 포함한 XML 이름을 반환합니다.
+func xmlName(name xml.Name) string {
+	if name.Space == "" {
+		return name.Local
+	}
+	return "{" + name.Space + "}" + name.Local
+}
+
+// bodyHash는 본문의 SHA-256 해시를 반환합니다.
+func bodyHash(body []byte) string {
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:])
+}
+
+// bodyPreview는 차이점에 기록할 본문 미리보기를 반환합니다.
+// 바이너리 본문은 해시로, 그 외에는 최대 maxBodyPreview 바이트의 텍스트로 기록합니다.
+func bodyPreview(kind bodyKind, body []byte) interface{} {
+	switch kind {
+	case bodyEmpty:
+		return nil
+	case bodyBinary:
+		return "sha256:" + bodyHash(body)
+	}
+	if len(body) > maxBodyPreview {
+		end := maxBodyPreview
+		for end > 0 && !utf8.RuneStart(body[end]) {
+			end--
+		}
+		return string(body[:end]) + "..."
+	}
+	return string(body)
+}
@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"encoding/json"
+	"flag"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var updateGolden = flag.Bool("update", false, "update golden files")
+
+// comparisonGoldenCase는 testdata/comparison_golden.json의 비교 사례입니다.
+type comparisonGoldenCase struct {
+	Name         string             `json:"name"`
+	IgnoreFields []string           `json:"ignore_fields,omitempty"`
+	FieldWeights map[string]float64 `json:"field_weights,omitempty"`
+	Legacy       json.RawMessage    `json:"legacy"`
+	Modern       json.RawMessage    `json:"modern"`
+	Want         *comparisonScore   `json:"want,omitempty"`
+}
+
+// comparisonScore는 비교 사례의 고정된 점수입니다.
+type comparisonScore struct {
+	MatchRate     float64 `json:"match_rate"`
+	TotalFields   int     `json:"total_fields"`
+	MatchedFields int     `json:"matched_fields"`
+	Differences   int     `json:"differences"`
+}
+
+func TestComparisonEngine_GoldenScores(t *testing.T) {
+	goldenPath := filepath.Join("testdata", "comparison_golden.json")
+	data, err := os.ReadFile(goldenPath)
+	if err != nil {
+		t.Fatalf("failed to read golden file: %v", err)
+	}
+
+	var cases []*comparisonGoldenCase
+	if err := json.Unmarshal(data, &cases); err != nil {
+		t.Fatalf("failed to parse golden file: %v", err)
+	}
+
+	for _, tc := range cases {
+		config := ComparisonConfig{Enabled: true, IgnoreFields: tc.IgnoreFields, Weights: ComparisonWeights{Body: 1}}
+		for path, weight := range tc.FieldWeights {
+			config.FieldWeights = append(config.FieldWeights, FieldWeight{Path: path, Weight: weight})
+		}
+
+		result := NewComparisonEngine(config).CompareResponses(jsonResponse(string(tc.Legacy)), jsonResponse(string(tc.Modern)))
+		got := &comparisonScore{
+			MatchRate:     math.Round(result.MatchRate*10000) / 10000,
+			TotalFields:   result.TotalFields,
+			MatchedFields: result.MatchedFields,
+			Differences:   len(result.Differences),
+		}
+
+		if *updateGolden {
+			tc.Want = got
+			continue
+		}
+
+		t.Run(tc.Name, func(t *testing.T) {
+			if tc.Want == nil {
+				t.Fatalf("golden score missing, run with -update")
+			}
+			if *got != *tc.Want {
+				t.Errorf("score = %+v, want %+v", *got, *tc.Want)
+			}
+		})
+	}
+
+	if *updateGolden {
+		updated, err := json.MarshalIndent(cases, "", "  ")
+		if err != nil {
+			t.Fatalf("failed to marshal golden file: %v", err)
+		}
+		if err := os.WriteFile(goldenPath, append(updated, '\n'), 0o644); err != nil {
+			t.Fatalf("failed to write golden file: %v", err)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package domain
+
+import (
+	"regexp"
+	"sort"
+	"time"
+)
+
+// InsightBucket은 일치율 추이를 집계하는 시간 단위입니다.
+type InsightBucket string
+
+const (
+	InsightBucketHour InsightBucket = "hour" // 시간 단위
+	InsightBucketDay  InsightBucket = "day"  // 일 단위 (UTC 기준)
+)
+
+// DefaultTopMismatchPaths는 불일치 경로 순위의 기본 개수입니다.
+const DefaultTopMismatchPaths = 20
+
+// IsValid는 집계 단위가 지원되는지 확인합니다.
+func (b InsightBucket) IsValid() bool {
+	return b == InsightBucketHour || b == InsightBucketDay
+}
+
+// Truncate는 시간을 집계 구간의 시작 시간(UTC)으로 내립니다.
+func (b InsightBucket) Truncate(t time.Time) time.Time {
+	t = t.UTC()
+	if b == InsightBucketDay {
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	}
+	return t.Truncate(time.Hour)
+}
+
+// PathMismatch는 경로별 불일치 집계입니다.
+type PathMismatch struct {
+	Dimension   ComparisonDimension // 비교 항목 (status_code, headers, body)
+	Path        string              // 배열 인덱스와 키를 [*]로 일반화한 경로
+	Count       int                 // 차이점 발생 횟수
+	Comparisons int                 // 이 경로에 차이가 있었던 비교 건수
+}
+
+// MatchRateBucket은 집계 구간 하나의 일치율 통계입니다.
+type MatchRateBucket struct {
+	Start            time.Time // 구간 시작 시간 (UTC)
+	Comparisons      int       // 비교 건수
+	Mismatched       int       // 차이점이 하나 이상 있었던 비교 건수
+	AverageMatchRate float64   // 평균 일치율
+	MinMatchRate     float64   // 최저 일치율
+}
+
+// ComparisonInsights는 라우팅 규칙의 비교 결과를 경로, 차이점 유형, 시간대별로 집계한 분석 결과입니다.
+// 모던 API 팀이 어떤 필드부터 수정해야 할지 우선순위를 정하는 데 사용합니다.
+type ComparisonInsights struct {
+	RoutingRuleID    string
+	From             time.Time
+	To               time.Time
+	Bucket           InsightBucket
+	TotalComparisons int
+	TopPaths         []PathMismatch    // 발생 횟수 내림차순
+	DiffTypeCounts   map[DiffType]int  // 차이점 유형별 발생 횟수
+	Trend            []MatchRateBucket // 구간 시작 시간 오름차순
+
+	paths   map[pathKey]*PathMismatch
+	buckets map[time.Time]*MatchRateBucket
+	limit   int
+}
+
+// pathKey는 경로별 집계의 키입니다.
+type pathKey struct {
+	dimension ComparisonDimension
+	path      string
+}
+
+// NewComparisonInsights는 비어 있는 분석 결과를 생성합니다. limit은 TopPaths 최대 개수입니다.
+func NewComparisonInsights(routingRuleID string, from, to time.Time, bucket InsightBucket, limit int) *ComparisonInsights {
+	if !bucket.IsValid() {
+		bucket = InsightBucketHour
+	}
+	if limit <= 0 {
+		limit = DefaultTopMismatchPaths
+	}
+	return &ComparisonInsights{
+		RoutingRuleID:  routingRuleID,
+		From:           from,
+		To:             to,
+		Bucket:         bucket,
+		TopPaths:       []PathMismatch{},
+		DiffTypeCounts: make(map[DiffType]int),
+		Trend:          []MatchRateBucket{},
+		paths:          make(map[pathKey]*PathMismatch),
+		buckets:        make(map[time.Time]*MatchRateBucket),
+		limit:          limit,
+	}
+}
+
+// Add는 비교 결과 하나를 집계에 반영합니다. createdAt은 비교 시점입니다.
+func (i *ComparisonInsights) Add(matchRate float64, differences []ResponseDiff, createdAt time.Time) {
+	i.TotalComparisons++
+
+	seen := make(map[pathKey]bool)
+	for _, diff := range differences {
+		i.DiffTypeCounts[diff.Type]++
+
+		key := pathKey{dimension: diff.Dimension, path: generalizePath(diff.Path)}
+		entry, exists := i.paths[key]
+		if !exists {
+			entry = &PathMismatch{Dimension: key.dimension, Path: key.path}
+			i.paths[key] = entry
+		}
+		entry.Count++
+		if !seen[key] {
+			seen[key] = true
+			entry.Comparisons++
+		}
+	}
+
+	start := i.Bucket.Truncate(createdAt)
+	bucket, exists := i.buckets[start]
+	if !exists {
+		bucket = &MatchRateBucket{Start: start, MinMatchRate: matchRate}
+		i.buckets[start] = bucket
+	}
+	// 평균은 누적 합으로 두었다가 Finalize에서 나눔
+	bucket.Comparisons++
+	bucket.AverageMatchRate += matchRate
+	if matchRate < bucket.MinMatchRate {
+		bucket.MinMatchRate = matchRate
+	}
+	if len(differences) > 0 {
+		bucket.Mismatched++
+	}
+}
+
+// Finalize는 집계를 마무리해 TopPaths와 Trend를 채웁니다. 모든 Add 호출 후 한 번 호출합니다.
+func (i *ComparisonInsights) Finalize() *ComparisonInsights {
+	i.TopPaths = make([]PathMismatch, 0, len(i.paths))
+	for _, entry := range i.paths {
+		i.TopPaths = append(i.TopPaths, *entry)
+	}
+	sort.Slice(i.TopPaths, func(a, b int) bool {
+		pa, pb := i.TopPaths[a], i.TopPaths[b]
+		if pa.Count != pb.Count {
+			return pa.Count > pb.Count
+		}
+		if pa.Dimension != pb.Dimension {
+			return pa.Dimension < pb.Dimension
+		}
+		return pa.Path < pb.Path
+	})
+	if len(i.TopPaths) > i.limit {
+		i.TopPaths = i.TopPaths[:i.limit]
+	}
+
+	i.Trend = make([]MatchRateBucket, 0, len(i.buckets))
+	for _, bucket := range i.buckets {
+		b := *bucket
+		b.AverageMatchRate /= float64(b.Comparisons)
+		i.Trend = append(i.Trend, b)
+	}
+	sort.Slice(i.Trend, func(a, b int) bool {
+		return i.Trend[a].Start.Before(i.Trend[b].Start)
+	})
+	return i
+}
+
+// arrayElementPattern은 경로의 배열 인덱스([0])와 keyed 요소([id=42])입니다.
+var arrayElementPattern = regexp.MustCompile(`\[[^\]]*\]`)
+
+// generalizePath는 배열 인덱스와 키를 [*]로 바꿔 같은 필드의 차이를 하나로 모읍니다.
+// 예: items[3].price, items[id=42].price → items[*].price
+func generalizePath(path string) string {
+	return arrayElementPattern.ReplaceAllString(path, "[*]")
+}
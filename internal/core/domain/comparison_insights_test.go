@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestComparisonInsights(t *testing.T) {
+	base := time.Date(2025, 1, 1, 10, 15, 0, 0, time.UTC)
+	insights := NewComparisonInsights("rule-1", base, base.Add(48*time.Hour), InsightBucketHour, 2)
+
+	insights.Add(0.5, []ResponseDiff{
+		{Type: VALUE_MISMATCH, Dimension: DimensionBody, Path: "items[0].price"},
+		{Type: VALUE_MISMATCH, Dimension: DimensionBody, Path: "items[3].price"},
+		{Type: MISSING, Dimension: DimensionBody, Path: "name"},
+	}, base)
+	insights.Add(0.9, []ResponseDiff{
+		{Type: VALUE_MISMATCH, Dimension: DimensionBody, Path: "items[id=42].price"},
+		{Type: VALUE_MISMATCH, Dimension: DimensionHeaders, Path: "Content-Type"},
+	}, base.Add(30*time.Minute))
+	insights.Add(1.0, nil, base.Add(2*time.Hour))
+	insights.Finalize()
+
+	if insights.TotalComparisons != 3 {
+		t.Errorf("TotalComparisons = %d, want 3", insights.TotalComparisons)
+	}
+
+	if len(insights.TopPaths) != 2 {
+		t.Fatalf("TopPaths = %d entries, want limit 2", len(insights.TopPaths))
+	}
+	top := insights.TopPaths[0]
+	if top.Path != "items[*].price" || top.Count != 3 || top.Comparisons != 2 {
+		t.Errorf("top path = %+v, want items[*].price count 3 in 2 comparisons", top)
+	}
+	// 같은 횟수는 비교 항목, 경로 순으로 정렬
+	if second := insights.TopPaths[1]; second.Dimension != DimensionBody || second.Path != "name" {
+		t.Errorf("second path = %+v, want body name", second)
+	}
+
+	if insights.DiffTypeCounts[VALUE_MISMATCH] != 4 || insights.DiffTypeCounts[MISSING] != 1 {
+		t.Errorf("DiffTypeCounts = %v", insights.DiffTypeCounts)
+	}
+
+	if len(insights.Trend) != 2 {
+		t.Fatalf("Trend = %d buckets, want 2", len(insights.Trend))
+	}
+	first := insights.Trend[0]
+	if !first.Start.Equal(time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf("first bucket start = %v", first.Start)
+	}
+	if first.Comparisons != 2 || first.Mismatched != 2 || first.AverageMatchRate != 0.7 || first.MinMatchRate != 0.5 {
+		t.Errorf("first bucket = %+v", first)
+	}
+	if last := insights.Trend[1]; last.Comparisons != 1 || last.Mismatched != 0 || last.AverageMatchRate != 1.0 {
+		t.Errorf("last bucket = %+v", last)
+	}
+}
+
+func TestInsightBucket_Truncate(t *testing.T) {
+	ts := time.Date(2025, 3, 9, 23, 45, 10, 0, time.FixedZone("KST", 9*60*60))
+
+	if got := InsightBucketHour.Truncate(ts); !got.Equal(time.Date(2025, 3, 9, 14, 0, 0, 0, time.UTC)) {
+		t.Errorf("hour truncate = %v", got)
+	}
+	if got := InsightBucketDay.Truncate(ts); !got.Equal(time.Date(2025, 3, 9, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("day truncate = %v", got)
+	}
+}
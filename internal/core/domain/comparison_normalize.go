@@ -0,0 +1,125 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// NormalizerType은 비교 전에 필드 값에 적용하는 정규화 종류입니다.
+type NormalizerType string
+
+const (
+	NormalizeTrim          NormalizerType = "trim"            // 문자열 앞뒤 공백 제거
+	NormalizeIgnoreCase    NormalizerType = "ignore_case"     // 문자열 대소문자 무시 (소문자로 변환)
+	NormalizeTimestamp     NormalizerType = "timestamp"       // 시간 문자열/epoch 숫자를 UTC RFC3339Nano로 변환
+	NormalizeNumericString NormalizerType = "numeric_string"  // 숫자 문자열을 숫자로 변환
+	NormalizeNullAsMissing NormalizerType = "null_as_missing" // null 필드를 없는 필드로 취급
+)
+
+// FieldNormalizer는 경로 선택자에 해당하는 필드 값을 비교 전에 정규화하는 규칙입니다.
+// 레거시와 모던 API가 같은 데이터를 다른 형식으로 내려주는 경우(공백, 대소문자, 시간 형식 등)에 사용합니다.
+type FieldNormalizer struct {
+	Path        string           // 대상 경로 선택자 (예: $.items[*].updatedAt)
+	Normalizers []NormalizerType // 적용할 정규화 (나열한 순서대로 적용)
+}
+
+// timestampLayouts는 NormalizeTimestamp가 시도하는 시간 형식입니다.
+// 시간대가 없는 형식은 UTC로 해석합니다.
+var timestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999",
+	time.RFC1123Z,
+	time.RFC1123,
+	"2006-01-02",
+}
+
+// epochMillisThreshold 이상의 epoch 숫자는 밀리초로, 미만은 초로 해석합니다.
+const epochMillisThreshold = 1e12
+
+// IsValid는 정규화 종류가 지원되는지 확인합니다.
+func (n NormalizerType) IsValid() bool {
+	switch n {
+	case NormalizeTrim, NormalizeIgnoreCase, NormalizeTimestamp, NormalizeNumericString, NormalizeNullAsMissing:
+		return true
+	}
+	return false
+}
+
+// apply는 값 하나에 정규화를 적용합니다. 적용할 수 없는 값은 그대로 반환합니다.
+// NormalizeNullAsMissing은 객체 필드 단위로 처리하므로 여기서는 아무것도 하지 않습니다.
+func (n NormalizerType) apply(value interface{}) interface{} {
+	switch n {
+	case NormalizeTrim:
+		if s, ok := value.(string); ok {
+			return strings.TrimSpace(s)
+		}
+	case NormalizeIgnoreCase:
+		if s, ok := value.(string); ok {
+			return strings.ToLower(s)
+		}
+	case NormalizeNumericString:
+		if s, ok := value.(string); ok {
+			if f, err := strconv.ParseFloat(strings.TrimSpace(s), 64); err == nil {
+				return f
+			}
+		}
+	case NormalizeTimestamp:
+		if t, ok := parseTimestamp(value); ok {
+			return t.UTC().Format(time.RFC3339Nano)
+		}
+	}
+	return value
+}
+
+// parseTimestamp는 시간 문자열 또는 epoch 숫자(초/밀리초)를 시간으로 해석합니다.
+func parseTimestamp(value interface{}) (time.Time, bool) {
+	switch v := value.(type) {
+	case string:
+		s := strings.TrimSpace(v)
+		for _, layout := range timestampLayouts {
+			if t, err := time.Parse(layout, s); err == nil {
+				return t, true
+			}
+		}
+		if f, err := strconv.ParseFloat(s, 64); err == nil {
+			return epochToTime(f), true
+		}
+	case float64:
+		return epochToTime(v), true
+	}
+	return time.Time{}, false
+}
+
+// epochToTime은 epoch 숫자를 시간으로 변환합니다.
+func epochToTime(epoch float64) time.Time {
+	if epoch >= epochMillisThreshold {
+		return time.UnixMilli(int64(epoch))
+	}
+	return time.Unix(int64(epoch), int64((epoch-float64(int64(epoch)))*1e9))
+}
+
+// compiledNormalizer는 선택자를 미리 해석해 둔 FieldNormalizer입니다.
+type compiledNormalizer struct {
+	selector    PathSelector
+	normalizers []NormalizerType
+}
+
+// validate는 정규화 규칙이 유효한지 검증합니다.
+func (f FieldNormalizer) validate() error {
+	if _, err := ParsePathSelector(f.Path); err != nil {
+		return err
+	}
+	if len(f.Normalizers) == 0 {
+		return fmt.Errorf("normalizer for %q has no normalizers", f.Path)
+	}
+	for _, n := range f.Normalizers {
+		if !n.IsValid() {
+			return fmt.Errorf("unsupported normalizer %q for %q", n, f.Path)
+		}
+	}
+	return nil
+}
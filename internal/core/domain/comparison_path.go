@@ -0,0 +1,240 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// pathElement는 JSON 경로의 한 단계(객체 키 또는 배열 인덱스)입니다.
+// 배열 인덱스에 key가 있으면 차이점 보고 시 인덱스 대신 key를 표시합니다 (키 기반 배열 비교).
+// anyIndex인 요소는 특정 위치가 아닌 배열의 임의 요소([*])를 나타냅니다 (순서 무시 배열 비교).
+type pathElement struct {
+	key      string
+	index    int
+	isIndex  bool
+	anyIndex bool
+}
+
+// jsonPath는 비교 중인 JSON 노드의 루트로부터의 경로입니다.
+type jsonPath []pathElement
+
+// child는 객체 키 key를 덧붙인 새 경로를 반환합니다.
+func (p jsonPath) child(key string) jsonPath {
+	return append(p[:len(p):len(p)], pathElement{key: key})
+}
+
+// at은 배열 인덱스 i를 덧붙인 새 경로를 반환합니다.
+func (p jsonPath) at(i int) jsonPath {
+	return append(p[:len(p):len(p)], pathElement{index: i, isIndex: true})
+}
+
+// anyElement는 특정 인덱스가 아닌 배열의 임의 요소([*])를 덧붙인 새 경로를 반환합니다.
+// 이 경로에는 [*] 선택자만 일치하고 [N] 선택자는 일치하지 않습니다.
+func (p jsonPath) anyElement() jsonPath {
+	return append(p[:len(p):len(p)], pathElement{isIndex: true, anyIndex: true})
+}
+
+// atKey는 배열 인덱스 i를 덧붙이되 보고용으로 요소 키 label을 표시하는 새 경로를 반환합니다.
+func (p jsonPath) atKey(i int, label string) jsonPath {
+	return append(p[:len(p):len(p)], pathElement{key: label, index: i, isIndex: true})
+}
+
+// String은 차이점 보고용 경로 문자열을 반환합니다 (예: items[0].name, items[id=42].name, 루트는 빈 문자열).
+func (p jsonPath) String() string {
+	var b strings.Builder
+	for i, elem := range p {
+		if elem.isIndex {
+			if elem.key != "" {
+				b.WriteString("[" + elem.key + "]")
+			} else if elem.anyIndex {
+				b.WriteString("[*]")
+			} else {
+				b.WriteString("[" + strconv.Itoa(elem.index) + "]")
+			}
+			continue
+		}
+		if i > 0 {
+			b.WriteByte('.')
+		}
+		b.WriteString(elem.key)
+	}
+	return b.String()
+}
+
+// selectorKind는 경로 선택자 세그먼트의 종류입니다.
+type selectorKind int
+
+const (
+	selectorKey       selectorKind = iota // 객체 키 (glob 패턴 * ? 지원)
+	selectorIndex                         // 특정 배열 인덱스 [N]
+	selectorAnyIndex                      // 모든 배열 인덱스 [*]
+	selectorRecursive                     // 임의 깊이 (..)
+)
+
+// selectorSegment는 경로 선택자의 한 세그먼트입니다.
+type selectorSegment struct {
+	kind    selectorKind
+	pattern string // selectorKey일 때 키 이름 또는 glob 패턴
+	glob    bool   // pattern에 glob 문자가 있는지 여부
+	index   int    // selectorIndex일 때 인덱스
+}
+
+// matches는 세그먼트가 경로 요소 하나와 일치하는지 확인합니다.
+func (s selectorSegment) matches(elem pathElement) bool {
+	switch s.kind {
+	case selectorKey:
+		if elem.isIndex {
+			return false
+		}
+		if s.glob {
+			return matchGlob(s.pattern, elem.key)
+		}
+		return s.pattern == elem.key
+	case selectorIndex:
+		return elem.isIndex && !elem.anyIndex && elem.index == s.index
+	case selectorAnyIndex:
+		return elem.isIndex
+	}
+	return false
+}
+
+// PathSelector는 비교 대상 JSON 노드를 고르는 경로 선택자입니다.
+//
+// 지원 문법 (JSONPath 부분 집합 + 키 glob):
+//   - $.items[*].updatedAt : 루트부터 고정된 경로 ($로 시작하면 루트에 고정)
+//   - $..updatedAt         : 임의 깊이의 updatedAt
+//   - $.meta.*At           : 키 이름 glob (* 임의 문자열, ? 임의 문자 하나)
+//   - $.items[0]           : 특정 배열 인덱스
+//   - $['x.y']             : 점이 포함된 키
+//   - updatedAt, user.id   : $로 시작하지 않으면 임의 깊이에서 시작하는 경로 ($..updatedAt과 같음)
+//
+// 선택자는 경로 전체와 정확히 일치해야 하므로 id는 userId나 orderId와 일치하지 않습니다.
+type PathSelector struct {
+	raw      string
+	segments []selectorSegment
+}
+
+// ParsePathSelector는 경로 선택자 문자열을 해석합니다.
+func ParsePathSelector(expr string) (PathSelector, error) {
+	raw := strings.TrimSpace(expr)
+	if raw == "" {
+		return PathSelector{}, fmt.Errorf("empty path selector")
+	}
+
+	rest := raw
+	var segments []selectorSegment
+	if strings.HasPrefix(rest, "$") {
+		rest = rest[1:]
+	} else {
+		segments = append(segments, selectorSegment{kind: selectorRecursive})
+		if !strings.HasPrefix(rest, "[") {
+			rest = "." + rest
+		}
+	}
+
+	for len(rest) > 0 {
+		switch {
+		case strings.HasPrefix(rest, ".."):
+			segments = append(segments, selectorSegment{kind: selectorRecursive})
+			rest = rest[2:]
+			if strings.HasPrefix(rest, "[") {
+				continue
+			}
+			name, remaining := readSelectorName(rest)
+			if name == "" {
+				return PathSelector{}, fmt.Errorf("invalid path selector %q: missing name after '..'", raw)
+			}
+			segments = append(segments, keySegment(name))
+			rest = remaining
+
+		case rest[0] == '.':
+			name, remaining := readSelectorName(rest[1:])
+			if name == "" {
+				return PathSelector{}, fmt.Errorf("invalid path selector %q: missing name after '.'", raw)
+			}
+			segments = append(segments, keySegment(name))
+			rest = remaining
+
+		case rest[0] == '[':
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return PathSelector{}, fmt.Errorf("invalid path selector %q: unclosed '['", raw)
+			}
+			segment, err := parseBracketSegment(rest[1:end])
+			if err != nil {
+				return PathSelector{}, fmt.Errorf("invalid path selector %q: %w", raw, err)
+			}
+			segments = append(segments, segment)
+			rest = rest[end+1:]
+
+		default:
+			return PathSelector{}, fmt.Errorf("invalid path selector %q: unexpected %q", raw, rest[0])
+		}
+	}
+
+	return PathSelector{raw: raw, segments: segments}, nil
+}
+
+// String은 선택자 원문을 반환합니다.
+func (s PathSelector) String() string {
+	return s.raw
+}
+
+// matches는 선택자가 경로 전체와 일치하는지 확인합니다.
+func (s PathSelector) matches(path jsonPath) bool {
+	return matchSelectorSegments(s.segments, path)
+}
+
+// matchSelectorSegments는 세그먼트 목록이 경로 전체와 일치하는지 재귀적으로 확인합니다.
+func matchSelectorSegments(segments []selectorSegment, path jsonPath) bool {
+	if len(segments) == 0 {
+		return len(path) == 0
+	}
+
+	segment := segments[0]
+	if segment.kind == selectorRecursive {
+		for i := 0; i <= len(path); i++ {
+			if matchSelectorSegments(segments[1:], path[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(path) == 0 || !segment.matches(path[0]) {
+		return false
+	}
+	return matchSelectorSegments(segments[1:], path[1:])
+}
+
+// readSelectorName은 다음 '.' 또는 '[' 전까지의 키 이름을 읽습니다.
+func readSelectorName(s string) (string, string) {
+	end := strings.IndexAny(s, ".[")
+	if end < 0 {
+		return s, ""
+	}
+	return s[:end], s[end:]
+}
+
+// keySegment는 키 이름 세그먼트를 생성합니다.
+func keySegment(name string) selectorSegment {
+	return selectorSegment{kind: selectorKey, pattern: name, glob: strings.ContainsAny(name, "*?")}
+}
+
+// parseBracketSegment는 [ ] 안의 내용을 세그먼트로 해석합니다.
+func parseBracketSegment(content string) (selectorSegment, error) {
+	content = strings.TrimSpace(content)
+	switch {
+	case content == "*":
+		return selectorSegment{kind: selectorAnyIndex}, nil
+	case len(content) >= 2 && (content[0] == '\'' || content[0] == '"') && content[len(content)-1] == content[0]:
+		return selectorSegment{kind: selectorKey, pattern: content[1 : len(content)-1]}, nil
+	}
+
+	index, err := strconv.Atoi(content)
+	if err != nil || index < 0 {
+		return selectorSegment{}, fmt.Errorf("invalid index [%s]", content)
+	}
+	return selectorSegment{kind: selectorIndex, index: index}, nil
+}
@@ -0,0 +1,83 @@
+package domain
+
+import "testing"
+
+// buildTestPath는 키(string)와 인덱스(int)로 jsonPath를 생성합니다.
+func buildTestPath(elems ...interface{}) jsonPath {
+	var path jsonPath
+	for _, elem := range elems {
+		switch v := elem.(type) {
+		case string:
+			path = path.child(v)
+		case int:
+			path = path.at(v)
+		}
+	}
+	return path
+}
+
+func TestJSONPath_String(t *testing.T) {
+	tests := []struct {
+		path jsonPath
+		want string
+	}{
+		{nil, ""},
+		{buildTestPath("user", "id"), "user.id"},
+		{buildTestPath("items", 0, "name"), "items[0].name"},
+		{buildTestPath(1, "id"), "[1].id"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.path.String(); got != tt.want {
+			t.Errorf("String() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestPathSelector_Matches(t *testing.T) {
+	tests := []struct {
+		name     string
+		selector string
+		path     jsonPath
+		want     bool
+	}{
+		{"bare key matches exact key", "id", buildTestPath("id"), true},
+		{"bare key matches at any depth", "id", buildTestPath("user", "id"), true},
+		{"bare key does not match substring", "id", buildTestPath("userId"), false},
+		{"bare key does not match prefix", "id", buildTestPath("identity"), false},
+		{"anchored path", "$.user.id", buildTestPath("user", "id"), true},
+		{"anchored path rejects deeper match", "$.id", buildTestPath("user", "id"), false},
+		{"any index", "$.items[*].updatedAt", buildTestPath("items", 3, "updatedAt"), true},
+		{"any index requires array", "$.items[*].updatedAt", buildTestPath("items", "updatedAt"), false},
+		{"specific index", "$.items[0].id", buildTestPath("items", 0, "id"), true},
+		{"specific index mismatch", "$.items[0].id", buildTestPath("items", 1, "id"), false},
+		{"recursive descent", "$..updatedAt", buildTestPath("a", 0, "b", "updatedAt"), true},
+		{"recursive descent with index", "$..tags[*]", buildTestPath("meta", "tags", 2), true},
+		{"key glob", "$.meta.*At", buildTestPath("meta", "createdAt"), true},
+		{"key glob mismatch", "$.meta.*At", buildTestPath("meta", "createdBy"), false},
+		{"single char glob", "$.v?", buildTestPath("v1"), true},
+		{"quoted key with dot", "$['x.y']", buildTestPath("x.y"), true},
+		{"relative nested path", "user.id", buildTestPath("data", "user", "id"), true},
+		{"selector does not match parent", "$.items[*].updatedAt", buildTestPath("items", 0), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selector, err := ParsePathSelector(tt.selector)
+			if err != nil {
+				t.Fatalf("ParsePathSelector(%q) error = %v", tt.selector, err)
+			}
+			if got := selector.matches(tt.path); got != tt.want {
+				t.Errorf("%q matches %q = %v, want %v", tt.selector, tt.path.String(), got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParsePathSelector_Invalid(t *testing.T) {
+	for _, expr := range []string{"", "  ", "$.", "$.items[", "$.items[-1]", "$.items[abc]", "$..", "$x"} {
+		if _, err := ParsePathSelector(expr); err == nil {
+			t.Errorf("ParsePathSelector(%q) expected error", expr)
+		}
+	}
+}
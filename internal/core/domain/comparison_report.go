@@ -0,0 +1,364 @@
+package domain
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// DiffNode는 차이점을 경로별로 묶은 트리의 노드입니다.
+// 루트의 자식은 비교 항목(status_code, headers, body)이고, 그 아래는 경로 세그먼트입니다.
+type DiffNode struct {
+	Name        string         `json:"name"`                  // 노드 이름 (비교 항목 또는 경로 세그먼트)
+	Path        string         `json:"path,omitempty"`        // 루트부터의 경로
+	Differences []ResponseDiff `json:"differences,omitempty"` // 이 경로의 차이점
+	Children    []*DiffNode    `json:"children,omitempty"`    // 하위 노드 (처음 나타난 순서)
+	index       map[string]*DiffNode
+}
+
+// child는 이름이 name인 하위 노드를 찾거나 생성합니다.
+func (n *DiffNode) child(name, path string) *DiffNode {
+	if n.index == nil {
+		n.index = make(map[string]*DiffNode)
+	}
+	if node, exists := n.index[name]; exists {
+		return node
+	}
+	node := &DiffNode{Name: name, Path: path}
+	n.index[name] = node
+	n.Children = append(n.Children, node)
+	return node
+}
+
+// BuildDiffTree는 차이점 목록을 비교 항목과 경로별 트리로 구성합니다.
+// 비교 항목이 없는 차이점(응답 자체가 없는 경우 등)은 루트 바로 아래에 경로로 배치합니다.
+func BuildDiffTree(differences []ResponseDiff) *DiffNode {
+	root := &DiffNode{Name: "response"}
+	for _, diff := range differences {
+		node := root
+		prefix := ""
+		if diff.Dimension != "" {
+			node = root.child(string(diff.Dimension), string(diff.Dimension))
+			prefix = string(diff.Dimension)
+		}
+
+		segments := splitDiffPath(diff.Path)
+		if len(segments) == 1 && segments[0] == string(diff.Dimension) {
+			segments = nil
+		}
+		path := prefix
+		for _, segment := range segments {
+			switch {
+			case path == "":
+				path = segment
+			case strings.HasPrefix(segment, "["):
+				path += segment
+			default:
+				path += "." + segment
+			}
+			node = node.child(segment, path)
+		}
+		node.Differences = append(node.Differences, diff)
+	}
+	return root
+}
+
+// splitDiffPath는 차이점 경로를 세그먼트로 나눕니다 (예: items[id=42].name → items, [id=42], name).
+func splitDiffPath(path string) []string {
+	var segments []string
+	var current strings.Builder
+	flush := func() {
+		if current.Len() > 0 {
+			segments = append(segments, current.String())
+			current.Reset()
+		}
+	}
+
+	for i := 0; i < len(path); i++ {
+		switch path[i] {
+		case '.':
+			flush()
+		case '[':
+			flush()
+			end := strings.IndexByte(path[i:], ']')
+			if end < 0 {
+				current.WriteString(path[i:])
+				i = len(path)
+				continue
+			}
+			segments = append(segments, path[i:i+end+1])
+			i += end
+		default:
+			current.WriteByte(path[i])
+		}
+	}
+	flush()
+	return segments
+}
+
+// DiffOp는 줄 단위 차이의 종류입니다.
+type DiffOp string
+
+const (
+	DiffEqual  DiffOp = "equal"  // 양쪽에 같은 줄
+	DiffDelete DiffOp = "delete" // 레거시에만 있는 줄
+	DiffInsert DiffOp = "insert" // 모던에만 있는 줄
+)
+
+// DiffLine은 줄 단위 차이의 한 줄입니다. 줄 번호는 1부터 시작하며, 해당 쪽에 없는 줄은 0입니다.
+type DiffLine struct {
+	Op         DiffOp `json:"op"`
+	LegacyLine int    `json:"legacy_line,omitempty"`
+	ModernLine int    `json:"modern_line,omitempty"`
+	Text       string `json:"text"`
+}
+
+// maxLineDiffCells는 LCS 계산에 사용할 최대 표 크기입니다.
+// 넘으면 공통 앞뒤 부분을 제외한 나머지를 통째로 삭제/추가로 표시합니다.
+const maxLineDiffCells = 4_000_000
+
+// DiffLines는 두 텍스트 줄 목록의 차이를 계산합니다 (LCS 기반).
+func DiffLines(legacy, modern []string) []DiffLine {
+	// 공통 앞부분과 뒷부분은 LCS 계산에서 제외
+	prefix := 0
+	for prefix < len(legacy) && prefix < len(modern) && legacy[prefix] == modern[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(legacy)-prefix && suffix < len(modern)-prefix &&
+		legacy[len(legacy)-1-suffix] == modern[len(modern)-1-suffix] {
+		suffix++
+	}
+
+	lines := make([]DiffLine, 0, len(legacy)+len(modern))
+	for i := 0; i < prefix; i++ {
+		lines = append(lines, DiffLine{Op: DiffEqual, LegacyLine: i + 1, ModernLine: i + 1, Text: legacy[i]})
+	}
+
+	a := legacy[prefix : len(legacy)-suffix]
+	b := modern[prefix : len(modern)-suffix]
+	lines = append(lines, diffMiddle(a, b, prefix)...)
+
+	for k := 0; k < suffix; k++ {
+		i, j := len(legacy)-suffix+k, len(modern)-suffix+k
+		lines = append(lines, DiffLine{Op: DiffEqual, LegacyLine: i + 1, ModernLine: j + 1, Text: legacy[i]})
+	}
+	return lines
+}
+
+// diffMiddle은 공통 앞뒤 부분을 제외한 구간의 줄 단위 차이를 계산합니다.
+func diffMiddle(a, b []string, offset int) []DiffLine {
+	var lines []DiffLine
+	if len(a)*len(b) > maxLineDiffCells {
+		for i, text := range a {
+			lines = append(lines, DiffLine{Op: DiffDelete, LegacyLine: offset + i + 1, Text: text})
+		}
+		for j, text := range b {
+			lines = append(lines, DiffLine{Op: DiffInsert, ModernLine: offset + j + 1, Text: text})
+		}
+		return lines
+	}
+
+	// lcs[i][j]: a[i:]와 b[j:]의 최장 공통 부분 수열 길이
+	width := len(b) + 1
+	lcs := make([]int32, (len(a)+1)*width)
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i*width+j] = lcs[(i+1)*width+j+1] + 1
+			} else if lcs[(i+1)*width+j] >= lcs[i*width+j+1] {
+				lcs[i*width+j] = lcs[(i+1)*width+j]
+			} else {
+				lcs[i*width+j] = lcs[i*width+j+1]
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			lines = append(lines, DiffLine{Op: DiffEqual, LegacyLine: offset + i + 1, ModernLine: offset + j + 1, Text: a[i]})
+			i++
+			j++
+		case j >= len(b) || (i < len(a) && lcs[(i+1)*width+j] >= lcs[i*width+j+1]):
+			lines = append(lines, DiffLine{Op: DiffDelete, LegacyLine: offset + i + 1, Text: a[i]})
+			i++
+		default:
+			lines = append(lines, DiffLine{Op: DiffInsert, ModernLine: offset + j + 1, Text: b[j]})
+			j++
+		}
+	}
+	return lines
+}
+
+// UnifiedDiff는 줄 단위 차이를 unified diff 형식 텍스트로 만듭니다.
+// context는 변경된 줄 앞뒤로 함께 표시할 줄 수입니다.
+func UnifiedDiff(legacyName, modernName string, lines []DiffLine, context int) string {
+	var b strings.Builder
+	b.WriteString("--- " + legacyName + "\n")
+	b.WriteString("+++ " + modernName + "\n")
+
+	for start := 0; start < len(lines); {
+		// 다음 변경 줄 찾기
+		for start < len(lines) && lines[start].Op == DiffEqual {
+			start++
+		}
+		if start >= len(lines) {
+			break
+		}
+
+		// 변경 줄 앞뒤 context 줄을 포함해 hunk 범위 결정 (가까운 변경은 하나의 hunk로 병합)
+		from := start - context
+		if from < 0 {
+			from = 0
+		}
+		to := start
+		for to < len(lines) {
+			if lines[to].Op != DiffEqual {
+				to++
+				continue
+			}
+			next := to
+			for next < len(lines) && lines[next].Op == DiffEqual {
+				next++
+			}
+			if next >= len(lines) || next-to > 2*context {
+				break
+			}
+			to = next
+		}
+		end := to + context
+		if end > len(lines) {
+			end = len(lines)
+		}
+
+		writeHunk(&b, lines[from:end])
+		start = end
+	}
+	return b.String()
+}
+
+// writeHunk는 unified diff hunk 하나를 기록합니다.
+func writeHunk(b *strings.Builder, hunk []DiffLine) {
+	legacyStart, modernStart, legacyCount, modernCount := 0, 0, 0, 0
+	for _, line := range hunk {
+		if line.Op != DiffInsert {
+			if legacyStart == 0 {
+				legacyStart = line.LegacyLine
+			}
+			legacyCount++
+		}
+		if line.Op != DiffDelete {
+			if modernStart == 0 {
+				modernStart = line.ModernLine
+			}
+			modernCount++
+		}
+	}
+
+	fmt.Fprintf(b, "@@ -%d,%d +%d,%d @@\n", legacyStart, legacyCount, modernStart, modernCount)
+	for _, line := range hunk {
+		switch line.Op {
+		case DiffDelete:
+			b.WriteString("-" + line.Text + "\n")
+		case DiffInsert:
+			b.WriteString("+" + line.Text + "\n")
+		default:
+			b.WriteString(" " + line.Text + "\n")
+		}
+	}
+}
+
+// SideBySideRow는 좌우 비교 화면의 한 행입니다.
+type SideBySideRow struct {
+	Op         string // equal, changed, removed, added
+	LegacyLine int
+	LegacyText string
+	ModernLine int
+	ModernText string
+}
+
+// SideBySide는 줄 단위 차이를 좌우 비교 행으로 변환합니다.
+// 연속된 삭제/추가 줄은 같은 행에 짝지어 changed로 표시합니다.
+func SideBySide(lines []DiffLine) []SideBySideRow {
+	var rows []SideBySideRow
+	for i := 0; i < len(lines); {
+		if lines[i].Op == DiffEqual {
+			rows = append(rows, SideBySideRow{
+				Op:         "equal",
+				LegacyLine: lines[i].LegacyLine,
+				LegacyText: lines[i].Text,
+				ModernLine: lines[i].ModernLine,
+				ModernText: lines[i].Text,
+			})
+			i++
+			continue
+		}
+
+		var deleted, inserted []DiffLine
+		for ; i < len(lines) && lines[i].Op != DiffEqual; i++ {
+			if lines[i].Op == DiffDelete {
+				deleted = append(deleted, lines[i])
+			} else {
+				inserted = append(inserted, lines[i])
+			}
+		}
+		for k := 0; k < len(deleted) || k < len(inserted); k++ {
+			row := SideBySideRow{Op: "changed"}
+			if k < len(deleted) {
+				row.LegacyLine, row.LegacyText = deleted[k].LegacyLine, deleted[k].Text
+			} else {
+				row.Op = "added"
+			}
+			if k < len(inserted) {
+				row.ModernLine, row.ModernText = inserted[k].ModernLine, inserted[k].Text
+			} else {
+				row.Op = "removed"
+			}
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
+
+// ResponseLines는 줄 단위 비교를 위해 응답을 텍스트 줄 목록으로 만듭니다.
+// 상태 코드, 정렬한 헤더, 본문 순서이며 JSON 본문은 키를 정렬해 들여쓰기합니다.
+func ResponseLines(response *Response) []string {
+	if response == nil {
+		return []string{"(no response)"}
+	}
+
+	lines := []string{fmt.Sprintf("HTTP %d", response.StatusCode)}
+	names := make([]string, 0, len(response.Headers))
+	for name := range response.Headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		for _, value := range response.Headers[name] {
+			lines = append(lines, name+": "+value)
+		}
+	}
+	lines = append(lines, "")
+
+	kind, data := detectBody(response)
+	switch kind {
+	case bodyEmpty:
+		return lines
+	case bodyJSON:
+		var pretty bytes.Buffer
+		encoder := json.NewEncoder(&pretty)
+		encoder.SetEscapeHTML(false)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(data); err == nil {
+			return append(lines, strings.Split(strings.TrimRight(pretty.String(), "\n"), "\n")...)
+		}
+	case bodyBinary:
+		return append(lines, fmt.Sprintf("(binary body: %d bytes, sha256:%s)", len(response.Body), bodyHash(response.Body)))
+	}
+	text := strings.ReplaceAll(string(response.Body), "\r\n", "\n")
+	return append(lines, strings.Split(strings.TrimRight(text, "\n"), "\n")...)
+}
@@ -0,0 +1,154 @@
+package domain
+
+import (
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestBuildDiffTree(t *testing.T) {
+	diffs := []ResponseDiff{
+		{Type: VALUE_MISMATCH, Dimension: DimensionStatusCode, Path: "status_code"},
+		{Type: VALUE_MISMATCH, Dimension: DimensionHeaders, Path: "Content-Type"},
+		{Type: VALUE_MISMATCH, Dimension: DimensionBody, Path: "items[id=42].price"},
+		{Type: MISSING, Dimension: DimensionBody, Path: "items[id=42].name"},
+		{Type: EXTRA, Dimension: DimensionBody, Path: "total"},
+		{Type: MISSING, Path: "modern_response"},
+	}
+
+	root := BuildDiffTree(diffs)
+
+	var names []string
+	for _, child := range root.Children {
+		names = append(names, child.Name)
+	}
+	if want := []string{"status_code", "headers", "body", "modern_response"}; !reflect.DeepEqual(names, want) {
+		t.Fatalf("root children = %v, want %v", names, want)
+	}
+
+	status := root.Children[0]
+	if len(status.Children) != 0 || len(status.Differences) != 1 {
+		t.Errorf("status_code node should hold its diff directly, got %d children %d diffs", len(status.Children), len(status.Differences))
+	}
+
+	body := root.Children[2]
+	if len(body.Children) != 2 {
+		t.Fatalf("body children = %d, want 2", len(body.Children))
+	}
+	element := body.Children[0].Children[0]
+	if element.Name != "[id=42]" || element.Path != "body.items[id=42]" || len(element.Children) != 2 {
+		t.Errorf("element node = %q %q with %d children", element.Name, element.Path, len(element.Children))
+	}
+	if price := element.Children[0]; price.Path != "body.items[id=42].price" || len(price.Differences) != 1 {
+		t.Errorf("price node = %q with %d diffs", price.Path, len(price.Differences))
+	}
+
+	if missing := root.Children[3]; missing.Path != "modern_response" || len(missing.Differences) != 1 {
+		t.Errorf("undimensioned node = %q with %d diffs", missing.Path, len(missing.Differences))
+	}
+}
+
+func TestSplitDiffPath(t *testing.T) {
+	tests := []struct {
+		path string
+		want []string
+	}{
+		{"", nil},
+		{"name", []string{"name"}},
+		{"items[0].name", []string{"items", "[0]", "name"}},
+		{"items[id=4.2].tags[1]", []string{"items", "[id=4.2]", "tags", "[1]"}},
+		{"[0]", []string{"[0]"}},
+	}
+
+	for _, tt := range tests {
+		if got := splitDiffPath(tt.path); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("splitDiffPath(%q) = %v, want %v", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestDiffLines(t *testing.T) {
+	legacy := []string{"a", "b", "c", "d"}
+	modern := []string{"a", "c", "x", "d"}
+
+	var ops []string
+	for _, line := range DiffLines(legacy, modern) {
+		ops = append(ops, string(line.Op)[:1]+line.Text)
+	}
+
+	want := []string{"ea", "db", "ec", "ix", "ed"}
+	if !reflect.DeepEqual(ops, want) {
+		t.Errorf("DiffLines = %v, want %v", ops, want)
+	}
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	legacy := make([]string, 20)
+	modern := make([]string, 20)
+	for i := range legacy {
+		legacy[i] = string(rune('a' + i))
+		modern[i] = legacy[i]
+	}
+	modern[2] = "C"
+	modern[15] = "P"
+
+	got := UnifiedDiff("legacy", "modern", DiffLines(legacy, modern), 3)
+
+	want := strings.Join([]string{
+		"--- legacy",
+		"+++ modern",
+		"@@ -1,6 +1,6 @@",
+		" a", " b", "-c", "+C", " d", " e", " f",
+		"@@ -13,7 +13,7 @@",
+		" m", " n", " o", "-p", "+P", " q", " r", " s",
+		"",
+	}, "\n")
+	if got != want {
+		t.Errorf("UnifiedDiff =\n%s\nwant\n%s", got, want)
+	}
+
+	if got := UnifiedDiff("legacy", "modern", DiffLines(legacy, legacy), 3); got != "--- legacy\n+++ modern\n" {
+		t.Errorf("UnifiedDiff of identical input = %q", got)
+	}
+}
+
+func TestSideBySide(t *testing.T) {
+	rows := SideBySide(DiffLines([]string{"a", "b", "c"}, []string{"a", "B", "x", "y"}))
+
+	var ops []string
+	for _, row := range rows {
+		ops = append(ops, row.Op+":"+row.LegacyText+"|"+row.ModernText)
+	}
+
+	want := []string{"equal:a|a", "changed:b|B", "changed:c|x", "added:|y"}
+	if !reflect.DeepEqual(ops, want) {
+		t.Errorf("SideBySide = %v, want %v", ops, want)
+	}
+}
+
+func TestResponseLines(t *testing.T) {
+	response := &Response{
+		StatusCode: 200,
+		Headers:    http.Header{"X-B": {"2"}, "Content-Type": {"application/json"}},
+		Body:       []byte(`{"b":1,"a":"<x>"}`),
+	}
+
+	want := []string{
+		"HTTP 200",
+		"Content-Type: application/json",
+		"X-B: 2",
+		"",
+		"{",
+		`  "a": "<x>",`,
+		`  "b": 1`,
+		"}",
+	}
+	if got := ResponseLines(response); !reflect.DeepEqual(got, want) {
+		t.Errorf("ResponseLines = %q, want %q", got, want)
+	}
+
+	if got := ResponseLines(nil); !reflect.DeepEqual(got, []string{"(no response)"}) {
+		t.Errorf("ResponseLines(nil) = %q", got)
+	}
+}
@@ -0,0 +1,83 @@
+package domain
+
+import "time"
+
+// ComparisonRollup은 보존 기간이 지나 삭제한 비교 결과를 규칙별, 일별로 요약한 통계입니다.
+// 원본 비교 결과가 삭제된 뒤에도 전환 판단에 사용하는 비교 통계를 유지하기 위해 사용합니다.
+type ComparisonRollup struct {
+	RoutingRuleID     string        // 라우팅 규칙 ID
+	Day               time.Time     // 집계 일자 (UTC 자정)
+	TotalComparisons  int           // 비교 건수
+	SuccessfulMatches int           // 성공한 비교 건수
+	MatchRateSum      float64       // 일치율 합 (평균 = MatchRateSum / TotalComparisons)
+	ModernErrors      int           // 모던 API 호출 실패 또는 5xx 응답 건수
+	ModernResponses   int           // 모던 응답이 있는 비교 건수
+	ModernLatencySum  time.Duration // 모던 API 응답 시간 합
+	LastComparison    time.Time     // 마지막 비교 시점
+}
+
+// RollupDay는 비교 시점이 속한 집계 일자(UTC 자정)를 반환합니다.
+func RollupDay(t time.Time) time.Time {
+	return InsightBucketDay.Truncate(t)
+}
+
+// RollupDaysWithin은 기간 [from, to) 안에 온전히 포함되는 집계 일자 범위 [first, end)를 반환합니다.
+//
+// 일별 요약은 하루 단위로만 나눌 수 있으므로, 기간이 일부만 걸친 날의 요약은
+// 포함하지 않습니다. 그렇지 않으면 하루를 나눈 여러 하위 기간에서 같은 요약이
+// 중복 집계됩니다.
+func RollupDaysWithin(from, to time.Time) (first, end time.Time) {
+	first = RollupDay(from)
+	if first.Before(from) {
+		first = first.Add(24 * time.Hour)
+	}
+	return first, RollupDay(to)
+}
+
+// Add는 비교 결과 하나를 요약에 반영합니다.
+func (r *ComparisonRollup) Add(comparison *APIComparison) {
+	r.TotalComparisons++
+	r.MatchRateSum += comparison.MatchRate
+	if comparison.IsSuccessful() {
+		r.SuccessfulMatches++
+	}
+	if comparison.IsModernError() {
+		r.ModernErrors++
+	}
+	if comparison.ModernResponse != nil {
+		r.ModernResponses++
+		r.ModernLatencySum += comparison.ModernResponse.Duration
+	}
+	if comparison.Timestamp.After(r.LastComparison) {
+		r.LastComparison = comparison.Timestamp
+	}
+}
+
+// Merge는 다른 요약을 합산합니다.
+func (r *ComparisonRollup) Merge(other *ComparisonRollup) {
+	r.TotalComparisons += other.TotalComparisons
+	r.SuccessfulMatches += other.SuccessfulMatches
+	r.MatchRateSum += other.MatchRateSum
+	r.ModernErrors += other.ModernErrors
+	r.ModernResponses += other.ModernResponses
+	r.ModernLatencySum += other.ModernLatencySum
+	if other.LastComparison.After(r.LastComparison) {
+		r.LastComparison = other.LastComparison
+	}
+}
+
+// AverageMatchRate는 평균 일치율을 반환합니다.
+func (r *ComparisonRollup) AverageMatchRate() float64 {
+	if r.TotalComparisons == 0 {
+		return 0
+	}
+	return r.MatchRateSum / float64(r.TotalComparisons)
+}
+
+// AverageModernLatency는 모던 API 평균 응답 시간을 반환합니다.
+func (r *ComparisonRollup) AverageModernLatency() time.Duration {
+	if r.ModernResponses == 0 {
+		return 0
+	}
+	return r.ModernLatencySum / time.Duration(r.ModernResponses)
+}
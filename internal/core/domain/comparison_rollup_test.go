@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestComparisonRollup_AddAndMerge(t *testing.T) {
+	day := time.Date(2025, 1, 5, 0, 0, 0, 0, time.UTC)
+	comparisons := []*APIComparison{
+		{MatchRate: 1.0, ModernResponse: &Response{StatusCode: 200, Duration: 100 * time.Millisecond}, Timestamp: day.Add(time.Hour)},
+		{MatchRate: 0.5, ModernResponse: &Response{StatusCode: 503, Duration: 300 * time.Millisecond}, Timestamp: day.Add(3 * time.Hour)},
+		{MatchRate: 0.0, Timestamp: day.Add(2 * time.Hour)},
+	}
+
+	first := &ComparisonRollup{RoutingRuleID: "route-1", Day: day}
+	first.Add(comparisons[0])
+	second := &ComparisonRollup{RoutingRuleID: "route-1", Day: day}
+	second.Add(comparisons[1])
+	second.Add(comparisons[2])
+
+	first.Merge(second)
+
+	if first.TotalComparisons != 3 {
+		t.Errorf("TotalComparisons = %d, want 3", first.TotalComparisons)
+	}
+	if first.SuccessfulMatches != 1 {
+		t.Errorf("SuccessfulMatches = %d, want 1", first.SuccessfulMatches)
+	}
+	if got := first.AverageMatchRate(); got != 0.5 {
+		t.Errorf("AverageMatchRate() = %v, want 0.5", got)
+	}
+	if first.ModernErrors != 2 {
+		t.Errorf("ModernErrors = %d, want 2 (5xx and missing response)", first.ModernErrors)
+	}
+	if got := first.AverageModernLatency(); got != 200*time.Millisecond {
+		t.Errorf("AverageModernLatency() = %v, want 200ms", got)
+	}
+	if !first.LastComparison.Equal(day.Add(3 * time.Hour)) {
+		t.Errorf("LastComparison = %v, want %v", first.LastComparison, day.Add(3*time.Hour))
+	}
+}
+
+func TestRollupDaysWithin(t *testing.T) {
+	day := time.Date(2025, 1, 5, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		from, to  time.Time
+		wantFirst time.Time
+		wantEnd   time.Time
+	}{
+		{"whole days", day, day.Add(48 * time.Hour), day, day.Add(48 * time.Hour)},
+		{"partial first and last day", day.Add(6 * time.Hour), day.Add(54 * time.Hour), day.Add(24 * time.Hour), day.Add(48 * time.Hour)},
+		{"within a single day", day.Add(time.Hour), day.Add(7 * time.Hour), day.Add(24 * time.Hour), day},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			first, end := RollupDaysWithin(tt.from, tt.to)
+			if !first.Equal(tt.wantFirst) || !end.Equal(tt.wantEnd) {
+				t.Errorf("RollupDaysWithin() = [%v, %v), want [%v, %v)", first, end, tt.wantFirst, tt.wantEnd)
+			}
+		})
+	}
+}
+
+func TestRollupDay(t *testing.T) {
+	kst := time.FixedZone("KST", 9*60*60)
+	got := RollupDay(time.Date(2025, 1, 6, 5, 30, 0, 0, kst))
+	want := time.Date(2025, 1, 5, 0, 0, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("RollupDay() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,43 @@
+package domain
+
+import "fmt"
+
+// ComparisonSampling은 비교 결과를 저장할지 결정하는 규칙별 샘플링 정책입니다.
+// 모든 요청의 비교 결과(양쪽 응답 본문 포함)를 저장하면 저장소 부담이 크므로 일부만 저장합니다.
+//
+// 판단 순서:
+//  1. AlwaysStoreMismatches이고 비교가 실패(불일치)했으면 비율/상한과 관계없이 저장
+//  2. Rate 비율로 무작위 샘플링
+//  3. MaxPerMinute가 있으면 규칙별로 분당 처음 N건만 저장
+//
+// Rate 샘플링은 저장된 비교의 평균 일치율을 왜곡하지 않지만, AlwaysStoreMismatches는 불일치만 더 많이 남겨
+// 저장된 일치율이 실제보다 낮아집니다. 그래서 자동 롤백과 단계적 전환을 사용하는 규칙에는 허용하지 않습니다.
+type ComparisonSampling struct {
+	Rate                  float64 // 저장 비율 (0.0 ~ 1.0, 0이면 모두 저장)
+	AlwaysStoreMismatches bool    // 실패한 비교는 항상 저장 (오류 분석용)
+	MaxPerMinute          int     // 규칙별 분당 최대 저장 건수 (0이면 제한 없음)
+}
+
+// IsZero는 샘플링 정책이 지정되지 않았는지(모두 저장) 확인합니다.
+func (s ComparisonSampling) IsZero() bool {
+	return s.Rate == 0 && !s.AlwaysStoreMismatches && s.MaxPerMinute == 0
+}
+
+// EffectiveRate는 적용할 저장 비율을 반환합니다.
+func (s ComparisonSampling) EffectiveRate() float64 {
+	if s.Rate <= 0 {
+		return 1.0
+	}
+	return s.Rate
+}
+
+// validate는 샘플링 정책이 유효한지 검증합니다.
+func (s ComparisonSampling) validate() error {
+	if s.Rate < 0 || s.Rate > 1 {
+		return fmt.Errorf("sampling rate must be between 0.0 and 1.0")
+	}
+	if s.MaxPerMinute < 0 {
+		return fmt.Errorf("max per minute must not be negative")
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package domain
+
+import "testing"
+
+func TestComparisonSampling_EffectiveRate(t *testing.T) {
+	tests := []struct {
+		name     string
+		sampling ComparisonSampling
+		want     float64
+	}{
+		{"unset stores everything", ComparisonSampling{}, 1.0},
+		{"mismatches only keeps full rate", ComparisonSampling{AlwaysStoreMismatches: true}, 1.0},
+		{"fixed rate", ComparisonSampling{Rate: 0.25}, 0.25},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.sampling.EffectiveRate(); got != tt.want {
+				t.Errorf("EffectiveRate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrchestrationRule_IsValid_Sampling(t *testing.T) {
+	tests := []struct {
+		name         string
+		sampling     ComparisonSampling
+		rampPlan     []RampStage
+		rollbackRate float64
+		wantErr      bool
+	}{
+		{"rate sampling with ramp plan", ComparisonSampling{Rate: 0.1}, []RampStage{{Percentage: 10}}, 0, false},
+		{"rate sampling with rollback", ComparisonSampling{Rate: 0.1, MaxPerMinute: 100}, nil, 0.1, false},
+		{"always store mismatches without transitions", ComparisonSampling{Rate: 0.1, AlwaysStoreMismatches: true}, nil, 0, false},
+		{"always store mismatches with ramp plan", ComparisonSampling{AlwaysStoreMismatches: true}, []RampStage{{Percentage: 10}}, 0, true},
+		{"always store mismatches with rollback", ComparisonSampling{AlwaysStoreMismatches: true}, nil, 0.1, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := NewOrchestrationRule("orch-1", "sampling", "route-1", "legacy-1", "modern-1")
+			rule.ComparisonConfig.Sampling = tt.sampling
+			rule.TransitionConfig.RampPlan = tt.rampPlan
+			rule.TransitionConfig.Rollback.SampleRate = tt.rollbackRate
+
+			err := rule.IsValid()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error=%v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
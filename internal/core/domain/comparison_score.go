@@ -0,0 +1,133 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+)
+
+// FieldWeight는 경로 선택자에 해당하는 필드의 일치율 가중치입니다.
+// 객체나 배열 경로를 지정하면 하위의 모든 리프에 같은 가중치가 적용됩니다.
+type FieldWeight struct {
+	Path   string  // 대상 경로 선택자 (예: $.amount, $.items[*].price)
+	Weight float64 // 가중치 (기본 1.0, 예: 10이면 일반 필드 10개와 같은 비중)
+}
+
+// validate는 필드 가중치가 유효한지 검증합니다.
+func (w FieldWeight) validate() error {
+	if _, err := ParsePathSelector(w.Path); err != nil {
+		return err
+	}
+	if w.Weight <= 0 {
+		return fmt.Errorf("weight for %q must be positive", w.Path)
+	}
+	return nil
+}
+
+// compiledFieldWeight는 선택자를 미리 해석해 둔 FieldWeight입니다.
+type compiledFieldWeight struct {
+	selector PathSelector
+	weight   float64
+}
+
+// fieldWeight는 path 노드의 가중치를 반환합니다.
+// path와 일치하는 첫 번째 규칙의 가중치이며, 없으면 상위 노드에서 물려받은 inherited입니다.
+//
+// 비교는 루트부터 내려가며 노드마다 한 번씩 가중치를 정하고 하위로 넘기므로,
+// 리프의 가중치는 리프 경로 또는 가장 가까운 상위 경로와 일치하는 규칙의 가중치가 됩니다 (없으면 1).
+func (e *ComparisonEngine) fieldWeight(path jsonPath, inherited float64) float64 {
+	for _, rule := range e.weights {
+		if rule.selector.matches(path) {
+			return rule.weight
+		}
+	}
+	return inherited
+}
+
+// scoreLeaf는 가중치가 weight인 리프 하나의 비교 결과를 점수에 반영합니다.
+func (e *ComparisonEngine) scoreLeaf(weight float64, matched bool, result *ComparisonResult) {
+	result.TotalFields++
+	result.TotalWeight += weight
+	if matched {
+		result.MatchedFields++
+		result.MatchedWeight += weight
+	}
+}
+
+// scoreUnmatched는 한쪽 응답에만 있는 값의 모든 리프를 불일치로 반영합니다.
+func (e *ComparisonEngine) scoreUnmatched(value interface{}, path jsonPath, weight float64, result *ComparisonResult) {
+	leaves, weight := e.subtreeScore(value, path, weight)
+	result.TotalFields += leaves
+	result.TotalWeight += weight
+}
+
+// scoreTypeMismatch는 타입이 다른 두 값을 리프가 더 많은 쪽 기준으로 불일치로 반영합니다.
+func (e *ComparisonEngine) scoreTypeMismatch(legacy, modern interface{}, path jsonPath, weight float64, result *ComparisonResult) {
+	legacyLeaves, legacyWeight := e.subtreeScore(legacy, path, weight)
+	modernLeaves, modernWeight := e.subtreeScore(modern, path, weight)
+	if modernLeaves > legacyLeaves {
+		legacyLeaves = modernLeaves
+	}
+	if modernWeight > legacyWeight {
+		legacyWeight = modernWeight
+	}
+	result.TotalFields += legacyLeaves
+	result.TotalWeight += legacyWeight
+}
+
+// subtreeScore는 가중치가 weight인 path 노드 값의 리프 수와 가중치 합을 반환합니다. 무시할 필드는 제외합니다.
+func (e *ComparisonEngine) subtreeScore(value interface{}, path jsonPath, weight float64) (int, float64) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		leaves, total := 0, 0.0
+		for key := range v {
+			childPath := path.child(key)
+			if e.shouldIgnoreField(childPath) {
+				continue
+			}
+			if fieldValue, ok := e.fieldValue(v, key, childPath); ok {
+				l, w := e.subtreeScore(fieldValue, childPath, e.fieldWeight(childPath, weight))
+				leaves += l
+				total += w
+			}
+		}
+		if leaves > 0 {
+			return leaves, total
+		}
+	case []interface{}:
+		leaves, total := 0, 0.0
+		for i, elem := range v {
+			if elemPath := path.at(i); !e.shouldIgnoreField(elemPath) {
+				l, w := e.subtreeScore(elem, elemPath, e.fieldWeight(elemPath, weight))
+				leaves += l
+				total += w
+			}
+		}
+		if leaves > 0 {
+			return leaves, total
+		}
+	}
+	return 1, weight
+}
+
+// addScore는 다른 비교 결과의 점수를 합산합니다.
+func (r *ComparisonResult) addScore(other *ComparisonResult) {
+	r.TotalFields += other.TotalFields
+	r.MatchedFields += other.MatchedFields
+	r.TotalWeight += other.TotalWeight
+	r.MatchedWeight += other.MatchedWeight
+}
+
+// unionKeys는 두 객체의 키를 정렬해 중복 없이 반환합니다.
+func unionKeys(legacy, modern map[string]interface{}) []string {
+	keys := make([]string, 0, len(legacy)+len(modern))
+	for key := range legacy {
+		keys = append(keys, key)
+	}
+	for key := range modern {
+		if _, exists := legacy[key]; !exists {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,445 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// jsonResponse는 JSON 본문을 가진 테스트용 응답을 생성합니다.
+func jsonResponse(body string) *Response {
+	response := NewResponse("test-request")
+	response.StatusCode = 200
+	response.Body = []byte(body)
+	return response
+}
+
+func TestComparisonEngine_IgnoreFields(t *testing.T) {
+	legacy := `{"id":1,"userId":10,"requestId":"a","items":[{"sku":"A","updatedAt":"x"}],"meta":{"updatedAt":"x"}}`
+	modern := `{"id":1,"userId":11,"items":[{"sku":"A","updatedAt":"y"}],"meta":{"updatedAt":"y"}}`
+
+	tests := []struct {
+		name      string
+		ignore    []string
+		wantPaths []string
+	}{
+		{
+			name:      "substring keys are not ignored",
+			ignore:    []string{"id", "requestId", "updatedAt"},
+			wantPaths: []string{"userId"},
+		},
+		{
+			name:      "anchored selector only ignores the selected path",
+			ignore:    []string{"$.items[*].updatedAt", "userId", "requestId"},
+			wantPaths: []string{"meta.updatedAt"},
+		},
+		{
+			name:      "missing and extra fields are ignored",
+			ignore:    []string{"requestId"},
+			wantPaths: []string{"userId", "items[0].updatedAt", "meta.updatedAt"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := NewComparisonEngine(ComparisonConfig{Enabled: true, IgnoreFields: tt.ignore})
+			result := engine.CompareResponses(jsonResponse(legacy), jsonResponse(modern))
+
+			got := make(map[string]bool)
+			for _, diff := range result.Differences {
+				got[diff.Path] = true
+			}
+			if len(got) != len(tt.wantPaths) {
+				t.Fatalf("differences = %+v, want paths %v", result.Differences, tt.wantPaths)
+			}
+			for _, path := range tt.wantPaths {
+				if !got[path] {
+					t.Errorf("missing difference at %q, got %+v", path, result.Differences)
+				}
+			}
+		})
+	}
+}
+
+func TestComparisonEngine_Normalizers(t *testing.T) {
+	tests := []struct {
+		name        string
+		legacy      string
+		modern      string
+		normalizers []FieldNormalizer
+		wantDiffs   int
+	}{
+		{
+			name:        "trim and ignore case",
+			legacy:      `{"name":"  Alice "}`,
+			modern:      `{"name":"alice"}`,
+			normalizers: []FieldNormalizer{{Path: "$.name", Normalizers: []NormalizerType{NormalizeTrim, NormalizeIgnoreCase}}},
+		},
+		{
+			name:        "timestamp formats compare as the same instant",
+			legacy:      `{"items":[{"updatedAt":"2025-01-05 09:00:00+09:00"}]}`,
+			modern:      `{"items":[{"updatedAt":1736035200000}]}`,
+			normalizers: []FieldNormalizer{{Path: "$.items[*].updatedAt", Normalizers: []NormalizerType{NormalizeTimestamp}}},
+		},
+		{
+			name:        "numeric string compares as number",
+			legacy:      `{"amount":"100.50"}`,
+			modern:      `{"amount":100.5}`,
+			normalizers: []FieldNormalizer{{Path: "amount", Normalizers: []NormalizerType{NormalizeNumericString}}},
+		},
+		{
+			name:        "null compares as missing",
+			legacy:      `{"id":1,"nickname":null}`,
+			modern:      `{"id":1}`,
+			normalizers: []FieldNormalizer{{Path: "nickname", Normalizers: []NormalizerType{NormalizeNullAsMissing}}},
+		},
+		{
+			name:        "normalizer only applies to selected path",
+			legacy:      `{"name":"Alice","title":"Dr"}`,
+			modern:      `{"name":"alice","title":"dr"}`,
+			normalizers: []FieldNormalizer{{Path: "$.name", Normalizers: []NormalizerType{NormalizeIgnoreCase}}},
+			wantDiffs:   1,
+		},
+		{
+			name:      "without normalizers null differs from missing",
+			legacy:    `{"id":1,"nickname":null}`,
+			modern:    `{"id":1}`,
+			wantDiffs: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := NewComparisonEngine(ComparisonConfig{Enabled: true, Normalizers: tt.normalizers})
+			result := engine.CompareResponses(jsonResponse(tt.legacy), jsonResponse(tt.modern))
+			if len(result.Differences) != tt.wantDiffs {
+				t.Errorf("differences = %+v, want %d", result.Differences, tt.wantDiffs)
+			}
+		})
+	}
+}
+
+func TestComparisonConfig_IsValid(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  ComparisonConfig
+		wantErr bool
+	}{
+		{"default config", DefaultComparisonConfig(), false},
+		{"valid selectors and normalizers", ComparisonConfig{
+			IgnoreFields: []string{"$.items[*].updatedAt"},
+			Normalizers:  []FieldNormalizer{{Path: "$..name", Normalizers: []NormalizerType{NormalizeTrim}}},
+		}, false},
+		{"invalid ignore selector", ComparisonConfig{IgnoreFields: []string{"$.items["}}, true},
+		{"unknown normalizer", ComparisonConfig{
+			Normalizers: []FieldNormalizer{{Path: "name", Normalizers: []NormalizerType{"uppercase"}}},
+		}, true},
+		{"normalizer without types", ComparisonConfig{Normalizers: []FieldNormalizer{{Path: "name"}}}, true},
+		{"negative allowable difference", ComparisonConfig{AllowableDifference: -1}, true},
+		{"valid sampling", ComparisonConfig{Sampling: ComparisonSampling{Rate: 0.1, MaxPerMinute: 60}}, false},
+		{"sampling rate over 1", ComparisonConfig{Sampling: ComparisonSampling{Rate: 1.5}}, true},
+		{"negative max per minute", ComparisonConfig{Sampling: ComparisonSampling{MaxPerMinute: -1}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.config.IsValid(); (err != nil) != tt.wantErr {
+				t.Errorf("IsValid() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestComparisonEngine_ArrayStrategies(t *testing.T) {
+	tests := []struct {
+		name      string
+		legacy    string
+		modern    string
+		rules     []ArrayRule
+		wantPaths []string
+	}{
+		{
+			name:      "ordered reports reordered elements",
+			legacy:    `{"tags":["a","b","c"]}`,
+			modern:    `{"tags":["c","a","b"]}`,
+			wantPaths: []string{"tags[0]", "tags[1]", "tags[2]"},
+		},
+		{
+			name:   "unordered ignores order",
+			legacy: `{"tags":["a","b","c"]}`,
+			modern: `{"tags":["c","a","b"]}`,
+			rules:  []ArrayRule{{Path: "$.tags", Strategy: ArrayUnordered}},
+		},
+		{
+			name:      "unordered compares duplicate counts",
+			legacy:    `{"tags":["a","a","b"]}`,
+			modern:    `{"tags":["a","b","b"]}`,
+			rules:     []ArrayRule{{Path: "$.tags", Strategy: ArrayUnordered}},
+			wantPaths: []string{"tags[1]", "tags[2]"},
+		},
+		{
+			name:   "unordered objects with different key order",
+			legacy: `{"items":[{"id":1,"v":"x"},{"id":2,"v":"y"}]}`,
+			modern: `{"items":[{"v":"y","id":2},{"v":"x","id":1}]}`,
+			rules:  []ArrayRule{{Path: "items", Strategy: ArrayUnordered}},
+		},
+		{
+			name:      "keyed matches elements by id",
+			legacy:    `{"items":[{"id":1,"name":"A"},{"id":2,"name":"B"},{"id":3,"name":"C"}]}`,
+			modern:    `{"items":[{"id":3,"name":"C"},{"id":1,"name":"A"},{"id":2,"name":"b"}]}`,
+			rules:     []ArrayRule{{Path: "$.items", Strategy: ArrayKeyed, KeyFields: []string{"id"}}},
+			wantPaths: []string{"items[id=2].name"},
+		},
+		{
+			name:      "keyed reports missing and extra keys",
+			legacy:    `{"items":[{"id":1},{"id":2}]}`,
+			modern:    `{"items":[{"id":2},{"id":3}]}`,
+			rules:     []ArrayRule{{Path: "$.items", Strategy: ArrayKeyed, KeyFields: []string{"id"}}},
+			wantPaths: []string{"items[id=1]", "items[id=3]"},
+		},
+		{
+			name:      "keyed with composite key",
+			legacy:    `{"lines":[{"order":"o1","seq":1,"qty":1},{"order":"o1","seq":2,"qty":5}]}`,
+			modern:    `{"lines":[{"order":"o1","seq":2,"qty":5},{"order":"o1","seq":1,"qty":2}]}`,
+			rules:     []ArrayRule{{Path: "lines", Strategy: ArrayKeyed, KeyFields: []string{"order", "seq"}}},
+			wantPaths: []string{"lines[order=o1,seq=1].qty"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := NewComparisonEngine(ComparisonConfig{Enabled: true, ArrayRules: tt.rules})
+			result := engine.CompareResponses(jsonResponse(tt.legacy), jsonResponse(tt.modern))
+
+			got := make(map[string]bool)
+			for _, diff := range result.Differences {
+				got[diff.Path] = true
+			}
+			if len(got) != len(tt.wantPaths) {
+				t.Fatalf("differences = %+v, want paths %v", result.Differences, tt.wantPaths)
+			}
+			for _, path := range tt.wantPaths {
+				if !got[path] {
+					t.Errorf("missing difference at %q, got %+v", path, result.Differences)
+				}
+			}
+		})
+	}
+}
+
+func TestComparisonEngine_UnorderedFingerprintIgnoresPosition(t *testing.T) {
+	// 인덱스 선택자 규칙이 있어도 같은 요소는 위치와 관계없이 같은 fingerprint를 가져야 함
+	engine := NewComparisonEngine(ComparisonConfig{
+		Enabled:      true,
+		IgnoreFields: []string{"$.items[1].ts"},
+		ArrayRules:   []ArrayRule{{Path: "$.items", Strategy: ArrayUnordered}},
+	})
+	path := jsonPath{}.child("items")
+	legacy := map[string]interface{}{"id": 1.0, "ts": "a"}
+	modern := map[string]interface{}{"ts": "a", "id": 1.0}
+
+	if got, want := engine.fingerprint(modern, path.anyElement()), engine.fingerprint(legacy, path.anyElement()); got != want {
+		t.Errorf("fingerprint = %s, want %s", got, want)
+	}
+	if got := path.anyElement().String(); got != "items[*]" {
+		t.Errorf("path = %q, want items[*]", got)
+	}
+
+	// 레거시 0번 요소와 모던 1번 요소가 fingerprint로 짝지어져 레거시 요소는 누락으로 보고되지 않음
+	engine = NewComparisonEngine(ComparisonConfig{
+		Enabled:          true,
+		IgnoreFields:     []string{"$.items[1].ts"},
+		ArrayRules:       []ArrayRule{{Path: "$.items", Strategy: ArrayUnordered}},
+		MaxArrayElements: 1,
+	})
+	result := engine.CompareResponses(
+		jsonResponse(`{"items":[{"id":1,"ts":"a"}]}`),
+		jsonResponse(`{"items":[{"id":2,"ts":"z"},{"id":1,"ts":"a"}]}`),
+	)
+	for _, diff := range result.Differences {
+		if diff.Type == MISSING {
+			t.Errorf("unexpected missing element %+v", diff)
+		}
+	}
+	if len(result.Differences) != 2 {
+		t.Errorf("differences = %+v, want length mismatch and extra items[0]", result.Differences)
+	}
+}
+
+func TestComparisonEngine_ArrayElementCap(t *testing.T) {
+	legacy := make([]int, 50)
+	modern := make([]int, 50)
+	for i := range legacy {
+		legacy[i], modern[i] = i, i
+	}
+	modern[42] = -1
+
+	tests := []struct {
+		name      string
+		max       int
+		sampling  ArraySamplingMode
+		wantDiffs int
+	}{
+		{"default cap compares all elements", 0, "", 1},
+		{"head sampling misses tail difference", 10, ArraySamplingHead, 0},
+		{"uniform sampling covers the whole array", 10, ArraySamplingUniform, 0},
+		{"uniform sampling hits sampled index", 8, ArraySamplingUniform, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := NewComparisonEngine(ComparisonConfig{Enabled: true, MaxArrayElements: tt.max, ArraySampling: tt.sampling})
+			result := engine.CompareResponses(jsonResponse(toJSON(t, legacy)), jsonResponse(toJSON(t, modern)))
+			if len(result.Differences) != tt.wantDiffs {
+				t.Errorf("differences = %+v, want %d", result.Differences, tt.wantDiffs)
+			}
+		})
+	}
+}
+
+func TestSampleIndices(t *testing.T) {
+	tests := []struct {
+		name        string
+		n, limit    int
+		mode        ArraySamplingMode
+		want        []int
+		wantSampled bool
+	}{
+		{"within limit", 3, 5, ArraySamplingHead, []int{0, 1, 2}, false},
+		{"head", 10, 3, ArraySamplingHead, []int{0, 1, 2}, true},
+		{"uniform includes both ends", 10, 4, ArraySamplingUniform, []int{0, 3, 6, 9}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, sampled := sampleIndices(tt.n, tt.limit, tt.mode)
+			if sampled != tt.wantSampled || len(got) != len(tt.want) {
+				t.Fatalf("sampleIndices() = %v, %v, want %v, %v", got, sampled, tt.want, tt.wantSampled)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("sampleIndices() = %v, want %v", got, tt.want)
+					break
+				}
+			}
+		})
+	}
+}
+
+func toJSON(t *testing.T, value interface{}) string {
+	t.Helper()
+	data, err := json.Marshal(value)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	return string(data)
+}
+
+func TestComparisonEngine_Dimensions(t *testing.T) {
+	response := func(status int, contentType, body string, headers map[string]string) *Response {
+		r := NewResponse("test-request")
+		r.StatusCode = status
+		r.Body = []byte(body)
+		if contentType != "" {
+			r.SetHeader("Content-Type", contentType)
+		}
+		for k, v := range headers {
+			r.SetHeader(k, v)
+		}
+		return r
+	}
+
+	tests := []struct {
+		name          string
+		config        ComparisonConfig
+		legacy        *Response
+		modern        *Response
+		wantMatchRate float64
+		wantDiffs     []ResponseDiff
+	}{
+		{
+			name:          "status code counts against match rate",
+			legacy:        response(200, "", `{"id":1}`, nil),
+			modern:        response(500, "", `{"id":1}`, nil),
+			wantMatchRate: 0.7 / 0.9,
+			wantDiffs:     []ResponseDiff{{Type: VALUE_MISMATCH, Dimension: DimensionStatusCode, LegacyValue: 200, ModernValue: 500}},
+		},
+		{
+			name:          "equivalent header values match",
+			legacy:        response(200, "application/json; charset=UTF-8", `{}`, map[string]string{"Cache-Control": "no-cache, max-age=0"}),
+			modern:        response(200, "application/json;charset=utf-8", `{}`, map[string]string{"cache-control": "max-age=0,no-cache"}),
+			wantMatchRate: 1.0,
+		},
+		{
+			name:          "missing header",
+			legacy:        response(200, "application/json", `{}`, map[string]string{"Cache-Control": "no-store"}),
+			modern:        response(200, "application/json", `{}`, nil),
+			wantMatchRate: (0.2 + 0.1*0.5 + 0.7) / 1.0,
+			wantDiffs:     []ResponseDiff{{Type: MISSING, Dimension: DimensionHeaders, LegacyValue: "no-store"}},
+		},
+		{
+			name:          "text bodies ignore line endings",
+			legacy:        response(200, "text/plain", "line1\r\nline2\r\n", nil),
+			modern:        response(200, "text/plain", "line1\nline2", nil),
+			wantMatchRate: 1.0,
+		},
+		{
+			name:          "text body mismatch keeps legacy and modern values",
+			legacy:        response(200, "text/plain", "old", nil),
+			modern:        response(200, "text/plain", "new", nil),
+			wantMatchRate: 0.3,
+			wantDiffs:     []ResponseDiff{{Type: VALUE_MISMATCH, Dimension: DimensionBody, LegacyValue: "old", ModernValue: "new"}},
+		},
+		{
+			name:          "xml is canonicalized",
+			legacy:        response(200, "application/xml", `<?xml version="1.0"?><a y="2" x="1"><!-- note --> <b>t</b></a>`, nil),
+			modern:        response(200, "application/xml", `<a x="1" y="2"><b>t</b></a>`, nil),
+			wantMatchRate: 1.0,
+		},
+		{
+			name:          "binary bodies are compared by hash",
+			legacy:        response(200, "image/png", "\x89PNG\x00\x01", nil),
+			modern:        response(200, "image/png", "\x89PNG\x00\x02", nil),
+			wantMatchRate: 0.3,
+			wantDiffs:     []ResponseDiff{{Type: VALUE_MISMATCH, Dimension: DimensionBody, LegacyValue: "sha256:" + bodyHash([]byte("\x89PNG\x00\x01"))}},
+		},
+		{
+			name:          "body format mismatch is not swapped",
+			legacy:        response(200, "", "not json", nil),
+			modern:        response(200, "", `{"id":1}`, nil),
+			wantMatchRate: 0.2 / 0.9,
+			wantDiffs:     []ResponseDiff{{Type: TYPE_MISMATCH, Dimension: DimensionBody, LegacyValue: "not json", ModernValue: `{"id":1}`}},
+		},
+		{
+			name:          "zero weight dimension is not compared",
+			config:        ComparisonConfig{Weights: ComparisonWeights{Body: 1}},
+			legacy:        response(200, "", `{"id":1}`, nil),
+			modern:        response(500, "", `{"id":1}`, nil),
+			wantMatchRate: 1.0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := NewComparisonEngine(tt.config).CompareResponses(tt.legacy, tt.modern)
+
+			if diff := result.MatchRate - tt.wantMatchRate; diff > 0.0001 || diff < -0.0001 {
+				t.Errorf("MatchRate = %v, want %v", result.MatchRate, tt.wantMatchRate)
+			}
+			if len(result.Differences) != len(tt.wantDiffs) {
+				t.Fatalf("differences = %+v, want %+v", result.Differences, tt.wantDiffs)
+			}
+			for i, want := range tt.wantDiffs {
+				got := result.Differences[i]
+				if got.Type != want.Type || got.Dimension != want.Dimension {
+					t.Errorf("difference[%d] = %+v, want %+v", i, got, want)
+				}
+				if want.LegacyValue != nil && got.LegacyValue != want.LegacyValue {
+					t.Errorf("difference[%d].LegacyValue = %v, want %v", i, got.LegacyValue, want.LegacyValue)
+				}
+				if want.ModernValue != nil && got.ModernValue != want.ModernValue {
+					t.Errorf("difference[%d].ModernValue = %v, want %v", i, got.ModernValue, want.ModernValue)
+				}
+			}
+		})
+	}
+}
@@ -6,21 +6,23 @@ import (
 
 // APIEndpoint는 외부 API 엔드포인트 정보를 나타냅니다.
 type APIEndpoint struct {
-	ID          string        // 엔드포인트 고유 ID
-	Name        string        // 엔드포인트 이름
-	BaseURL     string        // 기본 URL (예: https://api.example.com)
-	Path        string        // 경로 (예: /v1/users)
-	HealthURL   string        // 헬스 체크 URL
-	Method      string        // HTTP 메서드
-	Timeout     time.Duration // 타임아웃
-	RetryCount  int           // 재시도 횟수
-	IsActive    bool          // 활성화 여부
-	IsLegacy    bool          // 레거시 API 여부
-	IsDefault   bool          // 기본 엔드포인트 여부
-	Priority    int           // 우선순위 (여러 엔드포인트가 있을 경우)
-	Description string        // 설명
-	CreatedAt   time.Time     // 생성 시간
-	UpdatedAt   time.Time     // 수정 시간
+	ID                   string               // 엔드포인트 고유 ID
+	Name                 string               // 엔드포인트 이름
+	BaseURL              string               // 기본 URL (예: https://api.example.com)
+	Path                 string               // 경로 (예: /v1/users)
+	HealthURL            string               // 헬스 체크 URL
+	Method               string               // HTTP 메서드
+	Timeout              time.Duration        // 타임아웃
+	RetryCount           int                  // 재시도 횟수
+	RetryPolicy          RetryPolicy          // 재시도 정책 (비어 있으면 RetryCount와 기본값 사용)
+	CircuitBreakerPolicy CircuitBreakerPolicy // Circuit Breaker 정책 (비어 있는 항목은 전역 정책 사용)
+	IsActive             bool                 // 활성화 여부
+	IsLegacy             bool                 // 레거시 API 여부
+	IsDefault            bool                 // 기본 엔드포인트 여부
+	Priority             int                  // 우선순위 (여러 엔드포인트가 있을 경우)
+	Description          string               // 설명
+	CreatedAt            time.Time            // 생성 시간
+	UpdatedAt            time.Time            // 수정 시간
 }
 
 // NewAPIEndpoint는 새로운 APIEndpoint를 생성합니다.
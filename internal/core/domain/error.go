@@ -14,8 +14,9 @@ var (
 	ErrInvalidBody      = errors.New("invalid request body")
 
 	// Response 관련 에러
-	ErrInvalidResponse = errors.New("invalid response")
-	ErrEmptyResponse   = errors.New("empty response")
+	ErrInvalidResponse      = errors.New("invalid response")
+	ErrEmptyResponse        = errors.New("empty response")
+	ErrResponseBodyTooLarge = errors.New("response body too large")
 
 	// Routing 관련 에러
 	ErrRouteNotFound    = errors.New("route not found")
@@ -27,6 +28,9 @@ var (
 	ErrExternalAPITimeout     = errors.New("external API timeout")
 	ErrExternalAPIFailed      = errors.New("external API request failed")
 	ErrExternalAPIUnavailable = errors.New("external API unavailable")
+	ErrCircuitOpen            = errors.New("circuit breaker is open")
+	ErrCircuitBreakerNotFound = errors.New("circuit breaker not found")
+	ErrAllAPICallsFailed      = errors.New("both legacy and modern API calls failed")
 
 	// Cache 관련 에러
 	ErrCacheNotFound    = errors.New("cache entry not found")
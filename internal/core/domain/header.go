@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"net/http"
+	"strings"
+)
+
+// hopByHopHeaders는 연결 구간마다 의미가 달라 프록시가 다음 구간으로 전달하지 않는 헤더입니다 (RFC 9110 7.6.1).
+var hopByHopHeaders = []string{
+	"Connection",
+	"Proxy-Connection",
+	"Keep-Alive",
+	"Proxy-Authenticate",
+	"Proxy-Authorization",
+	"Te",
+	"Trailer",
+	"Transfer-Encoding",
+	"Upgrade",
+}
+
+// RemoveHopByHopHeaders는 hop-by-hop 헤더와 Connection 헤더에 나열된 헤더를 제거합니다.
+// 클라이언트 요청을 업스트림으로, 업스트림 응답을 클라이언트로 전달하기 전에 호출합니다.
+func RemoveHopByHopHeaders(header http.Header) {
+	for _, value := range headerValues(header, "Connection") {
+		for _, name := range strings.Split(value, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				header.Del(name)
+			}
+		}
+	}
+	for _, name := range hopByHopHeaders {
+		header.Del(name)
+	}
+}
+
+// headerValues는 대소문자를 구분하지 않고 헤더의 모든 값을 조회합니다.
+func headerValues(header http.Header, key string) []string {
+	if values, ok := header[http.CanonicalHeaderKey(key)]; ok {
+		return values
+	}
+	for k, values := range header {
+		if strings.EqualFold(k, key) {
+			return values
+		}
+	}
+	return nil
+}
+
+// lookupHeader는 대소문자를 구분하지 않고 헤더의 첫 번째 값을 조회합니다.
+func lookupHeader(header http.Header, key string) (string, bool) {
+	values := headerValues(header, key)
+	if len(values) == 0 {
+		return "", false
+	}
+	return values[0], true
+}
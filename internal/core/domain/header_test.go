@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestRemoveHopByHopHeaders(t *testing.T) {
+	header := http.Header{
+		"Connection":        {"keep-alive, X-Session-Hint"},
+		"Keep-Alive":        {"timeout=5"},
+		"Transfer-Encoding": {"chunked"},
+		"Upgrade":           {"websocket"},
+		"X-Session-Hint":    {"abc"},
+		"Set-Cookie":        {"a=1", "b=2"},
+		"Content-Type":      {"application/json"},
+	}
+
+	RemoveHopByHopHeaders(header)
+
+	want := http.Header{
+		"Set-Cookie":   {"a=1", "b=2"},
+		"Content-Type": {"application/json"},
+	}
+	if !reflect.DeepEqual(header, want) {
+		t.Errorf("expected %v, got %v", want, header)
+	}
+}
+
+func TestRoutingRule_Matches_MultiValuePredicates(t *testing.T) {
+	tests := []struct {
+		name        string
+		queryParams map[string]string
+		want        bool
+	}{
+		{"any value matches exact", map[string]string{"id": "2"}, true},
+		{"any value matches regex", map[string]string{"id": "~^3$"}, false},
+		{"negation requires no value to match", map[string]string{"id": "!2"}, false},
+		{"negation with no matching value", map[string]string{"id": "!3"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := &RoutingRule{
+				ID:            "r",
+				PathPattern:   "/api/users",
+				MethodPattern: "*",
+				IsActive:      true,
+				QueryParams:   tt.queryParams,
+			}
+			req := NewRequest("id", "GET", "/api/users")
+			req.AddQueryParam("id", "1")
+			req.AddQueryParam("id", "2")
+
+			got, err := rule.Matches(req)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestResponseFromJSON_Headers(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want http.Header
+	}{
+		{"multi-value", `{"StatusCode":200,"Headers":{"Set-Cookie":["a=1","b=2"]}}`, http.Header{"Set-Cookie": {"a=1", "b=2"}}},
+		{"legacy single value", `{"StatusCode":200,"Headers":{"Content-Type":"application/json"}}`, http.Header{"Content-Type": {"application/json"}}},
+		{"null", `{"StatusCode":200,"Headers":null}`, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response, err := ResponseFromJSON([]byte(tt.data))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if response.StatusCode != 200 {
+				t.Errorf("expected status 200, got %d", response.StatusCode)
+			}
+			if !reflect.DeepEqual(response.Headers, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, response.Headers)
+			}
+		})
+	}
+}
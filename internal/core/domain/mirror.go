@@ -0,0 +1,88 @@
+package domain
+
+import "net/http"
+
+// DryRunHeader는 미러 호출임을 대상 API에 알리는 헤더입니다.
+// 대상 API는 이 헤더가 있으면 응답만 생성하고 데이터는 변경하지 않아야 합니다.
+const DryRunHeader = "X-Bridge-Dry-Run"
+
+// MirrorPolicy는 라우팅 규칙별 미러링 안전 정책입니다.
+//
+// 미러 호출은 클라이언트에 반환하지 않는 쪽 API 호출입니다 (PARALLEL / SHADOW의 모던 호출,
+// CANARY 모던 그룹과 MODERN_ONLY 롤백 샘플링의 레거시 비교 호출).
+// POST, PUT, PATCH, DELETE 같은 쓰기 메서드를 미러링하면 양쪽 백엔드에 같은 쓰기가 두 번 반영되므로,
+// AllowUnsafeMethods로 명시적으로 허용하지 않으면 미러링하지 않고 주 API만 호출합니다.
+// 멱등 여부가 아니라 안전한 메서드인지(IsSafeMethod)로 판단하므로 멱등인 PUT, DELETE도 쓰기 메서드입니다.
+//
+// 허용한 경우에도 쓰기 요청의 미러 호출은
+//   - DryRunEndpointID가 있으면 모던 엔드포인트 대신 해당 샌드박스 엔드포인트로 보내고
+//   - DryRunHeader가 true이면 X-Bridge-Dry-Run: true 헤더를 붙여 보냅니다.
+//
+// 레거시 비교 호출은 운영 레거시 API에 쓰기를 반영하게 되므로 더 엄격합니다.
+// 쓰기 요청은 AllowUnsafeMethods와 함께 LegacyDryRunEndpointID가 있을 때만 해당 샌드박스로 보내고,
+// 없으면 레거시 비교 호출을 하지 않습니다.
+//
+// 읽기 메서드(GET, HEAD, OPTIONS, TRACE)는 정책과 관계없이 그대로 미러링합니다.
+type MirrorPolicy struct {
+	AllowUnsafeMethods bool   // 쓰기 메서드 미러링 허용
+	DryRunEndpointID   string // 쓰기 요청의 모던 미러 호출을 보낼 샌드박스 엔드포인트 ID (비어 있으면 모던 엔드포인트)
+	DryRunHeader       bool   // 쓰기 요청의 미러 호출에 X-Bridge-Dry-Run: true 헤더 추가
+
+	// LegacyDryRunEndpointID는 쓰기 요청의 레거시 비교 호출을 보낼 샌드박스 엔드포인트 ID입니다.
+	// 비어 있으면 쓰기 요청은 레거시 비교 호출을 하지 않습니다.
+	LegacyDryRunEndpointID string
+}
+
+// IsSafeMethod는 데이터를 변경하지 않는 안전한(safe) 메서드(GET, HEAD, OPTIONS, TRACE)인지 확인합니다.
+// PUT, DELETE는 멱등(idempotent)이지만 데이터를 변경하므로 안전한 메서드가 아닙니다.
+func IsSafeMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace:
+		return true
+	}
+	return false
+}
+
+// AllowsMirror는 method 요청을 미러링할 수 있는지 확인합니다.
+func (p MirrorPolicy) AllowsMirror(method string) bool {
+	return IsSafeMethod(method) || p.AllowUnsafeMethods
+}
+
+// AllowsLegacyMirror는 method 요청의 레거시 비교 호출을 할 수 있는지 확인합니다.
+// 쓰기 요청은 운영 레거시 API로 보내지 않으므로 레거시 샌드박스 엔드포인트가 있어야 합니다.
+func (p MirrorPolicy) AllowsLegacyMirror(method string) bool {
+	return IsSafeMethod(method) || (p.AllowUnsafeMethods && p.LegacyDryRunEndpointID != "")
+}
+
+// LegacyMirrorEndpointID는 method 요청의 레거시 비교 호출을 보낼 엔드포인트 ID를 반환합니다.
+func (p MirrorPolicy) LegacyMirrorEndpointID(method, legacyEndpointID string) string {
+	if !IsSafeMethod(method) && p.LegacyDryRunEndpointID != "" {
+		return p.LegacyDryRunEndpointID
+	}
+	return legacyEndpointID
+}
+
+// MirrorEndpointID는 method 요청의 모던 미러 호출을 보낼 엔드포인트 ID를 반환합니다.
+func (p MirrorPolicy) MirrorEndpointID(method, modernEndpointID string) string {
+	if !IsSafeMethod(method) && p.DryRunEndpointID != "" {
+		return p.DryRunEndpointID
+	}
+	return modernEndpointID
+}
+
+// MirrorHeaders는 method 요청의 미러 호출에만 추가할 헤더를 반환합니다. 추가할 헤더가 없으면 nil입니다.
+func (p MirrorPolicy) MirrorHeaders(method string) map[string]string {
+	if IsSafeMethod(method) || !p.DryRunHeader {
+		return nil
+	}
+	return map[string]string{DryRunHeader: "true"}
+}
+
+// validate는 미러링 정책이 유효한지 검증합니다.
+// 쓰기 메서드를 미러링하지 않으면 dry-run 설정은 쓰이지 않으므로 함께 지정할 수 없습니다.
+func (p MirrorPolicy) validate() error {
+	if !p.AllowUnsafeMethods && (p.DryRunEndpointID != "" || p.LegacyDryRunEndpointID != "" || p.DryRunHeader) {
+		return NewValidationError("MirrorPolicy", "dry-run settings require allow_unsafe_methods")
+	}
+	return nil
+}
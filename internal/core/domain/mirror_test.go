@@ -0,0 +1,120 @@
+package domain
+
+import "testing"
+
+func TestMirrorPolicy_AllowsMirror(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy MirrorPolicy
+		method string
+		want   bool
+	}{
+		{"GET always mirrored", MirrorPolicy{}, "GET", true},
+		{"HEAD always mirrored", MirrorPolicy{}, "HEAD", true},
+		{"POST not mirrored by default", MirrorPolicy{}, "POST", false},
+		{"PUT not mirrored by default", MirrorPolicy{}, "PUT", false},
+		{"PATCH not mirrored by default", MirrorPolicy{}, "PATCH", false},
+		{"DELETE not mirrored by default", MirrorPolicy{}, "DELETE", false},
+		{"POST mirrored when opted in", MirrorPolicy{AllowUnsafeMethods: true}, "POST", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.AllowsMirror(tt.method); got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestMirrorPolicy_LegacyMirror(t *testing.T) {
+	tests := []struct {
+		name         string
+		policy       MirrorPolicy
+		method       string
+		wantAllowed  bool
+		wantEndpoint string
+	}{
+		{"GET uses live legacy", MirrorPolicy{}, "GET", true, "legacy-1"},
+		{"GET ignores legacy sandbox", MirrorPolicy{AllowUnsafeMethods: true, LegacyDryRunEndpointID: "legacy-sandbox-1"}, "GET", true, "legacy-1"},
+		{"POST not compared by default", MirrorPolicy{}, "POST", false, "legacy-1"},
+		{"POST not compared without legacy sandbox", MirrorPolicy{AllowUnsafeMethods: true, DryRunEndpointID: "sandbox-1"}, "POST", false, "legacy-1"},
+		{"POST compared on legacy sandbox", MirrorPolicy{AllowUnsafeMethods: true, LegacyDryRunEndpointID: "legacy-sandbox-1"}, "POST", true, "legacy-sandbox-1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.AllowsLegacyMirror(tt.method); got != tt.wantAllowed {
+				t.Errorf("expected allowed=%v, got %v", tt.wantAllowed, got)
+			}
+			if got := tt.policy.LegacyMirrorEndpointID(tt.method, "legacy-1"); got != tt.wantEndpoint {
+				t.Errorf("expected endpoint %s, got %s", tt.wantEndpoint, got)
+			}
+		})
+	}
+}
+
+func TestMirrorPolicy_DryRun(t *testing.T) {
+	policy := MirrorPolicy{AllowUnsafeMethods: true, DryRunEndpointID: "sandbox-1", DryRunHeader: true}
+
+	if got := policy.MirrorEndpointID("POST", "modern-1"); got != "sandbox-1" {
+		t.Errorf("expected write mirror to use sandbox endpoint, got %s", got)
+	}
+	if got := policy.MirrorEndpointID("GET", "modern-1"); got != "modern-1" {
+		t.Errorf("expected read mirror to use modern endpoint, got %s", got)
+	}
+	if got := policy.MirrorHeaders("DELETE"); got[DryRunHeader] != "true" {
+		t.Errorf("expected dry-run header for write mirror, got %v", got)
+	}
+	if got := policy.MirrorHeaders("GET"); got != nil {
+		t.Errorf("expected no mirror headers for read mirror, got %v", got)
+	}
+	if got := (MirrorPolicy{AllowUnsafeMethods: true}).MirrorHeaders("POST"); got != nil {
+		t.Errorf("expected no mirror headers without dry-run header, got %v", got)
+	}
+}
+
+func TestMirrorPolicy_Validation(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  MirrorPolicy
+		wantErr bool
+	}{
+		{"default", MirrorPolicy{}, false},
+		{"opted in with dry run", MirrorPolicy{AllowUnsafeMethods: true, DryRunEndpointID: "sandbox-1", DryRunHeader: true}, false},
+		{"dry run endpoint without opt in", MirrorPolicy{DryRunEndpointID: "sandbox-1"}, true},
+		{"dry run header without opt in", MirrorPolicy{DryRunHeader: true}, true},
+		{"legacy dry run endpoint without opt in", MirrorPolicy{LegacyDryRunEndpointID: "legacy-sandbox-1"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := NewRoutingRule("rule-1", "users", "/api/users", "*", "endpoint-1")
+			rule.MirrorPolicy = tt.policy
+
+			err := rule.IsValid()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error=%v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestRequest_ForMirror(t *testing.T) {
+	request := NewRequest("req-1", "POST", "/api/users")
+	request.SetHeader("Content-Type", "application/json")
+
+	if request.ForMirror() != request {
+		t.Error("expected request itself when there are no mirror headers")
+	}
+
+	request.MirrorHeaders = map[string]string{DryRunHeader: "true"}
+	mirror := request.ForMirror()
+
+	if mirror.Headers.Get(DryRunHeader) != "true" || mirror.Headers.Get("Content-Type") != "application/json" {
+		t.Errorf("expected mirror request with original and dry-run headers, got %v", mirror.Headers)
+	}
+	if _, exists := request.GetHeader(DryRunHeader); exists {
+		t.Error("expected original request headers to be unchanged")
+	}
+}
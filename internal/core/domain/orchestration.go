@@ -2,6 +2,7 @@ package domain
 
 import (
 	"encoding/json"
+	"strings"
 	"time"
 )
 
@@ -15,29 +16,37 @@ const (
 	MODERN_ONLY APIMode = "MODERN_ONLY"
 	// PARALLEL: 레거시와 모던 API를 병렬로 호출
 	PARALLEL APIMode = "PARALLEL"
+	// CANARY: 설정된 비율의 트래픽만 모던 API로, 나머지는 레거시 API로 호출
+	CANARY APIMode = "CANARY"
+	// SHADOW: 레거시 응답을 즉시 반환하고, 모던 API 호출과 비교는 백그라운드에서 수행
+	SHADOW APIMode = "SHADOW"
 )
 
 // APIComparison는 API 응답 비교 결과를 나타냅니다.
 type APIComparison struct {
-	ID                 string         // 비교 고유 ID
-	RequestID          string         // 요청 ID
-	RoutingRuleID      string         // 라우팅 규칙 ID
-	LegacyResponse     *Response      // 레거시 API 응답
-	ModernResponse     *Response      // 모던 API 응답
-	MatchRate          float64        // 일치율 (0.0 ~ 1.0)
-	Differences        []ResponseDiff // 차이점 목록
-	ComparisonDuration time.Duration  // 비교 소요 시간
-	Timestamp          time.Time      // 비교 시점
-	CreatedAt          time.Time      // 생성 시간
+	ID                  string         // 비교 고유 ID
+	RequestID           string         // 요청 ID
+	RoutingRuleID       string         // 라우팅 규칙 ID
+	OrchestrationRuleID string         // 비교를 수행한 오케스트레이션 규칙 ID
+	LegacyResponse      *Response      // 레거시 API 응답
+	ModernResponse      *Response      // 모던 API 응답
+	MatchRate           float64        // 일치율 (0.0 ~ 1.0)
+	Differences         []ResponseDiff // 차이점 목록
+	ComparisonDuration  time.Duration  // 비교 소요 시간
+	StrictMode          bool           // 엄격 모드 비교 여부 (성공 판정 기준)
+	Skipped             bool           // 비교 비활성화로 응답 비교를 건너뛰었는지 여부
+	Timestamp           time.Time      // 비교 시점
+	CreatedAt           time.Time      // 생성 시간
 }
 
 // ResponseDiff는 응답 차이점을 나타냅니다.
 type ResponseDiff struct {
-	Type        DiffType `json:"type"`         // 차이점 유형
-	Path        string   `json:"path"`         // 차이점 경로
-	LegacyValue any      `json:"legacy_value"` // 레거시 값
-	ModernValue any      `json:"modern_value"` // 모던 값
-	Message     string   `json:"message"`      // 설명
+	Type        DiffType            `json:"type"`                // 차이점 유형
+	Dimension   ComparisonDimension `json:"dimension,omitempty"` // 비교 항목 (status_code, headers, body)
+	Path        string              `json:"path"`                // 차이점 경로 (본문은 JSON 경로, 헤더는 헤더 이름)
+	LegacyValue any                 `json:"legacy_value"`        // 레거시 값
+	ModernValue any                 `json:"modern_value"`        // 모던 값
+	Message     string              `json:"message"`             // 설명
 }
 
 // DiffType은 응답 차이점 유형을 나타냅니다.
@@ -68,20 +77,120 @@ type OrchestrationRule struct {
 
 // TransitionConfig는 전환 설정을 나타냅니다.
 type TransitionConfig struct {
-	AutoTransitionEnabled    bool          // 자동 전환 활성화
-	MatchRateThreshold       float64       // 전환 임계값 (0.0 ~ 1.0)
-	StabilityPeriod          time.Duration // 안정성 확인 기간
-	MinRequestsForTransition int           // 전환을 위한 최소 요청 수
-	RollbackThreshold        float64       // 롤백 임계값
+	AutoTransitionEnabled    bool           // 자동 전환 활성화
+	MatchRateThreshold       float64        // 전환 임계값 (0.0 ~ 1.0)
+	StabilityPeriod          time.Duration  // 안정성 확인 기간 (이 기간 내내 일치율이 임계값 이상이어야 전환)
+	StabilityWindows         int            // 안정성 확인 기간을 나누는 구간 수 (0이면 DefaultStabilityWindows)
+	MinSamplesPerWindow      int            // 구간별 최소 샘플 수 (0이면 MinRequestsForTransition / 구간 수)
+	ConfidenceLevel          float64        // 통과율 Wilson 신뢰 하한의 신뢰 수준 (0이면 DefaultConfidenceLevel)
+	MinRequestsForTransition int            // 전환을 위한 최소 요청 수 (안정성 확인 기간 전체)
+	RollbackThreshold        float64        // 롤백 임계값
+	Canary                   CanaryConfig   // CANARY 모드 트래픽 분배 설정
+	RampPlan                 []RampStage    // 단계적 전환 계획 (비어 있으면 PARALLEL → MODERN_ONLY 즉시 전환)
+	RampState                RampState      // 단계적 전환 진행 상태
+	Rollback                 RollbackConfig // MODERN_ONLY 모드 자동 롤백 설정
 }
 
 // ComparisonConfig는 비교 설정을 나타냅니다.
 type ComparisonConfig struct {
-	Enabled               bool     // 비교 활성화
-	IgnoreFields          []string // 무시할 필드 목록
-	AllowableDifference   float64  // 허용 가능한 차이 (숫자 필드용)
-	StrictMode            bool     // 엄격 모드 (모든 차이점을 에러로 처리)
-	SaveComparisonHistory bool     // 비교 이력 저장 여부
+	Enabled               bool               // 비교 활성화
+	IgnoreFields          []string           // 무시할 필드의 경로 선택자 목록 (PathSelector 문법)
+	Normalizers           []FieldNormalizer  // 비교 전 경로별 값 정규화 규칙
+	ArrayRules            []ArrayRule        // 경로별 배열 비교 방식 (지정하지 않은 배열은 ArrayOrdered)
+	MaxArrayElements      int                // 배열 하나에서 비교할 최대 요소 수 (0이면 DefaultMaxArrayElements)
+	ArraySampling         ArraySamplingMode  // 최대 요소 수를 넘는 배열의 요소 선택 방식 (비어 있으면 ArraySamplingHead)
+	CompareHeaders        []string           // 비교할 응답 헤더 (nil이면 DefaultComparedHeaders)
+	Weights               ComparisonWeights  // 비교 항목별 일치율 가중치 (지정하지 않으면 DefaultComparisonWeights)
+	FieldWeights          []FieldWeight      // 경로별 본문 리프 가중치 (지정하지 않은 리프는 1.0)
+	AllowableDifference   float64            // 허용 가능한 차이 (숫자 필드용)
+	StrictMode            bool               // 엄격 모드 (모든 차이점을 에러로 처리)
+	SaveComparisonHistory bool               // 비교 이력 저장 여부
+	Sampling              ComparisonSampling // 비교 이력 저장 샘플링 정책 (지정하지 않으면 모두 저장)
+}
+
+// ComparisonSuccessThreshold는 엄격 모드가 아닐 때 비교를 성공으로 판정하는 최소 일치율입니다.
+const ComparisonSuccessThreshold = 0.95
+
+// DefaultComparisonConfig는 비교 설정이 지정되지 않은 규칙에 사용하는 기본 비교 설정입니다.
+func DefaultComparisonConfig() ComparisonConfig {
+	return ComparisonConfig{
+		Enabled:               true,
+		IgnoreFields:          []string{"timestamp", "requestId", "request_id"},
+		AllowableDifference:   0.01, // 1% 허용 오차
+		StrictMode:            false,
+		SaveComparisonHistory: true,
+	}
+}
+
+// IsZero는 비교 설정이 전혀 지정되지 않았는지 확인합니다.
+func (c ComparisonConfig) IsZero() bool {
+	return !c.Enabled && len(c.IgnoreFields) == 0 && len(c.Normalizers) == 0 &&
+		len(c.ArrayRules) == 0 && c.MaxArrayElements == 0 && c.ArraySampling == "" &&
+		c.CompareHeaders == nil && c.Weights.IsZero() && len(c.FieldWeights) == 0 &&
+		c.AllowableDifference == 0 && !c.StrictMode && !c.SaveComparisonHistory && c.Sampling.IsZero()
+}
+
+// IsValid는 비교 설정의 경로 선택자, 정규화 규칙, 배열 비교 규칙이 유효한지 검증합니다.
+func (c ComparisonConfig) IsValid() error {
+	if c.AllowableDifference < 0 {
+		return NewValidationError("ComparisonConfig.AllowableDifference", "allowable difference must not be negative")
+	}
+	for _, field := range c.IgnoreFields {
+		if _, err := ParsePathSelector(field); err != nil {
+			return NewValidationError("ComparisonConfig.IgnoreFields", err.Error())
+		}
+	}
+	for _, normalizer := range c.Normalizers {
+		if err := normalizer.validate(); err != nil {
+			return NewValidationError("ComparisonConfig.Normalizers", err.Error())
+		}
+	}
+	for _, rule := range c.ArrayRules {
+		if err := rule.validate(); err != nil {
+			return NewValidationError("ComparisonConfig.ArrayRules", err.Error())
+		}
+	}
+	if c.MaxArrayElements < 0 {
+		return NewValidationError("ComparisonConfig.MaxArrayElements", "max array elements must not be negative")
+	}
+	if !c.ArraySampling.IsValid() {
+		return NewValidationError("ComparisonConfig.ArraySampling", "array sampling must be head or uniform")
+	}
+	for _, header := range c.CompareHeaders {
+		if strings.TrimSpace(header) == "" {
+			return NewValidationError("ComparisonConfig.CompareHeaders", "compared header name must not be empty")
+		}
+	}
+	if c.Weights.StatusCode < 0 || c.Weights.Headers < 0 || c.Weights.Body < 0 {
+		return NewValidationError("ComparisonConfig.Weights", "comparison weights must not be negative")
+	}
+	for _, weight := range c.FieldWeights {
+		if err := weight.validate(); err != nil {
+			return NewValidationError("ComparisonConfig.FieldWeights", err.Error())
+		}
+	}
+	if err := c.Sampling.validate(); err != nil {
+		return NewValidationError("ComparisonConfig.Sampling", err.Error())
+	}
+	return nil
+}
+
+// EffectiveComparisonConfig는 규칙의 비교 설정을 반환합니다.
+// 규칙이 없거나 비교 설정이 지정되지 않았으면 DefaultComparisonConfig를 사용합니다.
+func (o *OrchestrationRule) EffectiveComparisonConfig() ComparisonConfig {
+	if o == nil || o.ComparisonConfig.IsZero() {
+		return DefaultComparisonConfig()
+	}
+	return o.ComparisonConfig
+}
+
+// comparisonSucceeded는 비교 결과의 성공 여부를 판정합니다.
+// 엄격 모드에서는 차이점이 없어야 하고, 그 외에는 ComparisonSuccessThreshold 이상 일치하면 성공입니다.
+func comparisonSucceeded(strict bool, matchRate float64, differences int) bool {
+	if strict {
+		return differences == 0 && matchRate >= 1.0
+	}
+	return matchRate >= ComparisonSuccessThreshold
 }
 
 // NewOrchestrationRule은 새로운 OrchestrationRule을 생성합니다.
@@ -100,27 +209,22 @@ func NewOrchestrationRule(id, name, routingRuleID, legacyEndpointID, modernEndpo
 			StabilityPeriod:          24 * time.Hour,
 			MinRequestsForTransition: 100,
 			RollbackThreshold:        0.90, // 90% 미만 시 롤백
+			Canary: CanaryConfig{
+				Percentage:      0,
+				StickyKeySource: StickyKeyClientIP,
+			},
 		},
-		ComparisonConfig: ComparisonConfig{
-			Enabled:               true,
-			IgnoreFields:          []string{"timestamp", "requestId"},
-			AllowableDifference:   0.01, // 1% 허용 오차
-			StrictMode:            false,
-			SaveComparisonHistory: true,
-		},
-		IsActive:  true,
-		CreatedAt: now,
-		UpdatedAt: now,
+		ComparisonConfig: DefaultComparisonConfig(),
+		IsActive:         true,
+		CreatedAt:        now,
+		UpdatedAt:        now,
 	}
 }
 
 // CanTransitionToModern는 모던 API로 전환 가능한지 확인합니다.
+// 단계적 전환 계획이 있는 규칙은 EvaluateRamp로만 전환하므로 항상 false입니다.
 func (o *OrchestrationRule) CanTransitionToModern(recentMatchRate float64, requestCount int) bool {
-	if !o.TransitionConfig.AutoTransitionEnabled {
-		return false
-	}
-
-	if o.CurrentMode != PARALLEL {
+	if o.AutoTransitionBlocker() != "" {
 		return false
 	}
 
@@ -131,7 +235,8 @@ func (o *OrchestrationRule) CanTransitionToModern(recentMatchRate float64, reque
 	return recentMatchRate >= o.TransitionConfig.MatchRateThreshold
 }
 
-// ShouldRollback는 롤백이 필요한지 확인합니다.
+// ShouldRollback는 일치율 기준으로 롤백이 필요한지 확인합니다.
+// 에러율과 지연 시간까지 함께 판단하려면 EvaluateRollback을 사용합니다.
 func (o *OrchestrationRule) ShouldRollback(recentMatchRate float64) bool {
 	if o.CurrentMode != MODERN_ONLY {
 		return false
@@ -157,6 +262,28 @@ func (o *OrchestrationRule) IsValid() error {
 	if o.TransitionConfig.MatchRateThreshold < 0.0 || o.TransitionConfig.MatchRateThreshold > 1.0 {
 		return NewValidationError("MatchRateThreshold", "match rate threshold must be between 0.0 and 1.0")
 	}
+	if err := o.TransitionConfig.Canary.IsValid(); err != nil {
+		return err
+	}
+	if err := o.TransitionConfig.Rollback.IsValid(); err != nil {
+		return err
+	}
+	if err := o.TransitionConfig.validateRampPlan(); err != nil {
+		return err
+	}
+	if err := o.TransitionConfig.validateStability(); err != nil {
+		return err
+	}
+	if err := o.ComparisonConfig.IsValid(); err != nil {
+		return err
+	}
+	if o.HasRampPlan() && !o.ComparisonConfig.SaveComparisonHistory {
+		return NewValidationError("RampPlan", "ramp plan requires comparison history to be saved")
+	}
+	// 불일치 우선 저장은 저장된 일치율을 낮추므로 자동 롤백/단계적 전환 판단을 왜곡함
+	if o.ComparisonConfig.Sampling.AlwaysStoreMismatches && (o.HasRampPlan() || o.TransitionConfig.Rollback.SampleRate > 0) {
+		return NewValidationError("ComparisonConfig.Sampling", "always_store_mismatches cannot be used with a ramp plan or automatic rollback")
+	}
 	return nil
 }
 
@@ -190,8 +317,14 @@ func (c *APIComparison) CalculateMatchRate() float64 {
 }
 
 // IsSuccessful은 비교가 성공적인지 확인합니다.
+// 엄격 모드에서는 차이점이 하나도 없어야 성공입니다.
 func (c *APIComparison) IsSuccessful() bool {
-	return c.MatchRate >= 0.95 // 95% 이상 일치 시 성공
+	return comparisonSucceeded(c.StrictMode, c.MatchRate, len(c.Differences))
+}
+
+// IsModernError는 모던 API 호출이 실패했거나 5xx 응답을 받았는지 확인합니다.
+func (c *APIComparison) IsModernError() bool {
+	return c.ModernResponse == nil || c.ModernResponse.StatusCode >= 500
 }
 
 // DifferencesToJSON은 Differences를 JSON으로 직렬화합니다.
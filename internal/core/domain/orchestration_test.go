@@ -0,0 +1,55 @@
+package domain
+
+import "testing"
+
+func TestOrchestrationRule_EffectiveComparisonConfig(t *testing.T) {
+	configured := ComparisonConfig{Enabled: true, IgnoreFields: []string{"traceId"}, StrictMode: true}
+	disabled := ComparisonConfig{Enabled: false, SaveComparisonHistory: true}
+
+	tests := []struct {
+		name string
+		rule *OrchestrationRule
+		want ComparisonConfig
+	}{
+		{"nil rule falls back to defaults", nil, DefaultComparisonConfig()},
+		{"unset config falls back to defaults", &OrchestrationRule{}, DefaultComparisonConfig()},
+		{"configured rule", &OrchestrationRule{ComparisonConfig: configured}, configured},
+		{"disabled rule keeps its config", &OrchestrationRule{ComparisonConfig: disabled}, disabled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.rule.EffectiveComparisonConfig()
+			if got.Enabled != tt.want.Enabled || got.StrictMode != tt.want.StrictMode ||
+				got.AllowableDifference != tt.want.AllowableDifference || len(got.IgnoreFields) != len(tt.want.IgnoreFields) {
+				t.Errorf("EffectiveComparisonConfig() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAPIComparison_IsSuccessful(t *testing.T) {
+	oneDiff := []ResponseDiff{{Type: VALUE_MISMATCH, Path: "price"}}
+
+	tests := []struct {
+		name        string
+		strict      bool
+		matchRate   float64
+		differences []ResponseDiff
+		want        bool
+	}{
+		{"lenient above threshold", false, 0.96, oneDiff, true},
+		{"lenient below threshold", false, 0.90, oneDiff, false},
+		{"strict with difference", true, 0.99, oneDiff, false},
+		{"strict exact match", true, 1.0, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			comparison := &APIComparison{StrictMode: tt.strict, MatchRate: tt.matchRate, Differences: tt.differences}
+			if got := comparison.IsSuccessful(); got != tt.want {
+				t.Errorf("IsSuccessful() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,208 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// RampStage는 단계적 전환 계획(ramp plan)의 한 단계입니다.
+//
+// 0인 기준값은 TransitionConfig의 전역 값으로 대체됩니다:
+//   - MinSampleSize → MinRequestsForTransition
+//   - MinMatchRate  → MatchRateThreshold
+//   - DwellTime     → StabilityPeriod
+//
+// MaxErrorRate가 0이면 에러율은 검사하지 않습니다.
+type RampStage struct {
+	Percentage    int           // 이 단계에서 모던 API로 보낼 트래픽 비율 (1 ~ 100)
+	MinSampleSize int           // 다음 단계로 진행하기 위한 최소 비교 샘플 수
+	MinMatchRate  float64       // 다음 단계로 진행하기 위한 최소 평균 일치율 (0.0 ~ 1.0)
+	MaxErrorRate  float64       // 허용 최대 모던 API 에러율 (0.0 ~ 1.0)
+	DwellTime     time.Duration // 다음 단계로 진행하기 전 최소 유지 시간
+}
+
+// RampState는 단계적 전환의 진행 상태입니다.
+type RampState struct {
+	StageIndex     int       // 현재 단계 (CANARY 모드일 때 RampPlan 인덱스)
+	StageStartedAt time.Time // 현재 단계(또는 모드) 시작 시간, 관측 구간의 시작점
+}
+
+// RampAction은 단계 평가 결과의 동작입니다.
+type RampAction string
+
+const (
+	RampHold     RampAction = "HOLD"     // 현재 단계 유지
+	RampAdvance  RampAction = "ADVANCE"  // 다음 단계로 진행
+	RampComplete RampAction = "COMPLETE" // 마지막 단계 통과, MODERN_ONLY로 전환
+)
+
+// RampDecision은 단계 평가 결과입니다.
+type RampDecision struct {
+	Action         RampAction        // 수행할 동작
+	FromMode       APIMode           // 평가 시점의 모드
+	FromPercentage int               // 평가 시점의 모던 API 트래픽 비율
+	ToMode         APIMode           // 전환할 모드 (HOLD면 현재 모드)
+	ToStage        int               // 전환할 단계 인덱스 (CANARY일 때만 의미 있음)
+	ToPercentage   int               // 전환 후 모던 API 트래픽 비율
+	Signals        TransitionSignals // 평가에 사용한 관측 지표
+	Reason         string            // 판단 근거
+}
+
+// HasRampPlan은 단계적 전환 계획이 설정되어 있는지 확인합니다.
+func (o *OrchestrationRule) HasRampPlan() bool {
+	return len(o.TransitionConfig.RampPlan) > 0
+}
+
+// EvaluateRamp는 관측 지표로 단계적 전환의 다음 동작을 결정합니다.
+//
+// 진행 순서:
+//   - PARALLEL / SHADOW: 전역 전환 기준(MatchRateThreshold, MinRequestsForTransition,
+//     StabilityPeriod)을 충족하면 첫 단계(CANARY)로 진입
+//   - CANARY: 현재 단계 기준을 모두 충족하면 다음 단계로, 마지막 단계면 MODERN_ONLY로 전환
+//   - 그 외 모드: 유지
+//
+// 규칙 상태는 변경하지 않으며, 결과 적용은 ApplyRampDecision으로 합니다.
+func (o *OrchestrationRule) EvaluateRamp(signals TransitionSignals, now time.Time) RampDecision {
+	config := o.TransitionConfig
+	decision := RampDecision{
+		Action:         RampHold,
+		FromMode:       o.CurrentMode,
+		FromPercentage: o.modernPercentage(),
+		ToMode:         o.CurrentMode,
+		ToStage:        config.RampState.StageIndex,
+		ToPercentage:   o.modernPercentage(),
+		Signals:        signals,
+	}
+
+	if !config.AutoTransitionEnabled || !o.HasRampPlan() {
+		decision.Reason = "ramp plan disabled"
+		return decision
+	}
+
+	switch o.CurrentMode {
+	case PARALLEL, SHADOW:
+		gate := RampStage{
+			MinSampleSize: config.MinRequestsForTransition,
+			MinMatchRate:  config.MatchRateThreshold,
+			DwellTime:     config.StabilityPeriod,
+		}
+		if unmet := o.unmetRampGates(gate, signals, now); len(unmet) > 0 {
+			decision.Reason = "entry criteria not met: " + strings.Join(unmet, ", ")
+			return decision
+		}
+		decision.Action = RampAdvance
+		decision.ToMode = CANARY
+		decision.ToStage = 0
+		decision.ToPercentage = config.RampPlan[0].Percentage
+		decision.Reason = fmt.Sprintf("entry criteria met, starting stage 1 at %d%%", decision.ToPercentage)
+
+	case CANARY:
+		index := config.RampState.StageIndex
+		if index < 0 || index >= len(config.RampPlan) {
+			decision.Reason = fmt.Sprintf("stage index %d out of range", index)
+			return decision
+		}
+		if unmet := o.unmetRampGates(config.RampPlan[index], signals, now); len(unmet) > 0 {
+			decision.Reason = fmt.Sprintf("stage %d criteria not met: %s", index+1, strings.Join(unmet, ", "))
+			return decision
+		}
+		if index == len(config.RampPlan)-1 {
+			decision.Action = RampComplete
+			decision.ToMode = MODERN_ONLY
+			decision.ToPercentage = 100
+			decision.Reason = fmt.Sprintf("final stage %d criteria met, switching to modern only", index+1)
+			return decision
+		}
+		decision.Action = RampAdvance
+		decision.ToStage = index + 1
+		decision.ToPercentage = config.RampPlan[index+1].Percentage
+		decision.Reason = fmt.Sprintf("stage %d criteria met, advancing to stage %d at %d%%", index+1, index+2, decision.ToPercentage)
+
+	default:
+		decision.Reason = fmt.Sprintf("ramp not applicable in %s mode", o.CurrentMode)
+	}
+
+	return decision
+}
+
+// ApplyRampDecision은 단계 평가 결과를 규칙에 반영합니다. HOLD면 아무것도 하지 않습니다.
+func (o *OrchestrationRule) ApplyRampDecision(decision RampDecision, now time.Time) {
+	if decision.Action == RampHold {
+		return
+	}
+
+	o.CurrentMode = decision.ToMode
+	o.TransitionConfig.Canary.Percentage = decision.ToPercentage
+	o.TransitionConfig.RampState = RampState{
+		StageIndex:     decision.ToStage,
+		StageStartedAt: now,
+	}
+	o.UpdatedAt = now
+}
+
+// unmetRampGates는 단계 기준 중 충족하지 못한 항목을 반환합니다.
+func (o *OrchestrationRule) unmetRampGates(stage RampStage, signals TransitionSignals, now time.Time) []string {
+	config := o.TransitionConfig
+
+	minSamples := stage.MinSampleSize
+	if minSamples <= 0 {
+		minSamples = config.MinRequestsForTransition
+	}
+	minMatchRate := stage.MinMatchRate
+	if minMatchRate <= 0 {
+		minMatchRate = config.MatchRateThreshold
+	}
+	dwell := stage.DwellTime
+	if dwell <= 0 {
+		dwell = config.StabilityPeriod
+	}
+
+	var unmet []string
+	if signals.SampleSize < minSamples {
+		unmet = append(unmet, fmt.Sprintf("samples %d < %d", signals.SampleSize, minSamples))
+	}
+	if signals.MatchRate < minMatchRate {
+		unmet = append(unmet, fmt.Sprintf("match rate %.4f < %.4f", signals.MatchRate, minMatchRate))
+	}
+	if stage.MaxErrorRate > 0 && signals.ErrorRate > stage.MaxErrorRate {
+		unmet = append(unmet, fmt.Sprintf("error rate %.4f > %.4f", signals.ErrorRate, stage.MaxErrorRate))
+	}
+	if elapsed := now.Sub(config.RampState.StageStartedAt); elapsed < dwell {
+		unmet = append(unmet, fmt.Sprintf("dwell %s < %s", elapsed.Truncate(time.Second), dwell))
+	}
+	return unmet
+}
+
+// modernPercentage는 현재 모드에서 모던 API가 처리하는 트래픽 비율을 반환합니다.
+func (o *OrchestrationRule) modernPercentage() int {
+	switch o.CurrentMode {
+	case MODERN_ONLY:
+		return 100
+	case CANARY:
+		return o.TransitionConfig.Canary.Percentage
+	default:
+		return 0
+	}
+}
+
+// validateRampPlan은 단계적 전환 계획이 유효한지 검증합니다.
+func (c TransitionConfig) validateRampPlan() error {
+	previous := 0
+	for i, stage := range c.RampPlan {
+		if stage.Percentage <= previous || stage.Percentage > 100 {
+			return NewValidationError("RampPlan", fmt.Sprintf("stage %d percentage must increase and be between 1 and 100", i+1))
+		}
+		if stage.MinMatchRate < 0 || stage.MinMatchRate > 1 {
+			return NewValidationError("RampPlan", fmt.Sprintf("stage %d min match rate must be between 0.0 and 1.0", i+1))
+		}
+		if stage.MaxErrorRate < 0 || stage.MaxErrorRate > 1 {
+			return NewValidationError("RampPlan", fmt.Sprintf("stage %d max error rate must be between 0.0 and 1.0", i+1))
+		}
+		if stage.MinSampleSize < 0 || stage.DwellTime < 0 {
+			return NewValidationError("RampPlan", fmt.Sprintf("stage %d sample size and dwell time must not be negative", i+1))
+		}
+		previous = stage.Percentage
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+// newRampRule은 1% → 5% → 100% 단계 계획을 가진 테스트용 규칙을 생성합니다.
+func newRampRule(mode APIMode, stageIndex int, stageStartedAt time.Time) *OrchestrationRule {
+	rule := NewOrchestrationRule("orch-1", "ramp", "route-1", "legacy-1", "modern-1")
+	rule.CurrentMode = mode
+	rule.TransitionConfig.MinRequestsForTransition = 100
+	rule.TransitionConfig.MatchRateThreshold = 0.95
+	rule.TransitionConfig.StabilityPeriod = time.Hour
+	rule.TransitionConfig.RampPlan = []RampStage{
+		{Percentage: 1, MinSampleSize: 50, MinMatchRate: 0.98, MaxErrorRate: 0.01, DwellTime: 10 * time.Minute},
+		{Percentage: 5},
+		{Percentage: 100, MaxErrorRate: 0.02},
+	}
+	rule.TransitionConfig.RampState = RampState{StageIndex: stageIndex, StageStartedAt: stageStartedAt}
+	if mode == CANARY {
+		rule.TransitionConfig.Canary.Percentage = rule.TransitionConfig.RampPlan[stageIndex].Percentage
+	}
+	return rule
+}
+
+func TestOrchestrationRule_EvaluateRamp(t *testing.T) {
+	now := time.Date(2025, 1, 5, 12, 0, 0, 0, time.UTC)
+	healthy := TransitionSignals{SampleSize: 200, MatchRate: 0.99, ErrorRate: 0.0}
+
+	tests := []struct {
+		name           string
+		rule           *OrchestrationRule
+		signals        TransitionSignals
+		wantAction     RampAction
+		wantMode       APIMode
+		wantStage      int
+		wantPercentage int
+	}{
+		{"parallel enters first stage", newRampRule(PARALLEL, 0, now.Add(-2*time.Hour)), healthy, RampAdvance, CANARY, 0, 1},
+		{"shadow enters first stage", newRampRule(SHADOW, 0, now.Add(-2*time.Hour)), healthy, RampAdvance, CANARY, 0, 1},
+		{"parallel holds before stability period", newRampRule(PARALLEL, 0, now.Add(-30*time.Minute)), healthy, RampHold, PARALLEL, 0, 0},
+		{"stage advances", newRampRule(CANARY, 0, now.Add(-15*time.Minute)), healthy, RampAdvance, CANARY, 1, 5},
+		{"stage holds during dwell time", newRampRule(CANARY, 0, now.Add(-5*time.Minute)), healthy, RampHold, CANARY, 0, 1},
+		{"stage holds with few samples", newRampRule(CANARY, 0, now.Add(-15*time.Minute)), TransitionSignals{SampleSize: 10, MatchRate: 0.99}, RampHold, CANARY, 0, 1},
+		{"stage holds with low match rate", newRampRule(CANARY, 0, now.Add(-15*time.Minute)), TransitionSignals{SampleSize: 200, MatchRate: 0.97}, RampHold, CANARY, 0, 1},
+		{"stage holds with high error rate", newRampRule(CANARY, 0, now.Add(-15*time.Minute)), TransitionSignals{SampleSize: 200, MatchRate: 0.99, ErrorRate: 0.05}, RampHold, CANARY, 0, 1},
+		{"stage falls back to global criteria", newRampRule(CANARY, 1, now.Add(-30*time.Minute)), healthy, RampHold, CANARY, 1, 5},
+		{"final stage completes", newRampRule(CANARY, 2, now.Add(-2*time.Hour)), healthy, RampComplete, MODERN_ONLY, 2, 100},
+		{"modern only holds", newRampRule(MODERN_ONLY, 2, now.Add(-2*time.Hour)), healthy, RampHold, MODERN_ONLY, 2, 100},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decision := tt.rule.EvaluateRamp(tt.signals, now)
+
+			if decision.Action != tt.wantAction {
+				t.Fatalf("expected action %s, got %s (%s)", tt.wantAction, decision.Action, decision.Reason)
+			}
+			if decision.ToMode != tt.wantMode || decision.ToStage != tt.wantStage || decision.ToPercentage != tt.wantPercentage {
+				t.Errorf("expected %s stage %d at %d%%, got %s stage %d at %d%%",
+					tt.wantMode, tt.wantStage, tt.wantPercentage, decision.ToMode, decision.ToStage, decision.ToPercentage)
+			}
+		})
+	}
+}
+
+func TestOrchestrationRule_EvaluateRamp_AutoTransitionDisabled(t *testing.T) {
+	now := time.Now()
+	rule := newRampRule(CANARY, 0, now.Add(-time.Hour))
+	rule.TransitionConfig.AutoTransitionEnabled = false
+
+	decision := rule.EvaluateRamp(TransitionSignals{SampleSize: 1000, MatchRate: 1.0}, now)
+	if decision.Action != RampHold {
+		t.Errorf("expected HOLD when auto transition disabled, got %s", decision.Action)
+	}
+}
+
+func TestOrchestrationRule_ApplyRampDecision(t *testing.T) {
+	now := time.Now()
+	rule := newRampRule(CANARY, 0, now.Add(-time.Hour))
+
+	decision := rule.EvaluateRamp(TransitionSignals{SampleSize: 200, MatchRate: 0.99}, now)
+	rule.ApplyRampDecision(decision, now)
+
+	if rule.CurrentMode != CANARY || rule.TransitionConfig.Canary.Percentage != 5 {
+		t.Errorf("expected CANARY at 5%%, got %s at %d%%", rule.CurrentMode, rule.TransitionConfig.Canary.Percentage)
+	}
+	if rule.TransitionConfig.RampState.StageIndex != 1 || !rule.TransitionConfig.RampState.StageStartedAt.Equal(now) {
+		t.Errorf("expected stage 1 started at now, got %+v", rule.TransitionConfig.RampState)
+	}
+}
+
+func TestOrchestrationRule_CanTransitionToModern_WithRampPlan(t *testing.T) {
+	rule := newRampRule(PARALLEL, 0, time.Now())
+
+	if rule.CanTransitionToModern(1.0, 1000) {
+		t.Error("rules with a ramp plan should not switch directly to MODERN_ONLY")
+	}
+}
+
+func TestOrchestrationRule_IsValid_RampPlan(t *testing.T) {
+	tests := []struct {
+		name     string
+		plan     []RampStage
+		saveHist bool
+		wantErr  bool
+	}{
+		{"no plan", nil, false, false},
+		{"increasing plan", []RampStage{{Percentage: 1}, {Percentage: 25}, {Percentage: 100}}, true, false},
+		{"decreasing percentage", []RampStage{{Percentage: 25}, {Percentage: 5}}, true, true},
+		{"duplicate percentage", []RampStage{{Percentage: 5}, {Percentage: 5}}, true, true},
+		{"zero percentage", []RampStage{{Percentage: 0}}, true, true},
+		{"percentage over 100", []RampStage{{Percentage: 101}}, true, true},
+		{"match rate over 1", []RampStage{{Percentage: 1, MinMatchRate: 1.5}}, true, true},
+		{"negative error rate", []RampStage{{Percentage: 1, MaxErrorRate: -0.1}}, true, true},
+		{"negative dwell time", []RampStage{{Percentage: 1, DwellTime: -time.Minute}}, true, true},
+		{"comparison history disabled", []RampStage{{Percentage: 1}}, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := NewOrchestrationRule("orch-1", "ramp", "route-1", "legacy-1", "modern-1")
+			rule.TransitionConfig.RampPlan = tt.plan
+			rule.ComparisonConfig.SaveComparisonHistory = tt.saveHist
+
+			err := rule.IsValid()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error=%v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
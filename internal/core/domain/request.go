@@ -1,6 +1,10 @@
 package domain
 
 import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
 	"time"
 )
 
@@ -10,9 +14,14 @@ type Request struct {
 	Method        string            // HTTP 메서드 (GET, POST, PUT, DELETE 등)
 	Path          string            // 요청 경로
 	RoutingRuleID string            // 라우팅 규칙 ID
-	Headers       map[string]string // 요청 헤더
-	QueryParams   map[string]string // 쿼리 파라미터
+	Headers       http.Header       // 요청 헤더 (같은 이름의 여러 값 유지)
+	QueryParams   url.Values        // 쿼리 파라미터 (같은 이름의 여러 값 유지, 예: ?id=1&id=2)
+	PathParams    map[string]string // 라우팅 규칙이 경로에서 캡처한 파라미터 (예: {id})
+	UpstreamPath  string            // 재작성된 업스트림 경로 (비어 있으면 Path 사용)
+	MirrorHeaders map[string]string // 미러 호출에만 추가할 헤더 (라우팅 규칙의 MirrorPolicy, ForMirror 참고)
 	Body          []byte            // 요청 본문
+	BodyStream    io.Reader         // 아직 읽지 않은 요청 본문 (있으면 Body 대신 그대로 전달, BufferBody 참고)
+	ContentLength int64             // BodyStream의 길이 (-1이면 알 수 없음)
 	Timestamp     time.Time         // 요청 시간
 	ClientIP      string            // 클라이언트 IP
 	SourceIP      string            // 소스 IP (ClientIP와 동일)
@@ -25,32 +34,95 @@ func NewRequest(id, method, path string) *Request {
 		ID:          id,
 		Method:      method,
 		Path:        path,
-		Headers:     make(map[string]string),
-		QueryParams: make(map[string]string),
+		Headers:     make(http.Header),
+		QueryParams: make(url.Values),
 		Timestamp:   time.Now(),
 	}
 }
 
-// GetHeader는 헤더 값을 조회합니다.
+// GetHeader는 헤더의 첫 번째 값을 조회합니다 (헤더 이름은 대소문자 구분 없음).
 func (r *Request) GetHeader(key string) (string, bool) {
-	value, exists := r.Headers[key]
-	return value, exists
+	return lookupHeader(r.Headers, key)
 }
 
-// SetHeader는 헤더를 설정합니다.
+// SetHeader는 헤더를 설정합니다. 기존 값은 모두 대체됩니다.
 func (r *Request) SetHeader(key, value string) {
-	r.Headers[key] = value
+	r.Headers.Set(key, value)
+}
+
+// AddHeader는 헤더 값을 추가합니다. 기존 값은 유지됩니다.
+func (r *Request) AddHeader(key, value string) {
+	r.Headers.Add(key, value)
 }
 
-// GetQueryParam은 쿼리 파라미터 값을 조회합니다.
+// GetQueryParam은 쿼리 파라미터의 첫 번째 값을 조회합니다.
 func (r *Request) GetQueryParam(key string) (string, bool) {
-	value, exists := r.QueryParams[key]
-	return value, exists
+	values, exists := r.QueryParams[key]
+	if !exists || len(values) == 0 {
+		return "", false
+	}
+	return values[0], true
 }
 
-// SetQueryParam은 쿼리 파라미터를 설정합니다.
+// SetQueryParam은 쿼리 파라미터를 설정합니다. 기존 값은 모두 대체됩니다.
 func (r *Request) SetQueryParam(key, value string) {
-	r.QueryParams[key] = value
+	r.QueryParams.Set(key, value)
+}
+
+// AddQueryParam은 쿼리 파라미터 값을 추가합니다. 기존 값은 유지됩니다.
+func (r *Request) AddQueryParam(key, value string) {
+	r.QueryParams.Add(key, value)
+}
+
+// GetPathParam은 경로 파라미터 값을 조회합니다.
+func (r *Request) GetPathParam(key string) (string, bool) {
+	value, exists := r.PathParams[key]
+	return value, exists
+}
+
+// TargetPath는 업스트림으로 전달할 경로를 반환합니다.
+// 재작성된 경로가 있으면 이를, 없으면 원래 요청 경로를 반환합니다.
+func (r *Request) TargetPath() string {
+	if r.UpstreamPath != "" {
+		return r.UpstreamPath
+	}
+	return r.Path
+}
+
+// BufferBody는 아직 읽지 않은 요청 본문(BodyStream)을 Body로 읽어 들입니다.
+// 응답 비교, 캐시, 재시도처럼 본문을 여러 번 보내야 하는 경우에만 호출합니다.
+func (r *Request) BufferBody() error {
+	if r.BodyStream == nil {
+		return nil
+	}
+
+	body, err := io.ReadAll(r.BodyStream)
+	r.BodyStream = nil
+	if err != nil {
+		return fmt.Errorf("failed to read request body: %w", err)
+	}
+
+	r.Body = body
+	r.ContentLength = int64(len(body))
+	return nil
+}
+
+// ForMirror는 미러 호출에 사용할 요청을 반환합니다.
+// MirrorHeaders가 있으면 헤더를 추가한 복사본을, 없으면 요청 자신을 반환합니다.
+func (r *Request) ForMirror() *Request {
+	if len(r.MirrorHeaders) == 0 {
+		return r
+	}
+
+	mirror := *r
+	mirror.Headers = make(http.Header, len(r.Headers)+len(r.MirrorHeaders))
+	for key, values := range r.Headers {
+		mirror.Headers[key] = append([]string(nil), values...)
+	}
+	for key, value := range r.MirrorHeaders {
+		mirror.Headers.Set(key, value)
+	}
+	return &mirror
 }
 
 // IsValid는 요청이 유효한지 검증합니다.
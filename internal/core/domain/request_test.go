@@ -1,7 +1,10 @@
 package domain
 
 import (
+	"errors"
+	"strings"
 	"testing"
+	"testing/iotest"
 )
 
 func TestNewRequest(t *testing.T) {
@@ -124,3 +127,34 @@ func BenchmarkNewRequest(b *testing.B) {
 		_ = NewRequest("id-"+string(rune(i)), "GET", "/api/test")
 	}
 }
+
+func TestRequest_BufferBody(t *testing.T) {
+	req := NewRequest("trace-123", "POST", "/api/v1/users")
+	req.BodyStream = strings.NewReader(`{"name":"kim"}`)
+	req.ContentLength = -1
+
+	if err := req.BufferBody(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(req.Body) != `{"name":"kim"}` {
+		t.Errorf("expected buffered body, got %s", req.Body)
+	}
+	if req.BodyStream != nil {
+		t.Error("BodyStream should be cleared after buffering")
+	}
+	if req.ContentLength != int64(len(req.Body)) {
+		t.Errorf("expected ContentLength %d, got %d", len(req.Body), req.ContentLength)
+	}
+
+	// 이미 버퍼링된 요청은 그대로 유지
+	if err := req.BufferBody(); err != nil || string(req.Body) != `{"name":"kim"}` {
+		t.Errorf("expected no-op on buffered request, got body %s, err %v", req.Body, err)
+	}
+
+	readErr := errors.New("read failed")
+	req = NewRequest("trace-123", "POST", "/api/v1/users")
+	req.BodyStream = iotest.ErrReader(readErr)
+	if err := req.BufferBody(); !errors.Is(err, readErr) {
+		t.Errorf("expected wrapped read error, got %v", err)
+	}
+}
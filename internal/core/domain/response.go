@@ -2,27 +2,30 @@ package domain
 
 import (
 	"encoding/json"
+	"io"
+	"net/http"
 	"time"
 )
 
 // Response는 API Bridge를 통과하는 응답을 나타냅니다.
 type Response struct {
-	RequestID   string            // 원본 요청 ID (Trace ID)
-	StatusCode  int               // HTTP 상태 코드
-	Headers     map[string]string // 응답 헤더
-	Body        []byte            // 응답 본문
-	ContentType string            // 응답 콘텐츠 타입
-	Timestamp   time.Time         // 응답 시간
-	Duration    time.Duration     // 처리 시간
-	Source      string            // 응답 소스 (예: external-api, cache, database)
-	Error       error             // 에러 (있는 경우)
+	RequestID   string        // 원본 요청 ID (Trace ID)
+	StatusCode  int           // HTTP 상태 코드
+	Headers     http.Header   // 응답 헤더 (같은 이름의 여러 값 유지, 예: Set-Cookie)
+	Body        []byte        // 응답 본문
+	BodyStream  io.ReadCloser `json:"-"` // 버퍼링하지 않은 응답 본문 (있으면 Body 대신 그대로 전달, 호출자가 닫아야 함)
+	ContentType string        // 응답 콘텐츠 타입
+	Timestamp   time.Time     // 응답 시간
+	Duration    time.Duration // 처리 시간
+	Source      string        // 응답 소스 (예: external-api, cache, database)
+	Error       error         `json:"-"` // 에러 (있는 경우, error는 JSON으로 복원할 수 없으므로 직렬화하지 않음)
 }
 
 // NewResponse는 새로운 Response를 생성합니다.
 func NewResponse(requestID string) *Response {
 	return &Response{
 		RequestID: requestID,
-		Headers:   make(map[string]string),
+		Headers:   make(http.Header),
 		Timestamp: time.Now(),
 	}
 }
@@ -40,15 +43,19 @@ func (r *Response) SetError(statusCode int, err error) {
 	r.Error = err
 }
 
-// GetHeader는 헤더 값을 조회합니다.
+// GetHeader는 헤더의 첫 번째 값을 조회합니다 (헤더 이름은 대소문자 구분 없음).
 func (r *Response) GetHeader(key string) (string, bool) {
-	value, exists := r.Headers[key]
-	return value, exists
+	return lookupHeader(r.Headers, key)
 }
 
-// SetHeader는 헤더를 설정합니다.
+// SetHeader는 헤더를 설정합니다. 기존 값은 모두 대체됩니다.
 func (r *Response) SetHeader(key, value string) {
-	r.Headers[key] = value
+	r.Headers.Set(key, value)
+}
+
+// AddHeader는 헤더 값을 추가합니다. 기존 값은 유지됩니다.
+func (r *Response) AddHeader(key, value string) {
+	r.Headers.Add(key, value)
 }
 
 // IsSuccess는 응답이 성공인지 확인합니다.
@@ -71,6 +78,39 @@ func (r *Response) ToJSON() ([]byte, error) {
 	return json.Marshal(r)
 }
 
+// UnmarshalJSON은 JSON에서 Response를 복원합니다.
+// 헤더 값이 문자열 하나로 저장된 이전 형식({"Content-Type": "application/json"})도 읽습니다.
+func (r *Response) UnmarshalJSON(data []byte) error {
+	type plainResponse Response
+	aux := struct {
+		*plainResponse
+		Headers json.RawMessage
+	}{plainResponse: (*plainResponse)(r)}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	r.Headers = nil
+	if len(aux.Headers) == 0 || string(aux.Headers) == "null" {
+		return nil
+	}
+
+	if err := json.Unmarshal(aux.Headers, &r.Headers); err == nil {
+		return nil
+	}
+
+	var single map[string]string
+	if err := json.Unmarshal(aux.Headers, &single); err != nil {
+		return err
+	}
+	r.Headers = make(http.Header, len(single))
+	for key, value := range single {
+		r.Headers[key] = []string{value}
+	}
+	return nil
+}
+
 // ResponseFromJSON은 JSON에서 Response를 생성합니다.
 func ResponseFromJSON(data []byte) (*Response, error) {
 	var response Response
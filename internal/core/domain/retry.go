@@ -0,0 +1,125 @@
+package domain
+
+import (
+	"math"
+	"time"
+)
+
+// 재시도 대상 에러 종류입니다. 설정의 retryable_errors 값으로 사용합니다.
+const (
+	RetryErrorTimeout           = "timeout"            // 요청 타임아웃
+	RetryErrorConnectionRefused = "connection_refused" // 연결 거부
+	RetryErrorConnectionReset   = "connection_reset"   // 연결 끊김
+)
+
+// 재시도 정책 기본값입니다. 설정에 재시도 정책이 없는 엔드포인트에 적용합니다.
+const (
+	DefaultRetryInitialDelay      = 1 * time.Second
+	DefaultRetryMaxDelay          = 10 * time.Second
+	DefaultRetryBackoffMultiplier = 2.0
+)
+
+// DefaultRetryableErrors는 기본 재시도 대상 에러 종류입니다.
+var DefaultRetryableErrors = []string{RetryErrorTimeout, RetryErrorConnectionRefused, RetryErrorConnectionReset}
+
+// DefaultRetryableHTTPCodes는 기본 재시도 대상 HTTP 상태 코드입니다.
+var DefaultRetryableHTTPCodes = []int{500, 502, 503, 504}
+
+// RetryPolicy는 엔드포인트별 재시도 정책입니다.
+//
+// 재시도 간격은 지수 백오프에 full jitter를 적용합니다.
+// n번째 재시도 전 대기 시간은 [0, min(MaxDelay, InitialDelay * BackoffMultiplier^(n-1))) 구간의 임의 값입니다.
+//
+// 응답 상태 코드 기반 재시도는 데이터를 변경하지 않는 안전한 메서드(GET, HEAD, OPTIONS, TRACE)에만 적용합니다.
+// 쓰기 요청은 서버가 이미 처리했을 수 있으므로 5xx 응답을 그대로 반환하고,
+// 전송 에러도 요청이 서버에 도달하지 않은 것이 확실한 연결 거부(connection_refused)만 재시도합니다.
+type RetryPolicy struct {
+	MaxAttempts        int           // 최대 시도 횟수 (초기 시도 포함)
+	InitialDelay       time.Duration // 첫 재시도 전 최대 대기 시간
+	MaxDelay           time.Duration // 재시도 전 최대 대기 시간 상한
+	BackoffMultiplier  float64       // 재시도마다 대기 시간 상한을 늘리는 배수
+	RetryableErrors    []string      // 재시도 대상 에러 종류 (RetryError* 상수)
+	RetryableHTTPCodes []int         // 재시도 대상 HTTP 상태 코드
+}
+
+// EffectiveRetryPolicy는 기본값을 채운 재시도 정책을 반환합니다.
+// 정책의 MaxAttempts가 없으면 RetryCount + 1을 최대 시도 횟수로 사용합니다.
+// RetryableHTTPCodes가 nil이면 기본 상태 코드를 사용하고, 빈 목록이면 상태 코드 기반 재시도를 하지 않습니다.
+func (e *APIEndpoint) EffectiveRetryPolicy() RetryPolicy {
+	policy := e.RetryPolicy
+	if policy.MaxAttempts <= 0 {
+		policy.MaxAttempts = e.RetryCount + 1
+	}
+	if policy.MaxAttempts < 1 {
+		policy.MaxAttempts = 1
+	}
+	if policy.InitialDelay <= 0 {
+		policy.InitialDelay = DefaultRetryInitialDelay
+	}
+	if policy.MaxDelay <= 0 {
+		policy.MaxDelay = DefaultRetryMaxDelay
+	}
+	if policy.MaxDelay < policy.InitialDelay {
+		policy.MaxDelay = policy.InitialDelay
+	}
+	if policy.BackoffMultiplier < 1 {
+		policy.BackoffMultiplier = DefaultRetryBackoffMultiplier
+	}
+	if len(policy.RetryableErrors) == 0 {
+		policy.RetryableErrors = DefaultRetryableErrors
+	}
+	if policy.RetryableHTTPCodes == nil {
+		policy.RetryableHTTPCodes = DefaultRetryableHTTPCodes
+	}
+	return policy
+}
+
+// BackoffCeiling은 retry번째 재시도(1부터 시작) 전 대기 시간의 상한을 반환합니다.
+func (p RetryPolicy) BackoffCeiling(retry int) time.Duration {
+	if retry < 1 {
+		return 0
+	}
+	ceiling := float64(p.InitialDelay) * math.Pow(p.BackoffMultiplier, float64(retry-1))
+	if ceiling > float64(p.MaxDelay) {
+		return p.MaxDelay
+	}
+	return time.Duration(ceiling)
+}
+
+// Backoff는 retry번째 재시도 전 대기 시간을 반환합니다.
+// random은 [0, 1) 구간의 난수를 반환하는 함수입니다 (예: rand.Float64).
+func (p RetryPolicy) Backoff(retry int, random func() float64) time.Duration {
+	return time.Duration(random() * float64(p.BackoffCeiling(retry)))
+}
+
+// ShouldRetryStatus는 method 요청의 statusCode 응답을 재시도해야 하는지 확인합니다.
+// 안전한 메서드(IsSafeMethod) 요청만 재시도하며, PUT, DELETE처럼 멱등이더라도 데이터를 변경하는 요청은 재시도하지 않습니다.
+func (p RetryPolicy) ShouldRetryStatus(method string, statusCode int) bool {
+	if !IsSafeMethod(method) {
+		return false
+	}
+	for _, code := range p.RetryableHTTPCodes {
+		if code == statusCode {
+			return true
+		}
+	}
+	return false
+}
+
+// ShouldRetryError는 method 요청의 kind 종류 에러를 재시도해야 하는지 확인합니다.
+// 안전한 메서드가 아닌 요청은 타임아웃이나 연결 끊김이면 서버가 이미 받았을 수 있으므로
+// 연결 거부(RetryErrorConnectionRefused)만 재시도합니다.
+func (p RetryPolicy) ShouldRetryError(method, kind string) bool {
+	if kind == "" {
+		return false
+	}
+	if !IsSafeMethod(method) && kind != RetryErrorConnectionRefused {
+		return false
+	}
+	for _, retryable := range p.RetryableErrors {
+		if retryable == kind {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,130 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAPIEndpoint_EffectiveRetryPolicy(t *testing.T) {
+	endpoint := &APIEndpoint{RetryCount: 2}
+
+	policy := endpoint.EffectiveRetryPolicy()
+	if policy.MaxAttempts != 3 {
+		t.Errorf("expected max attempts from retry count 3, got %d", policy.MaxAttempts)
+	}
+	if policy.InitialDelay != DefaultRetryInitialDelay || policy.MaxDelay != DefaultRetryMaxDelay {
+		t.Errorf("expected default delays, got %s / %s", policy.InitialDelay, policy.MaxDelay)
+	}
+	if policy.BackoffMultiplier != DefaultRetryBackoffMultiplier {
+		t.Errorf("expected default multiplier, got %v", policy.BackoffMultiplier)
+	}
+	if !policy.ShouldRetryStatus("GET", 503) {
+		t.Error("expected default policy to retry 503")
+	}
+
+	endpoint.RetryPolicy = RetryPolicy{MaxAttempts: 5, RetryableHTTPCodes: []int{}}
+	policy = endpoint.EffectiveRetryPolicy()
+	if policy.MaxAttempts != 5 {
+		t.Errorf("expected configured max attempts 5, got %d", policy.MaxAttempts)
+	}
+	if policy.ShouldRetryStatus("GET", 503) {
+		t.Error("expected empty status code list to disable status retries")
+	}
+
+	endpoint = &APIEndpoint{RetryCount: -1}
+	if got := endpoint.EffectiveRetryPolicy().MaxAttempts; got != 1 {
+		t.Errorf("expected at least one attempt, got %d", got)
+	}
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := RetryPolicy{InitialDelay: 100 * time.Millisecond, MaxDelay: time.Second, BackoffMultiplier: 2}
+
+	tests := []struct {
+		retry int
+		want  time.Duration
+	}{
+		{0, 0},
+		{1, 100 * time.Millisecond},
+		{2, 200 * time.Millisecond},
+		{3, 400 * time.Millisecond},
+		{4, 800 * time.Millisecond},
+		{5, time.Second},
+		{10, time.Second},
+	}
+
+	for _, tt := range tests {
+		if got := policy.BackoffCeiling(tt.retry); got != tt.want {
+			t.Errorf("retry %d: expected ceiling %s, got %s", tt.retry, tt.want, got)
+		}
+	}
+
+	if got := policy.Backoff(3, func() float64 { return 0.5 }); got != 200*time.Millisecond {
+		t.Errorf("expected jittered backoff 200ms, got %s", got)
+	}
+	if got := policy.Backoff(3, func() float64 { return 0 }); got != 0 {
+		t.Errorf("expected full jitter to allow zero delay, got %s", got)
+	}
+}
+
+func TestRetryPolicy_ShouldRetry(t *testing.T) {
+	policy := RetryPolicy{
+		RetryableErrors:    []string{RetryErrorTimeout},
+		RetryableHTTPCodes: []int{502, 503},
+	}
+
+	tests := []struct {
+		name   string
+		method string
+		status int
+		want   bool
+	}{
+		{"GET retryable status", "GET", 503, true},
+		{"GET non retryable status", "GET", 500, false},
+		{"GET success", "GET", 200, false},
+		{"POST retryable status not retried", "POST", 503, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := policy.ShouldRetryStatus(tt.method, tt.status); got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+
+	if !policy.ShouldRetryError("GET", RetryErrorTimeout) {
+		t.Error("expected timeout to be retried")
+	}
+	if policy.ShouldRetryError("GET", RetryErrorConnectionRefused) {
+		t.Error("expected connection refused not to be retried when not configured")
+	}
+	if policy.ShouldRetryError("GET", "") {
+		t.Error("expected unclassified error not to be retried")
+	}
+}
+
+func TestRetryPolicy_ShouldRetryError_UnsafeMethods(t *testing.T) {
+	policy := RetryPolicy{RetryableErrors: DefaultRetryableErrors}
+
+	tests := []struct {
+		method string
+		kind   string
+		want   bool
+	}{
+		{"GET", RetryErrorTimeout, true},
+		{"GET", RetryErrorConnectionReset, true},
+		{"POST", RetryErrorTimeout, false},
+		{"PUT", RetryErrorConnectionReset, false},
+		{"DELETE", RetryErrorTimeout, false},
+		{"POST", RetryErrorConnectionRefused, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.kind, func(t *testing.T) {
+			if got := policy.ShouldRetryError(tt.method, tt.kind); got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DefaultRollbackWindow는 RollbackConfig.Window가 0일 때 사용하는 관측 구간입니다.
+const DefaultRollbackWindow = 15 * time.Minute
+
+// RollbackConfig는 MODERN_ONLY 모드의 자동 롤백 설정을 나타냅니다.
+//
+// MODERN_ONLY 모드에서는 레거시 API를 호출하지 않으므로, SampleRate 비율의 요청만
+// 백그라운드에서 레거시 응답과 비교해 롤백 판단 지표(일치율, 에러율, 지연 시간)를 수집합니다.
+// 일치율 기준은 TransitionConfig.RollbackThreshold를 사용합니다.
+type RollbackConfig struct {
+	TargetMode    APIMode       // 롤백 대상 모드 (PARALLEL 또는 LEGACY_ONLY, 비어 있으면 PARALLEL)
+	SampleRate    float64       // 레거시 응답과 비교할 요청 비율 (0.0 ~ 1.0, 0이면 비교하지 않음)
+	Window        time.Duration // 롤백 판단에 사용할 최근 관측 구간 (0이면 DefaultRollbackWindow)
+	MinSampleSize int           // 롤백 판단을 위한 최소 비교 샘플 수 (0이면 MinRequestsForTransition)
+	MaxErrorRate  float64       // 허용 최대 모던 API 에러율 (0이면 검사하지 않음)
+	MaxLatency    time.Duration // 허용 최대 모던 API 평균 응답 시간 (0이면 검사하지 않음)
+}
+
+// RollbackTarget은 롤백 대상 모드를 반환합니다.
+func (c RollbackConfig) RollbackTarget() APIMode {
+	if c.TargetMode == LEGACY_ONLY {
+		return LEGACY_ONLY
+	}
+	return PARALLEL
+}
+
+// ObservationWindow는 롤백 판단에 사용할 관측 구간을 반환합니다.
+func (c RollbackConfig) ObservationWindow() time.Duration {
+	if c.Window <= 0 {
+		return DefaultRollbackWindow
+	}
+	return c.Window
+}
+
+// IsValid는 롤백 설정이 유효한지 검증합니다.
+func (c RollbackConfig) IsValid() error {
+	switch c.TargetMode {
+	case "", PARALLEL, LEGACY_ONLY:
+	default:
+		return NewValidationError("Rollback.TargetMode", "rollback target mode must be PARALLEL or LEGACY_ONLY")
+	}
+	if c.SampleRate < 0 || c.SampleRate > 1 {
+		return NewValidationError("Rollback.SampleRate", "rollback sample rate must be between 0.0 and 1.0")
+	}
+	if c.MaxErrorRate < 0 || c.MaxErrorRate > 1 {
+		return NewValidationError("Rollback.MaxErrorRate", "rollback max error rate must be between 0.0 and 1.0")
+	}
+	if c.Window < 0 || c.MinSampleSize < 0 || c.MaxLatency < 0 {
+		return NewValidationError("Rollback", "rollback window, sample size and latency must not be negative")
+	}
+	return nil
+}
+
+// EvaluateRollback은 관측 지표로 롤백 필요 여부와 그 사유를 판단합니다.
+//
+// MODERN_ONLY 모드이고 최소 샘플 수를 충족한 경우에만 판단하며,
+// 일치율(ShouldRollback), 에러율, 평균 응답 시간 중 하나라도 기준을 벗어나면 롤백합니다.
+func (o *OrchestrationRule) EvaluateRollback(signals TransitionSignals) (bool, string) {
+	if o.CurrentMode != MODERN_ONLY {
+		return false, fmt.Sprintf("rollback not applicable in %s mode", o.CurrentMode)
+	}
+
+	config := o.TransitionConfig.Rollback
+	minSamples := config.MinSampleSize
+	if minSamples <= 0 {
+		minSamples = o.TransitionConfig.MinRequestsForTransition
+	}
+	if signals.SampleSize < minSamples {
+		return false, fmt.Sprintf("insufficient samples %d < %d", signals.SampleSize, minSamples)
+	}
+
+	var breaches []string
+	if o.ShouldRollback(signals.MatchRate) {
+		breaches = append(breaches, fmt.Sprintf("match rate %.4f < %.4f", signals.MatchRate, o.TransitionConfig.RollbackThreshold))
+	}
+	if config.MaxErrorRate > 0 && signals.ErrorRate > config.MaxErrorRate {
+		breaches = append(breaches, fmt.Sprintf("error rate %.4f > %.4f", signals.ErrorRate, config.MaxErrorRate))
+	}
+	if config.MaxLatency > 0 && signals.AverageLatency > config.MaxLatency {
+		breaches = append(breaches, fmt.Sprintf("average latency %s > %s", signals.AverageLatency.Truncate(time.Millisecond), config.MaxLatency))
+	}
+
+	if len(breaches) == 0 {
+		return false, "within rollback thresholds"
+	}
+	return true, strings.Join(breaches, ", ")
+}
+
+// ApplyRollback은 규칙을 롤백 대상 모드로 전환합니다.
+// 롤백 직후 다시 자동 전환되어 모드가 반복 전환되지 않도록 자동 전환을 비활성화합니다.
+func (o *OrchestrationRule) ApplyRollback(now time.Time) {
+	o.CurrentMode = o.TransitionConfig.Rollback.RollbackTarget()
+	o.TransitionConfig.AutoTransitionEnabled = false
+	o.TransitionConfig.RampState = RampState{StageStartedAt: now}
+	o.UpdatedAt = now
+}
@@ -0,0 +1,103 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func newRollbackRule(mode APIMode) *OrchestrationRule {
+	rule := NewOrchestrationRule("orch-1", "rollback", "route-1", "legacy-1", "modern-1")
+	rule.CurrentMode = mode
+	rule.TransitionConfig.RollbackThreshold = 0.90
+	rule.TransitionConfig.Rollback = RollbackConfig{
+		SampleRate:    0.1,
+		MinSampleSize: 20,
+		MaxErrorRate:  0.05,
+		MaxLatency:    500 * time.Millisecond,
+	}
+	return rule
+}
+
+func TestOrchestrationRule_EvaluateRollback(t *testing.T) {
+	healthy := TransitionSignals{SampleSize: 100, MatchRate: 0.99, ErrorRate: 0.01, AverageLatency: 100 * time.Millisecond}
+
+	tests := []struct {
+		name         string
+		mode         APIMode
+		signals      TransitionSignals
+		wantRollback bool
+	}{
+		{"healthy", MODERN_ONLY, healthy, false},
+		{"low match rate", MODERN_ONLY, TransitionSignals{SampleSize: 100, MatchRate: 0.80}, true},
+		{"high error rate", MODERN_ONLY, TransitionSignals{SampleSize: 100, MatchRate: 0.99, ErrorRate: 0.10}, true},
+		{"high latency", MODERN_ONLY, TransitionSignals{SampleSize: 100, MatchRate: 0.99, AverageLatency: time.Second}, true},
+		{"insufficient samples", MODERN_ONLY, TransitionSignals{SampleSize: 5, MatchRate: 0.10}, false},
+		{"not modern only", PARALLEL, TransitionSignals{SampleSize: 100, MatchRate: 0.10}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := newRollbackRule(tt.mode)
+
+			rollback, reason := rule.EvaluateRollback(tt.signals)
+			if rollback != tt.wantRollback {
+				t.Errorf("expected rollback=%v, got %v (%s)", tt.wantRollback, rollback, reason)
+			}
+		})
+	}
+}
+
+func TestOrchestrationRule_ApplyRollback(t *testing.T) {
+	tests := []struct {
+		name       string
+		targetMode APIMode
+		want       APIMode
+	}{
+		{"default target", "", PARALLEL},
+		{"legacy only target", LEGACY_ONLY, LEGACY_ONLY},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := time.Now()
+			rule := newRollbackRule(MODERN_ONLY)
+			rule.TransitionConfig.Rollback.TargetMode = tt.targetMode
+
+			rule.ApplyRollback(now)
+
+			if rule.CurrentMode != tt.want {
+				t.Errorf("expected %s, got %s", tt.want, rule.CurrentMode)
+			}
+			if rule.TransitionConfig.AutoTransitionEnabled {
+				t.Error("auto transition should be disabled after rollback")
+			}
+			if !rule.TransitionConfig.RampState.StageStartedAt.Equal(now) {
+				t.Errorf("expected observation window to restart at now, got %v", rule.TransitionConfig.RampState.StageStartedAt)
+			}
+		})
+	}
+}
+
+func TestRollbackConfig_IsValid(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  RollbackConfig
+		wantErr bool
+	}{
+		{"zero value", RollbackConfig{}, false},
+		{"legacy only target", RollbackConfig{TargetMode: LEGACY_ONLY, SampleRate: 0.5}, false},
+		{"canary target", RollbackConfig{TargetMode: CANARY}, true},
+		{"sample rate over 1", RollbackConfig{SampleRate: 1.5}, true},
+		{"negative error rate", RollbackConfig{MaxErrorRate: -0.1}, true},
+		{"negative window", RollbackConfig{Window: -time.Minute}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.IsValid()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error=%v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,355 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// segmentKind는 경로 패턴 세그먼트의 종류를 나타냅니다.
+type segmentKind int
+
+const (
+	segmentStatic   segmentKind = iota // 고정 문자열 (예: users)
+	segmentParam                       // 이름 있는 파라미터 (예: {id}), 한 세그먼트와 매칭
+	segmentWildcard                    // 중간의 * , 한 세그먼트와 매칭
+	segmentGlob                        // 부분 와일드카드 (예: user*), 한 세그먼트 내에서 매칭
+	segmentRest                        // 마지막의 * , 남은 세그먼트 1개 이상과 매칭 (기존 .* 동작 호환)
+	segmentCatchAll                    // ** , 세그먼트 0개 이상과 매칭
+)
+
+const (
+	restParam     = "*"  // 마지막 * 가 캡처한 나머지 경로의 파라미터 이름
+	catchAllParam = "**" // ** 가 캡처한 경로의 파라미터 이름
+)
+
+// pathSegment는 파싱된 경로 패턴의 한 세그먼트입니다.
+type pathSegment struct {
+	kind  segmentKind
+	value string // 고정 문자열, 파라미터 이름 또는 glob 패턴
+}
+
+// parsePathPattern은 경로 패턴을 세그먼트 목록으로 파싱합니다.
+//
+// 지원하는 문법:
+//   - /api/v1/users      : 고정 경로
+//   - /api/v1/users/{id} : 이름 있는 파라미터 (한 세그먼트)
+//   - /api/v1/*/orders   : 한 세그먼트 와일드카드
+//   - /api/v1/users/*    : 마지막 * 는 나머지 경로 전체와 매칭 (기존 동작 호환)
+//   - /api/**/orders     : 0개 이상의 세그먼트와 매칭
+//   - /api/v1/user*      : 세그먼트 내부 부분 와일드카드
+func parsePathPattern(pattern string) ([]pathSegment, error) {
+	if pattern == "" {
+		return nil, NewValidationError("PathPattern", "path pattern is required")
+	}
+
+	parts := splitPath(pattern)
+	segments := make([]pathSegment, 0, len(parts))
+	for i, part := range parts {
+		switch {
+		case part == "**":
+			segments = append(segments, pathSegment{kind: segmentCatchAll})
+		case part == "*" && i == len(parts)-1:
+			segments = append(segments, pathSegment{kind: segmentRest})
+		case part == "*":
+			segments = append(segments, pathSegment{kind: segmentWildcard})
+		case strings.HasPrefix(part, "{") || strings.HasSuffix(part, "}"):
+			if len(part) < 3 || !strings.HasPrefix(part, "{") || !strings.HasSuffix(part, "}") {
+				return nil, NewValidationError("PathPattern", fmt.Sprintf("invalid path parameter segment: %q", part))
+			}
+			name := part[1 : len(part)-1]
+			if strings.ContainsAny(name, "{}*") {
+				return nil, NewValidationError("PathPattern", fmt.Sprintf("invalid path parameter name: %q", name))
+			}
+			segments = append(segments, pathSegment{kind: segmentParam, value: name})
+		case strings.Contains(part, "*"):
+			segments = append(segments, pathSegment{kind: segmentGlob, value: part})
+		default:
+			segments = append(segments, pathSegment{kind: segmentStatic, value: part})
+		}
+	}
+
+	return segments, nil
+}
+
+// splitPath는 경로를 "/" 기준 세그먼트로 분리합니다.
+// 선행 "/"는 무시하므로 "/api/users"는 ["api", "users"]가 됩니다.
+func splitPath(path string) []string {
+	return strings.Split(strings.TrimPrefix(path, "/"), "/")
+}
+
+// matchSegments는 파싱된 패턴이 경로 세그먼트 전체와 매칭되는지 확인합니다.
+// params가 nil이 아니면 매칭에 성공한 경로의 파라미터 값을 기록합니다.
+// 마지막 * 는 "*", ** 는 "**" 이름으로 나머지 경로를 기록합니다.
+func matchSegments(pattern []pathSegment, parts []string, params map[string]string) bool {
+	if len(pattern) == 0 {
+		return len(parts) == 0
+	}
+
+	seg := pattern[0]
+	switch seg.kind {
+	case segmentCatchAll:
+		for k := 0; k <= len(parts); k++ {
+			if matchSegments(pattern[1:], parts[k:], params) {
+				if params != nil {
+					params[catchAllParam] = strings.Join(parts[:k], "/")
+				}
+				return true
+			}
+		}
+		return false
+	case segmentRest:
+		if len(parts) == 0 {
+			return false
+		}
+		if params != nil {
+			params[restParam] = strings.Join(parts, "/")
+		}
+		return true
+	}
+
+	if len(parts) == 0 {
+		return false
+	}
+
+	switch seg.kind {
+	case segmentStatic:
+		if seg.value != parts[0] {
+			return false
+		}
+	case segmentGlob:
+		if !matchGlob(seg.value, parts[0]) {
+			return false
+		}
+	}
+
+	if !matchSegments(pattern[1:], parts[1:], params) {
+		return false
+	}
+	if seg.kind == segmentParam && params != nil {
+		params[seg.value] = parts[0]
+	}
+	return true
+}
+
+// matchGlob은 * (임의 문자열)와 ? (임의 문자 하나)를 지원하는 단순 glob 패턴으로 s 전체를 매칭합니다.
+// 라우트 경로의 부분 와일드카드 세그먼트와 비교 경로 선택자의 키 glob에서 함께 사용합니다.
+func matchGlob(pattern, s string) bool {
+	p, t := []rune(pattern), []rune(s)
+	star, mark := -1, 0
+	pi, ti := 0, 0
+	for ti < len(t) {
+		switch {
+		case pi < len(p) && p[pi] == '*':
+			star, mark = pi, ti
+			pi++
+		case pi < len(p) && (p[pi] == '?' || p[pi] == t[ti]):
+			pi++
+			ti++
+		case star >= 0:
+			pi = star + 1
+			mark++
+			ti = mark
+		default:
+			return false
+		}
+	}
+	for pi < len(p) && p[pi] == '*' {
+		pi++
+	}
+	return pi == len(p)
+}
+
+// routeNode는 라우트 인덱스 트리의 노드입니다.
+type routeNode struct {
+	static   map[string]*routeNode // 고정 세그먼트 자식
+	param    *routeNode            // {param} 또는 중간 * 자식
+	globs    []*globChild          // 부분 와일드카드 자식
+	rest     *routeNode            // 마지막 * 자식
+	catchAll *routeNode            // ** 자식
+	rules    []*indexedRule        // 이 노드에서 끝나는 규칙
+}
+
+// indexedRule은 인덱스에 등록된 규칙과 미리 파싱된 경로 세그먼트, 헤더/쿼리 조건입니다.
+type indexedRule struct {
+	rule       *RoutingRule
+	segments   []pathSegment
+	predicates *rulePredicates
+}
+
+// globChild는 부분 와일드카드 세그먼트와 그 자식 노드입니다.
+type globChild struct {
+	pattern string
+	node    *routeNode
+}
+
+// RouteIndex는 라우팅 규칙을 경로 세그먼트 단위의 트리로 색인합니다.
+//
+// 요청 경로를 세그먼트 단위로 한 번만 순회하므로 규칙 수와 무관하게
+// 조회 비용이 경로 깊이에 비례합니다. 생성 후에는 변경되지 않으므로
+// 여러 고루틴에서 잠금 없이 동시에 조회할 수 있습니다.
+type RouteIndex struct {
+	root      *routeNode
+	rules     map[*RoutingRule]*indexedRule // 경로 파라미터 추출 시 파싱 결과 재사용
+	ruleCount int
+}
+
+// NewRouteIndex는 라우팅 규칙 목록으로 RouteIndex를 생성합니다.
+//
+// 비활성 규칙은 색인하지 않습니다. 경로 패턴이 잘못된 규칙은 건너뛰고
+// 나머지 규칙으로 인덱스를 구성하며, 건너뛴 규칙은 error로 함께 반환합니다.
+func NewRouteIndex(rules []*RoutingRule) (*RouteIndex, error) {
+	index := &RouteIndex{
+		root:  newRouteNode(),
+		rules: make(map[*RoutingRule]*indexedRule, len(rules)),
+	}
+
+	var errs []error
+	for _, rule := range rules {
+		if rule == nil || !rule.IsActive {
+			continue
+		}
+
+		segments, err := parsePathPattern(rule.PathPattern)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("routing rule %s: %w", rule.ID, err))
+			continue
+		}
+
+		predicates, err := compilePredicates(rule)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("routing rule %s: %w", rule.ID, err))
+			continue
+		}
+
+		entry := &indexedRule{rule: rule, segments: segments, predicates: predicates}
+		index.insert(segments, entry)
+		index.rules[rule] = entry
+		index.ruleCount++
+	}
+
+	return index, errors.Join(errs...)
+}
+
+// Size는 인덱스에 등록된 규칙 수를 반환합니다.
+func (idx *RouteIndex) Size() int {
+	return idx.ruleCount
+}
+
+// Match는 요청의 경로, 메서드, 헤더/쿼리 파라미터 조건에 매칭되는 모든 규칙을 반환합니다.
+func (idx *RouteIndex) Match(request *Request) []*RoutingRule {
+	var candidates []*indexedRule
+	idx.root.collect(splitPath(request.Path), &candidates)
+
+	matched := make([]*RoutingRule, 0, len(candidates))
+	seen := make(map[*indexedRule]struct{}, len(candidates))
+	for _, entry := range candidates {
+		if _, dup := seen[entry]; dup {
+			continue
+		}
+		seen[entry] = struct{}{}
+
+		if entry.rule.matchesMethod(request.Method) && entry.predicates.matches(request) {
+			matched = append(matched, entry.rule)
+		}
+	}
+
+	return matched
+}
+
+// ExtractPathParams는 요청 경로에서 규칙의 경로 파라미터 값을 추출합니다.
+//
+// 인덱스에 등록된 규칙은 미리 파싱한 세그먼트를 재사용하고, 등록되지 않은 규칙
+// (기본 라우팅 규칙 등)은 RoutingRule.ExtractPathParams로 처리합니다.
+func (idx *RouteIndex) ExtractPathParams(rule *RoutingRule, path string) (map[string]string, bool) {
+	entry, ok := idx.rules[rule]
+	if !ok {
+		return rule.ExtractPathParams(path)
+	}
+
+	params := make(map[string]string)
+	if !matchSegments(entry.segments, splitPath(path), params) {
+		return nil, false
+	}
+	return params, true
+}
+
+// insert는 파싱된 세그먼트 경로에 규칙을 추가합니다.
+func (idx *RouteIndex) insert(segments []pathSegment, rule *indexedRule) {
+	node := idx.root
+	for _, seg := range segments {
+		node = node.child(seg)
+	}
+	node.rules = append(node.rules, rule)
+}
+
+// newRouteNode는 빈 트리 노드를 생성합니다.
+func newRouteNode() *routeNode {
+	return &routeNode{static: make(map[string]*routeNode)}
+}
+
+// child는 세그먼트에 해당하는 자식 노드를 반환하며, 없으면 생성합니다.
+func (n *routeNode) child(seg pathSegment) *routeNode {
+	switch seg.kind {
+	case segmentParam, segmentWildcard:
+		if n.param == nil {
+			n.param = newRouteNode()
+		}
+		return n.param
+	case segmentGlob:
+		for _, g := range n.globs {
+			if g.pattern == seg.value {
+				return g.node
+			}
+		}
+		g := &globChild{pattern: seg.value, node: newRouteNode()}
+		n.globs = append(n.globs, g)
+		return g.node
+	case segmentRest:
+		if n.rest == nil {
+			n.rest = newRouteNode()
+		}
+		return n.rest
+	case segmentCatchAll:
+		if n.catchAll == nil {
+			n.catchAll = newRouteNode()
+		}
+		return n.catchAll
+	default:
+		next, ok := n.static[seg.value]
+		if !ok {
+			next = newRouteNode()
+			n.static[seg.value] = next
+		}
+		return next
+	}
+}
+
+// collect는 남은 경로 세그먼트와 매칭되는 모든 규칙을 out에 추가합니다.
+func (n *routeNode) collect(parts []string, out *[]*indexedRule) {
+	if n.catchAll != nil {
+		for k := 0; k <= len(parts); k++ {
+			n.catchAll.collect(parts[k:], out)
+		}
+	}
+
+	if len(parts) == 0 {
+		*out = append(*out, n.rules...)
+		return
+	}
+
+	if n.rest != nil {
+		*out = append(*out, n.rest.rules...)
+	}
+	if next, ok := n.static[parts[0]]; ok {
+		next.collect(parts[1:], out)
+	}
+	if n.param != nil {
+		n.param.collect(parts[1:], out)
+	}
+	for _, g := range n.globs {
+		if matchGlob(g.pattern, parts[0]) {
+			g.node.collect(parts[1:], out)
+		}
+	}
+}
@@ -0,0 +1,318 @@
+package domain
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestRoutingRule_Matches_PathPatterns(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		path    string
+		want    bool
+	}{
+		{"exact match", "/api/v1/users", "/api/v1/users", true},
+		{"exact mismatch", "/api/v1/users", "/api/v1/orders", false},
+		{"trailing star matches rest", "/api/v1/users/*", "/api/v1/users/1/orders", true},
+		{"trailing star needs a segment", "/api/v1/users/*", "/api/v1/users", false},
+		{"trailing star matches empty segment", "/api/v1/users/*", "/api/v1/users/", true},
+		{"middle star matches one segment", "/api/*/users", "/api/v1/users", true},
+		{"middle star does not span segments", "/api/*/users", "/api/v1/x/users", false},
+		{"param matches one segment", "/api/v1/users/{id}", "/api/v1/users/42", true},
+		{"param does not span segments", "/api/v1/users/{id}", "/api/v1/users/42/orders", false},
+		{"double star matches zero segments", "/api/**/orders", "/api/orders", true},
+		{"double star matches many segments", "/api/**/orders", "/api/v1/users/1/orders", true},
+		{"glob segment", "/api/v1/user*", "/api/v1/users", true},
+		{"glob segment mismatch", "/api/v1/user*", "/api/v1/orders", false},
+		{"root", "/", "/", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := &RoutingRule{ID: "r", PathPattern: tt.pattern, MethodPattern: "*", IsActive: true}
+			got, err := rule.Matches(NewRequest("id", "GET", tt.path))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("pattern %s path %s: expected %v, got %v", tt.pattern, tt.path, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRoutingRule_Matches_MethodFallback(t *testing.T) {
+	rule := &RoutingRule{ID: "r", PathPattern: "/api/users", Method: "POST", IsActive: true}
+
+	if ok, _ := rule.Matches(NewRequest("id", "POST", "/api/users")); !ok {
+		t.Error("rule with Method POST should match POST request")
+	}
+	if ok, _ := rule.Matches(NewRequest("id", "GET", "/api/users")); ok {
+		t.Error("rule with Method POST should not match GET request")
+	}
+}
+
+func TestRoutingRule_IsValid_InvalidPattern(t *testing.T) {
+	rule := &RoutingRule{ID: "r", PathPattern: "/api/users/{id", EndpointID: "e"}
+	if err := rule.IsValid(); err == nil {
+		t.Error("expected validation error for unclosed path parameter")
+	}
+}
+
+func TestMatchGlob(t *testing.T) {
+	tests := []struct {
+		pattern string
+		s       string
+		want    bool
+	}{
+		{"user*", "users", true},
+		{"user*", "user", true},
+		{"*-v2", "orders-v2", true},
+		{"*-v2", "orders-v3", false},
+		{"a*b*c", "aXXbYYc", true},
+		{"*At", "updatedAt", true},
+		{"*At", "updatedAtX", false},
+		{"v?", "v1", true},
+		{"v?", "v12", false},
+		{"이름?", "이름들", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+"/"+tt.s, func(t *testing.T) {
+			if got := matchGlob(tt.pattern, tt.s); got != tt.want {
+				t.Errorf("matchGlob(%q, %q) = %v, want %v", tt.pattern, tt.s, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRouteIndex_Match(t *testing.T) {
+	rules := []*RoutingRule{
+		{ID: "users-exact", PathPattern: "/api/v1/users", MethodPattern: "GET", IsActive: true},
+		{ID: "users-param", PathPattern: "/api/v1/users/{id}", MethodPattern: "*", IsActive: true},
+		{ID: "users-rest", PathPattern: "/api/v1/users/*", MethodPattern: "*", IsActive: true},
+		{ID: "orders-any", PathPattern: "/api/**/orders", MethodPattern: "*", IsActive: true},
+		{ID: "inactive", PathPattern: "/api/v1/users", MethodPattern: "*", IsActive: false},
+	}
+
+	index, err := NewRouteIndex(rules)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if index.Size() != 4 {
+		t.Errorf("expected 4 indexed rules, got %d", index.Size())
+	}
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		want   []string
+	}{
+		{"exact", "GET", "/api/v1/users", []string{"users-exact"}},
+		{"method filtered", "POST", "/api/v1/users", nil},
+		{"param and rest", "GET", "/api/v1/users/42", []string{"users-param", "users-rest"}},
+		{"rest only", "GET", "/api/v1/users/42/profile", []string{"users-rest"}},
+		{"double star with rest", "GET", "/api/v1/users/42/orders", []string{"users-rest", "orders-any"}},
+		{"no match", "GET", "/health", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matched := index.Match(NewRequest("id", tt.method, tt.path))
+			got := make(map[string]bool)
+			for _, rule := range matched {
+				got[rule.ID] = true
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, got)
+			}
+			for _, id := range tt.want {
+				if !got[id] {
+					t.Errorf("expected rule %s in %v", id, got)
+				}
+			}
+		})
+	}
+}
+
+func TestRouteIndex_AgreesWithMatches(t *testing.T) {
+	patterns := []string{
+		"/api/v1/users", "/api/v1/users/*", "/api/*/users", "/api/v1/users/{id}",
+		"/api/**", "/api/**/orders", "/api/v1/user*", "/**/health",
+	}
+	paths := []string{
+		"/api/v1/users", "/api/v1/users/1", "/api/v2/users", "/api/orders",
+		"/api/v1/users/1/orders", "/health", "/internal/health", "/api/v1/userinfo",
+	}
+
+	for _, pattern := range patterns {
+		rule := &RoutingRule{ID: pattern, PathPattern: pattern, MethodPattern: "*", IsActive: true}
+		index, err := NewRouteIndex([]*RoutingRule{rule})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		for _, path := range paths {
+			request := NewRequest("id", "GET", path)
+			want, _ := rule.Matches(request)
+			got := len(index.Match(request)) == 1
+			if got != want {
+				t.Errorf("pattern %s path %s: index=%v matches=%v", pattern, path, got, want)
+			}
+		}
+	}
+}
+
+func TestRouteIndex_SkipsInvalidRules(t *testing.T) {
+	rules := []*RoutingRule{
+		{ID: "valid", PathPattern: "/api/users", MethodPattern: "*", IsActive: true},
+		{ID: "invalid", PathPattern: "/api/{", MethodPattern: "*", IsActive: true},
+	}
+
+	index, err := NewRouteIndex(rules)
+	if err == nil {
+		t.Error("expected error describing skipped rule")
+	}
+	if index.Size() != 1 {
+		t.Errorf("expected 1 indexed rule, got %d", index.Size())
+	}
+}
+
+func TestRoutingRule_ExtractPathParams(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		path    string
+		want    map[string]string
+		ok      bool
+	}{
+		{"named params", "/api/v1/users/{id}/orders/{orderId}", "/api/v1/users/42/orders/7", map[string]string{"id": "42", "orderId": "7"}, true},
+		{"param with rest", "/api/v1/users/{id}/orders/*", "/api/v1/users/42/orders/7/items", map[string]string{"id": "42", "*": "7/items"}, true},
+		{"catch all", "/api/**/orders", "/api/v1/users/orders", map[string]string{"**": "v1/users"}, true},
+		{"no params", "/api/v1/users", "/api/v1/users", map[string]string{}, true},
+		{"no match", "/api/v1/users/{id}", "/api/v1/orders/1", nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := &RoutingRule{PathPattern: tt.pattern}
+			got, ok := rule.ExtractPathParams(tt.path)
+			if ok != tt.ok {
+				t.Fatalf("expected ok=%v, got %v", tt.ok, ok)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, got)
+			}
+			for k, v := range tt.want {
+				if got[k] != v {
+					t.Errorf("param %s: expected %q, got %q", k, v, got[k])
+				}
+			}
+		})
+	}
+}
+
+func TestRouteIndex_ExtractPathParams(t *testing.T) {
+	indexed := &RoutingRule{ID: "users", PathPattern: "/api/v1/users/{id}/orders/*", MethodPattern: "*", IsActive: true}
+	index, err := NewRouteIndex([]*RoutingRule{indexed})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	params, ok := index.ExtractPathParams(indexed, "/api/v1/users/42/orders/7/items")
+	if !ok || params["id"] != "42" || params["*"] != "7/items" {
+		t.Errorf("indexed rule: unexpected params %v (ok=%v)", params, ok)
+	}
+
+	if _, ok := index.ExtractPathParams(indexed, "/api/v1/orders/1"); ok {
+		t.Error("indexed rule: expected no match")
+	}
+
+	// 인덱스에 없는 규칙(기본 라우팅 규칙 등)은 규칙 자체의 패턴으로 추출합니다.
+	unindexed := &RoutingRule{ID: "default", PathPattern: "/api/v1/items/{id}", MethodPattern: "*", IsActive: true}
+	params, ok = index.ExtractPathParams(unindexed, "/api/v1/items/9")
+	if !ok || params["id"] != "9" {
+		t.Errorf("unindexed rule: unexpected params %v (ok=%v)", params, ok)
+	}
+}
+
+func TestRoutingRule_RewritePath(t *testing.T) {
+	rule := &RoutingRule{
+		PathPattern:     "/api/v1/users/{id}/orders/*",
+		RewriteTemplate: "/v2/customers/{id}/orders/{*}",
+	}
+
+	params, ok := rule.ExtractPathParams("/api/v1/users/42/orders/7/items")
+	if !ok {
+		t.Fatal("expected path to match")
+	}
+	if got := rule.RewritePath(params); got != "/v2/customers/42/orders/7/items" {
+		t.Errorf("unexpected rewritten path: %s", got)
+	}
+
+	rule.RewriteTemplate = ""
+	if got := rule.RewritePath(params); got != "" {
+		t.Errorf("expected empty path without template, got %s", got)
+	}
+}
+
+func TestRoutingRule_IsValid_RewriteTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		wantErr  bool
+	}{
+		{"empty template", "", false},
+		{"known params", "/v2/customers/{id}/orders/{*}", false},
+		{"unknown param", "/v2/customers/{userId}", true},
+		{"unclosed brace", "/v2/customers/{id", true},
+		{"relative path", "v2/customers/{id}", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := &RoutingRule{
+				ID:              "r",
+				PathPattern:     "/api/v1/users/{id}/orders/*",
+				RewriteTemplate: tt.template,
+				EndpointID:      "e",
+			}
+			err := rule.IsValid()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error=%v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestRequest_TargetPath(t *testing.T) {
+	req := NewRequest("id", "GET", "/api/v1/users/42")
+	if req.TargetPath() != "/api/v1/users/42" {
+		t.Errorf("expected original path, got %s", req.TargetPath())
+	}
+
+	req.UpstreamPath = "/v2/customers/42"
+	if req.TargetPath() != "/v2/customers/42" {
+		t.Errorf("expected upstream path, got %s", req.TargetPath())
+	}
+}
+
+func BenchmarkRouteIndex_Match(b *testing.B) {
+	rules := make([]*RoutingRule, 0, 3000)
+	for i := 0; i < 3000; i++ {
+		rules = append(rules, &RoutingRule{
+			ID:            fmt.Sprintf("rule-%d", i),
+			PathPattern:   fmt.Sprintf("/legacy/service%d/resource/{id}/*", i),
+			MethodPattern: "*",
+			IsActive:      true,
+		})
+	}
+	index, _ := NewRouteIndex(rules)
+	request := NewRequest("id", "GET", "/legacy/service2999/resource/42/detail")
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		index.Match(request)
+	}
+}
@@ -0,0 +1,176 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// predicateOp는 헤더/쿼리 파라미터 조건의 비교 방식입니다.
+type predicateOp int
+
+const (
+	predicateExact   predicateOp = iota // 값이 정확히 일치
+	predicatePresent                    // 키가 존재
+	predicateRegex                      // 값이 정규식과 매칭
+)
+
+// 조건 종류별 구체성 가중치 (selectHighestPriorityRule의 동순위 비교에 사용)
+const (
+	exactPredicateWeight   = 3
+	regexPredicateWeight   = 2
+	presentPredicateWeight = 1
+)
+
+// matchPredicate는 RoutingRule.Headers / QueryParams의 한 조건입니다.
+//
+// 값 문법:
+//   - "mobile"   : 값이 정확히 mobile
+//   - "*"        : 키가 존재 (값 무관)
+//   - "~^v[23]$" : 값이 정규식과 매칭
+//   - "!" 접두사 : 조건 부정 (예: "!mobile", "!*"는 키가 없어야 함)
+//   - "=" 접두사 : 이후 문자열을 그대로 정확히 비교 (예: "=*"는 값이 * 인 경우)
+type matchPredicate struct {
+	key    string
+	op     predicateOp
+	value  string
+	regex  *regexp.Regexp
+	negate bool
+}
+
+// parsePredicate는 조건 표현식을 파싱하고 정규식을 컴파일합니다.
+func parsePredicate(key, expr string) (matchPredicate, error) {
+	p := parsePredicateSyntax(key, expr)
+	if p.op == predicateRegex {
+		re, err := regexp.Compile(p.value)
+		if err != nil {
+			return p, fmt.Errorf("invalid regex for %q: %w", key, err)
+		}
+		p.regex = re
+	}
+	return p, nil
+}
+
+// parsePredicateSyntax는 정규식 컴파일 없이 조건 표현식의 종류만 해석합니다.
+// 정규식 조건의 패턴 문자열은 value에 담깁니다.
+func parsePredicateSyntax(key, expr string) matchPredicate {
+	p := matchPredicate{key: key}
+
+	if strings.HasPrefix(expr, "!") {
+		p.negate = true
+		expr = expr[1:]
+	}
+
+	switch {
+	case strings.HasPrefix(expr, "="):
+		p.op = predicateExact
+		p.value = expr[1:]
+	case expr == "*":
+		p.op = predicatePresent
+	case strings.HasPrefix(expr, "~"):
+		p.op = predicateRegex
+		p.value = expr[1:]
+	default:
+		p.op = predicateExact
+		p.value = expr
+	}
+
+	return p
+}
+
+// matches는 조회된 값 목록이 조건을 만족하는지 확인합니다.
+// 값이 여러 개면 하나라도 만족할 때 일치로 보며, 부정 조건은 어떤 값도 만족하지 않아야 일치합니다.
+func (p matchPredicate) matches(values []string) bool {
+	var ok bool
+	switch p.op {
+	case predicatePresent:
+		ok = len(values) > 0
+	default:
+		for _, value := range values {
+			if p.matchesValue(value) {
+				ok = true
+				break
+			}
+		}
+	}
+
+	if p.negate {
+		return !ok
+	}
+	return ok
+}
+
+// matchesValue는 값 하나가 정확히 일치 / 정규식 조건을 만족하는지 확인합니다.
+func (p matchPredicate) matchesValue(value string) bool {
+	if p.op == predicateRegex {
+		return p.regex.MatchString(value)
+	}
+	return value == p.value
+}
+
+// weight는 조건의 구체성 가중치를 반환합니다. 부정 조건은 존재 조건과 같은 가중치입니다.
+func (p matchPredicate) weight() int {
+	if p.negate {
+		return presentPredicateWeight
+	}
+	switch p.op {
+	case predicateExact:
+		return exactPredicateWeight
+	case predicateRegex:
+		return regexPredicateWeight
+	default:
+		return presentPredicateWeight
+	}
+}
+
+// rulePredicates는 라우팅 규칙의 컴파일된 헤더/쿼리 파라미터 조건입니다.
+type rulePredicates struct {
+	headers []matchPredicate
+	query   []matchPredicate
+}
+
+// compilePredicates는 규칙의 Headers / QueryParams를 조건 목록으로 컴파일합니다.
+func compilePredicates(rule *RoutingRule) (*rulePredicates, error) {
+	compiled := &rulePredicates{}
+
+	for key, expr := range rule.Headers {
+		p, err := parsePredicate(key, expr)
+		if err != nil {
+			return nil, NewValidationError("Headers", err.Error())
+		}
+		compiled.headers = append(compiled.headers, p)
+	}
+	for key, expr := range rule.QueryParams {
+		p, err := parsePredicate(key, expr)
+		if err != nil {
+			return nil, NewValidationError("QueryParams", err.Error())
+		}
+		compiled.query = append(compiled.query, p)
+	}
+
+	return compiled, nil
+}
+
+// matches는 요청이 모든 헤더/쿼리 파라미터 조건을 만족하는지 확인합니다.
+func (rp *rulePredicates) matches(request *Request) bool {
+	for _, p := range rp.headers {
+		if !p.matches(headerValues(request.Headers, p.key)) {
+			return false
+		}
+	}
+	for _, p := range rp.query {
+		if !p.matches(request.QueryParams[p.key]) {
+			return false
+		}
+	}
+	return true
+}
+
+// predicateSpecificity는 헤더/쿼리 파라미터 조건 표현식들의 가중치 합을 반환합니다.
+func predicateSpecificity(exprs map[string]string) int {
+	total := 0
+	for key, expr := range exprs {
+		total += parsePredicateSyntax(key, expr).weight()
+	}
+	return total
+}
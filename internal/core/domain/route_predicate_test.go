@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"testing"
+)
+
+func TestRoutingRule_Matches_HeaderAndQueryPredicates(t *testing.T) {
+	tests := []struct {
+		name        string
+		headers     map[string]string
+		queryParams map[string]string
+		reqHeaders  map[string]string
+		reqQuery    map[string]string
+		want        bool
+	}{
+		{"exact header", map[string]string{"X-Client": "mobile"}, nil, map[string]string{"X-Client": "mobile"}, nil, true},
+		{"exact header mismatch", map[string]string{"X-Client": "mobile"}, nil, map[string]string{"X-Client": "web"}, nil, false},
+		{"header name is case-insensitive", map[string]string{"x-client": "mobile"}, nil, map[string]string{"X-Client": "mobile"}, nil, true},
+		{"presence", map[string]string{"Authorization": "*"}, nil, map[string]string{"Authorization": "Bearer t"}, nil, true},
+		{"presence missing", map[string]string{"Authorization": "*"}, nil, map[string]string{}, nil, false},
+		{"regex", nil, map[string]string{"version": "~^[23]$"}, nil, map[string]string{"version": "2"}, true},
+		{"regex mismatch", nil, map[string]string{"version": "~^[23]$"}, nil, map[string]string{"version": "1"}, false},
+		{"negated exact", map[string]string{"X-Client": "!mobile"}, nil, map[string]string{"X-Client": "web"}, nil, true},
+		{"negated exact on missing key", map[string]string{"X-Client": "!mobile"}, nil, map[string]string{}, nil, true},
+		{"negated exact mismatch", map[string]string{"X-Client": "!mobile"}, nil, map[string]string{"X-Client": "mobile"}, nil, false},
+		{"absence", nil, map[string]string{"debug": "!*"}, nil, map[string]string{"debug": "1"}, false},
+		{"negated regex", nil, map[string]string{"version": "!~^1"}, nil, map[string]string{"version": "2"}, true},
+		{"escaped literal", map[string]string{"X-Mode": "=*"}, nil, map[string]string{"X-Mode": "*"}, nil, true},
+		{"all predicates must match", map[string]string{"X-Client": "mobile"}, map[string]string{"version": "2"}, map[string]string{"X-Client": "mobile"}, map[string]string{"version": "1"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := &RoutingRule{
+				ID:            "r",
+				PathPattern:   "/api/users",
+				MethodPattern: "*",
+				IsActive:      true,
+				Headers:       tt.headers,
+				QueryParams:   tt.queryParams,
+			}
+			req := NewRequest("id", "GET", "/api/users")
+			for k, v := range tt.reqHeaders {
+				req.SetHeader(k, v)
+			}
+			for k, v := range tt.reqQuery {
+				req.SetQueryParam(k, v)
+			}
+
+			got, err := rule.Matches(req)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+
+			index, _ := NewRouteIndex([]*RoutingRule{rule})
+			if indexed := len(index.Match(req)) == 1; indexed != tt.want {
+				t.Errorf("route index: expected %v, got %v", tt.want, indexed)
+			}
+		})
+	}
+}
+
+func TestRoutingRule_Specificity(t *testing.T) {
+	tests := []struct {
+		name        string
+		headers     map[string]string
+		queryParams map[string]string
+		want        int
+	}{
+		{"no predicates", nil, nil, 0},
+		{"exact", map[string]string{"X-Client": "mobile"}, nil, 3},
+		{"regex", nil, map[string]string{"version": "~^2"}, 2},
+		{"presence and negation", map[string]string{"Authorization": "*"}, map[string]string{"debug": "!1"}, 2},
+		{"exact header and query", map[string]string{"X-Client": "mobile"}, map[string]string{"version": "2"}, 6},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := &RoutingRule{Headers: tt.headers, QueryParams: tt.queryParams}
+			if got := rule.Specificity(); got != tt.want {
+				t.Errorf("expected %d, got %d", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRoutingRule_IsValid_InvalidPredicate(t *testing.T) {
+	rule := &RoutingRule{
+		ID:          "r",
+		PathPattern: "/api/users",
+		EndpointID:  "e",
+		Headers:     map[string]string{"X-Client": "~(["},
+	}
+	if err := rule.IsValid(); err == nil {
+		t.Error("expected validation error for invalid regex predicate")
+	}
+}
@@ -1,7 +1,8 @@
 package domain
 
 import (
-	"regexp"
+	"fmt"
+	"strings"
 	"time"
 )
 
@@ -9,11 +10,12 @@ import (
 type RoutingRule struct {
 	ID               string            // 규칙 고유 ID
 	Name             string            // 규칙 이름
-	PathPattern      string            // 경로 패턴 (예: /api/v1/users/*)
+	PathPattern      string            // 경로 패턴 (예: /api/v1/users/{id}/orders/*)
+	RewriteTemplate  string            // 업스트림 경로 템플릿 (예: /v2/customers/{id}/orders/{*}), 비어 있으면 요청 경로 그대로 사용
 	MethodPattern    string            // HTTP 메서드 패턴 (예: GET, POST, *)
 	Method           string            // HTTP 메서드
-	Headers          map[string]string // 헤더 매칭
-	QueryParams      map[string]string // 쿼리 파라미터 매칭
+	Headers          map[string]string // 헤더 매칭 조건 (값 문법: 정확값, "*" 존재, "~정규식", "!" 부정)
+	QueryParams      map[string]string // 쿼리 파라미터 매칭 조건 (Headers와 동일한 문법)
 	EndpointID       string            // 대상 엔드포인트 ID
 	LegacyEndpointID string            // 레거시 엔드포인트 ID
 	ModernEndpointID string            // 모던 엔드포인트 ID
@@ -21,10 +23,10 @@ type RoutingRule struct {
 	IsActive         bool              // 활성화 여부
 	CacheEnabled     bool              // 캐시 사용 여부
 	CacheTTL         int               // 캐시 TTL (초)
+	MirrorPolicy     MirrorPolicy      // 쓰기 메서드 미러링 안전 정책
 	Description      string            // 설명
 	CreatedAt        time.Time         // 생성 시간
 	UpdatedAt        time.Time         // 수정 시간
-	compiledRegex    *regexp.Regexp    // 컴파일된 정규식 (private)
 }
 
 // NewRoutingRule은 새로운 RoutingRule을 생성합니다.
@@ -49,21 +51,91 @@ func (r *RoutingRule) Matches(request *Request) (bool, error) {
 	}
 
 	// 메서드 매칭
-	if r.MethodPattern != "*" && r.MethodPattern != request.Method {
+	if !r.matchesMethod(request.Method) {
 		return false, nil
 	}
 
 	// 경로 패턴 매칭
-	if r.compiledRegex == nil {
-		pattern := convertPatternToRegex(r.PathPattern)
-		var err error
-		r.compiledRegex, err = regexp.Compile(pattern)
-		if err != nil {
-			return false, NewDomainError("REGEX_COMPILE_ERROR", "failed to compile path pattern", err)
+	segments, err := parsePathPattern(r.PathPattern)
+	if err != nil {
+		return false, NewDomainError("INVALID_PATH_PATTERN", "failed to parse path pattern", err)
+	}
+
+	if !matchSegments(segments, splitPath(request.Path), nil) {
+		return false, nil
+	}
+
+	// 헤더/쿼리 파라미터 조건 매칭
+	predicates, err := compilePredicates(r)
+	if err != nil {
+		return false, NewDomainError("INVALID_MATCH_PREDICATE", "failed to compile header/query predicates", err)
+	}
+
+	return predicates.matches(request), nil
+}
+
+// Specificity는 헤더/쿼리 파라미터 조건의 구체성 점수를 반환합니다.
+// 우선순위가 같은 규칙 중에서는 점수가 높은(조건이 더 구체적인) 규칙이 선택됩니다.
+// 정확값 조건은 3, 정규식은 2, 존재/부정 조건은 1점입니다.
+func (r *RoutingRule) Specificity() int {
+	return predicateSpecificity(r.Headers) + predicateSpecificity(r.QueryParams)
+}
+
+// ExtractPathParams는 요청 경로에서 경로 패턴의 파라미터 값을 추출합니다.
+// 경로가 패턴과 매칭되지 않으면 false를 반환합니다.
+//
+// 예: 패턴 /api/v1/users/{id}/orders/* 와 경로 /api/v1/users/42/orders/7/items
+//
+//	-> {"id": "42", "*": "7/items"}
+func (r *RoutingRule) ExtractPathParams(path string) (map[string]string, bool) {
+	segments, err := parsePathPattern(r.PathPattern)
+	if err != nil {
+		return nil, false
+	}
+
+	params := make(map[string]string)
+	if !matchSegments(segments, splitPath(path), params) {
+		return nil, false
+	}
+	return params, true
+}
+
+// RewritePath는 RewriteTemplate의 {name} 자리에 파라미터 값을 채워 업스트림 경로를 생성합니다.
+// RewriteTemplate이 비어 있으면 빈 문자열을 반환합니다.
+func (r *RoutingRule) RewritePath(params map[string]string) string {
+	if r.RewriteTemplate == "" {
+		return ""
+	}
+
+	var b strings.Builder
+	template := r.RewriteTemplate
+	for {
+		open := strings.IndexByte(template, '{')
+		if open < 0 {
+			b.WriteString(template)
+			break
 		}
+		end := strings.IndexByte(template[open:], '}')
+		if end < 0 {
+			b.WriteString(template)
+			break
+		}
+		b.WriteString(template[:open])
+		b.WriteString(params[template[open+1:open+end]])
+		template = template[open+end+1:]
 	}
 
-	return r.compiledRegex.MatchString(request.Path), nil
+	return b.String()
+}
+
+// matchesMethod는 요청 메서드가 규칙의 메서드 패턴과 일치하는지 확인합니다.
+// MethodPattern이 비어 있으면 Method 필드를 사용합니다 (DB 저장 규칙 호환).
+func (r *RoutingRule) matchesMethod(method string) bool {
+	pattern := r.MethodPattern
+	if pattern == "" {
+		pattern = r.Method
+	}
+	return pattern == "*" || pattern == method
 }
 
 // IsValid는 라우팅 규칙이 유효한지 검증합니다.
@@ -71,22 +143,63 @@ func (r *RoutingRule) IsValid() error {
 	if r.ID == "" {
 		return NewValidationError("ID", "routing rule ID is required")
 	}
-	if r.PathPattern == "" {
-		return NewValidationError("PathPattern", "path pattern is required")
+	segments, err := parsePathPattern(r.PathPattern)
+	if err != nil {
+		return err
+	}
+	if err := validateRewriteTemplate(r.RewriteTemplate, segments); err != nil {
+		return err
+	}
+	if _, err := compilePredicates(r); err != nil {
+		return err
 	}
 	if r.EndpointID == "" {
 		return NewValidationError("EndpointID", "endpoint ID is required")
 	}
+	if err := r.MirrorPolicy.validate(); err != nil {
+		return err
+	}
 	return nil
 }
 
-// convertPatternToRegex는 간단한 패턴을 정규식으로 변환합니다.
-// 예: /api/v1/users/* -> ^/api/v1/users/.*$
-func convertPatternToRegex(pattern string) string {
-	// 특수문자 이스케이프
-	regex := regexp.QuoteMeta(pattern)
-	// * 를 .* 로 변환
-	regex = regexp.MustCompile(`\\\*`).ReplaceAllString(regex, ".*")
-	// 시작과 끝 앵커 추가
-	return "^" + regex + "$"
+// validateRewriteTemplate은 재작성 템플릿이 경로 패턴에서 캡처하는 파라미터만 참조하는지 검증합니다.
+func validateRewriteTemplate(template string, segments []pathSegment) error {
+	if template == "" {
+		return nil
+	}
+	if !strings.HasPrefix(template, "/") {
+		return NewValidationError("RewriteTemplate", "rewrite template must start with '/'")
+	}
+
+	captured := make(map[string]bool)
+	for _, seg := range segments {
+		switch seg.kind {
+		case segmentParam:
+			captured[seg.value] = true
+		case segmentRest:
+			captured[restParam] = true
+		case segmentCatchAll:
+			captured[catchAllParam] = true
+		}
+	}
+
+	rest := template
+	for {
+		open := strings.IndexByte(rest, '{')
+		if open < 0 {
+			if strings.IndexByte(rest, '}') >= 0 {
+				return NewValidationError("RewriteTemplate", "unbalanced '}' in rewrite template")
+			}
+			return nil
+		}
+		end := strings.IndexByte(rest[open:], '}')
+		if end < 0 {
+			return NewValidationError("RewriteTemplate", "unclosed '{' in rewrite template")
+		}
+		name := rest[open+1 : open+end]
+		if !captured[name] {
+			return NewValidationError("RewriteTemplate", fmt.Sprintf("rewrite template references unknown path parameter: %q", name))
+		}
+		rest = rest[open+end+1:]
+	}
 }
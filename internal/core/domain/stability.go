@@ -0,0 +1,221 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// 안정성 평가 기본값
+const (
+	DefaultStabilityPeriod  = 24 * time.Hour // TransitionConfig.StabilityPeriod가 0일 때의 안정성 확인 기간
+	DefaultStabilityWindows = 4              // 안정성 확인 기간을 나누는 기본 구간 수
+	DefaultConfidenceLevel  = 0.95           // 통과율 하한(Wilson) 계산의 기본 신뢰 수준
+)
+
+// StabilityWindow는 안정성 확인 기간을 나눈 구간 하나의 비교 통계와 판정입니다.
+type StabilityWindow struct {
+	Start             time.Time // 구간 시작 시간
+	End               time.Time // 구간 종료 시간
+	SampleSize        int       // 비교 샘플 수
+	SuccessfulMatches int       // 성공한 비교 수
+	MatchRate         float64   // 평균 일치율
+	Passed            bool      // 구간 기준 충족 여부
+	Reason            string    // 판정 근거
+}
+
+// TransitionEvaluation은 PARALLEL / SHADOW → MODERN_ONLY 자동 전환 평가 결과입니다.
+//
+// 안정성 확인 기간(StabilityPeriod)을 StabilityWindows개 구간으로 나누어
+//   - 모든 구간에서 최소 샘플 수(MinSamplesPerWindow)와 평균 일치율(MatchRateThreshold)을 충족하고
+//   - 기간 전체 샘플 수가 MinRequestsForTransition 이상이며
+//   - 비교 통과율의 Wilson 신뢰 하한이 MatchRateThreshold 이상일 때
+//
+// 전환 가능으로 판정합니다. 최근 N건 평균과 달리 오래된 비교 결과나 짧은 구간의 일시적인 호조로는 전환되지 않습니다.
+type TransitionEvaluation struct {
+	RuleID              string            // 오케스트레이션 규칙 ID
+	CurrentMode         APIMode           // 평가 시점의 모드
+	EvaluatedAt         time.Time         // 평가 시점
+	From                time.Time         // 안정성 확인 기간 시작
+	To                  time.Time         // 안정성 확인 기간 종료
+	Windows             []StabilityWindow // 구간별 통계 (오래된 구간부터)
+	MinSamplesPerWindow int               // 구간별 최소 샘플 수
+	SampleSize          int               // 기간 전체 비교 샘플 수
+	SuccessfulMatches   int               // 기간 전체 성공한 비교 수
+	MatchRate           float64           // 기간 전체 평균 일치율
+	PassRate            float64           // 비교 통과율 (성공한 비교 / 전체 비교)
+	PassRateLowerBound  float64           // 통과율의 Wilson 신뢰 하한
+	ConfidenceLevel     float64           // 신뢰 하한 계산에 사용한 신뢰 수준
+	CanTransition       bool              // 전환 가능 여부
+	Reason              string            // 판정 근거
+}
+
+// EffectiveStabilityPeriod는 적용할 안정성 확인 기간을 반환합니다.
+func (c TransitionConfig) EffectiveStabilityPeriod() time.Duration {
+	if c.StabilityPeriod <= 0 {
+		return DefaultStabilityPeriod
+	}
+	return c.StabilityPeriod
+}
+
+// EffectiveStabilityWindows는 안정성 확인 기간을 나눌 구간 수를 반환합니다.
+func (c TransitionConfig) EffectiveStabilityWindows() int {
+	if c.StabilityWindows <= 0 {
+		return DefaultStabilityWindows
+	}
+	return c.StabilityWindows
+}
+
+// EffectiveMinSamplesPerWindow는 구간별 최소 샘플 수를 반환합니다.
+// 지정하지 않으면 MinRequestsForTransition을 구간 수로 나눈 값(올림, 최소 1)입니다.
+func (c TransitionConfig) EffectiveMinSamplesPerWindow() int {
+	if c.MinSamplesPerWindow > 0 {
+		return c.MinSamplesPerWindow
+	}
+	windows := c.EffectiveStabilityWindows()
+	perWindow := (c.MinRequestsForTransition + windows - 1) / windows
+	if perWindow < 1 {
+		return 1
+	}
+	return perWindow
+}
+
+// EffectiveConfidenceLevel은 통과율 신뢰 하한 계산에 사용할 신뢰 수준을 반환합니다.
+func (c TransitionConfig) EffectiveConfidenceLevel() float64 {
+	if c.ConfidenceLevel <= 0 {
+		return DefaultConfidenceLevel
+	}
+	return c.ConfidenceLevel
+}
+
+// validateStability는 안정성 평가 설정이 유효한지 검증합니다.
+func (c TransitionConfig) validateStability() error {
+	if c.StabilityPeriod < 0 || c.StabilityWindows < 0 || c.MinSamplesPerWindow < 0 {
+		return NewValidationError("StabilityPeriod", "stability period, windows and samples per window must not be negative")
+	}
+	if c.ConfidenceLevel != 0 && (c.ConfidenceLevel < 0.5 || c.ConfidenceLevel >= 1) {
+		return NewValidationError("ConfidenceLevel", "confidence level must be at least 0.5 and less than 1.0")
+	}
+	return nil
+}
+
+// AutoTransitionBlocker는 자동 전환 평가 대상이 아닌 이유를 반환합니다. 평가 대상이면 빈 문자열입니다.
+// 단계적 전환 계획이 있는 규칙은 EvaluateRamp로만 전환하므로 대상이 아닙니다.
+func (o *OrchestrationRule) AutoTransitionBlocker() string {
+	switch {
+	case !o.TransitionConfig.AutoTransitionEnabled:
+		return "auto transition disabled"
+	case o.HasRampPlan():
+		return "rule uses a ramp plan"
+	case o.CurrentMode != PARALLEL && o.CurrentMode != SHADOW:
+		return fmt.Sprintf("auto transition not applicable in %s mode", o.CurrentMode)
+	}
+	return ""
+}
+
+// StabilityWindows는 now로 끝나는 안정성 확인 기간을 같은 길이의 구간으로 나눕니다 (오래된 구간부터).
+// 반환된 구간의 통계를 채워 EvaluateStability에 전달합니다.
+func (o *OrchestrationRule) StabilityWindows(now time.Time) []StabilityWindow {
+	config := o.TransitionConfig
+	count := config.EffectiveStabilityWindows()
+	width := config.EffectiveStabilityPeriod() / time.Duration(count)
+	from := now.Add(-width * time.Duration(count))
+
+	windows := make([]StabilityWindow, count)
+	for i := range windows {
+		windows[i].Start = from.Add(width * time.Duration(i))
+		windows[i].End = windows[i].Start.Add(width)
+	}
+	windows[count-1].End = now
+	return windows
+}
+
+// EvaluateStability는 구간별 통계로 자동 전환 가능 여부를 판정합니다.
+// windows는 StabilityWindows로 나눈 구간에 통계(SampleSize, SuccessfulMatches, MatchRate)를 채운 것입니다.
+// 규칙 상태는 변경하지 않습니다.
+func (o *OrchestrationRule) EvaluateStability(windows []StabilityWindow, now time.Time) *TransitionEvaluation {
+	config := o.TransitionConfig
+	evaluation := &TransitionEvaluation{
+		RuleID:              o.ID,
+		CurrentMode:         o.CurrentMode,
+		EvaluatedAt:         now,
+		Windows:             windows,
+		MinSamplesPerWindow: config.EffectiveMinSamplesPerWindow(),
+		ConfidenceLevel:     config.EffectiveConfidenceLevel(),
+	}
+	if len(windows) > 0 {
+		evaluation.From = windows[0].Start
+		evaluation.To = windows[len(windows)-1].End
+	}
+
+	var unmet []string
+	var matchRateSum float64
+	failedWindows := 0
+	for i := range windows {
+		window := &windows[i]
+		evaluation.SampleSize += window.SampleSize
+		evaluation.SuccessfulMatches += window.SuccessfulMatches
+		matchRateSum += window.MatchRate * float64(window.SampleSize)
+
+		var windowUnmet []string
+		if window.SampleSize < evaluation.MinSamplesPerWindow {
+			windowUnmet = append(windowUnmet, fmt.Sprintf("samples %d < %d", window.SampleSize, evaluation.MinSamplesPerWindow))
+		}
+		if window.SampleSize > 0 && window.MatchRate < config.MatchRateThreshold {
+			windowUnmet = append(windowUnmet, fmt.Sprintf("match rate %.4f < %.4f", window.MatchRate, config.MatchRateThreshold))
+		}
+		window.Passed = len(windowUnmet) == 0
+		window.Reason = "criteria met"
+		if !window.Passed {
+			window.Reason = strings.Join(windowUnmet, ", ")
+			failedWindows++
+		}
+	}
+	if failedWindows > 0 {
+		unmet = append(unmet, fmt.Sprintf("%d of %d windows below criteria", failedWindows, len(windows)))
+	}
+
+	if evaluation.SampleSize > 0 {
+		evaluation.MatchRate = matchRateSum / float64(evaluation.SampleSize)
+		evaluation.PassRate = float64(evaluation.SuccessfulMatches) / float64(evaluation.SampleSize)
+	}
+	evaluation.PassRateLowerBound = WilsonLowerBound(evaluation.SuccessfulMatches, evaluation.SampleSize, evaluation.ConfidenceLevel)
+
+	if evaluation.SampleSize < config.MinRequestsForTransition {
+		unmet = append(unmet, fmt.Sprintf("samples %d < %d", evaluation.SampleSize, config.MinRequestsForTransition))
+	}
+	if evaluation.PassRateLowerBound < config.MatchRateThreshold {
+		unmet = append(unmet, fmt.Sprintf("pass rate lower bound %.4f < %.4f", evaluation.PassRateLowerBound, config.MatchRateThreshold))
+	}
+
+	if blocker := o.AutoTransitionBlocker(); blocker != "" {
+		evaluation.Reason = blocker
+		return evaluation
+	}
+	if len(unmet) > 0 {
+		evaluation.Reason = strings.Join(unmet, ", ")
+		return evaluation
+	}
+
+	evaluation.CanTransition = true
+	evaluation.Reason = fmt.Sprintf("stable for %s across %d windows", config.EffectiveStabilityPeriod(), len(windows))
+	return evaluation
+}
+
+// WilsonLowerBound는 successes/total 비율의 단측 Wilson 점수 신뢰 하한을 반환합니다.
+// 샘플이 적을수록 하한이 낮아지므로 적은 샘플의 높은 통과율로 전환되는 것을 막습니다.
+func WilsonLowerBound(successes, total int, confidence float64) float64 {
+	if total <= 0 {
+		return 0
+	}
+
+	z := math.Sqrt2 * math.Erfinv(2*confidence-1)
+	n := float64(total)
+	p := float64(successes) / n
+	z2 := z * z
+
+	center := p + z2/(2*n)
+	margin := z * math.Sqrt(p*(1-p)/n+z2/(4*n*n))
+	return math.Max(0, (center-margin)/(1+z2/n))
+}
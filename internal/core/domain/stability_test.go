@@ -0,0 +1,171 @@
+package domain
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func newStabilityRule() *OrchestrationRule {
+	rule := NewOrchestrationRule("orch-1", "stability", "route-1", "legacy-1", "modern-1")
+	rule.TransitionConfig.AutoTransitionEnabled = true
+	rule.TransitionConfig.MatchRateThreshold = 0.95
+	rule.TransitionConfig.StabilityPeriod = 24 * time.Hour
+	rule.TransitionConfig.MinRequestsForTransition = 100
+	return rule
+}
+
+func TestWilsonLowerBound(t *testing.T) {
+	tests := []struct {
+		name       string
+		successes  int
+		total      int
+		confidence float64
+		want       float64
+	}{
+		{"no samples", 0, 0, 0.95, 0},
+		{"no successes", 0, 10, 0.95, 0},
+		{"95 of 100", 95, 100, 0.95, 0.90084},
+		{"all of 20", 20, 20, 0.95, 0.88084},
+		{"all of 100", 100, 100, 0.95, 0.97366},
+		{"all of 1000 at 99%", 1000, 1000, 0.99, 0.99462},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := WilsonLowerBound(tt.successes, tt.total, tt.confidence)
+			if math.Abs(got-tt.want) > 0.00001 {
+				t.Errorf("expected %.5f, got %.5f", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestOrchestrationRule_StabilityWindows(t *testing.T) {
+	now := time.Date(2025, 1, 5, 12, 0, 0, 0, time.UTC)
+	rule := newStabilityRule()
+	rule.TransitionConfig.StabilityWindows = 3
+
+	windows := rule.StabilityWindows(now)
+	if len(windows) != 3 {
+		t.Fatalf("expected 3 windows, got %d", len(windows))
+	}
+	if !windows[0].Start.Equal(now.Add(-24 * time.Hour)) {
+		t.Errorf("expected first window to start 24h ago, got %v", windows[0].Start)
+	}
+	for i := 1; i < len(windows); i++ {
+		if !windows[i].Start.Equal(windows[i-1].End) {
+			t.Errorf("window %d does not start where window %d ends", i, i-1)
+		}
+	}
+	if !windows[2].End.Equal(now) {
+		t.Errorf("expected last window to end now, got %v", windows[2].End)
+	}
+}
+
+func TestOrchestrationRule_EvaluateStability(t *testing.T) {
+	now := time.Date(2025, 1, 5, 12, 0, 0, 0, time.UTC)
+	stable := StabilityWindow{SampleSize: 50, SuccessfulMatches: 50, MatchRate: 0.99}
+
+	tests := []struct {
+		name       string
+		mode       APIMode
+		windows    []StabilityWindow
+		want       bool
+		wantReason string
+	}{
+		{
+			name:       "stable in every window",
+			mode:       PARALLEL,
+			windows:    []StabilityWindow{stable, stable, stable, stable},
+			want:       true,
+			wantReason: "stable for 24h0m0s across 4 windows",
+		},
+		{
+			name:       "match rate dip in one window",
+			mode:       PARALLEL,
+			windows:    []StabilityWindow{stable, {SampleSize: 50, SuccessfulMatches: 50, MatchRate: 0.90}, stable, stable},
+			want:       false,
+			wantReason: "1 of 4 windows below criteria",
+		},
+		{
+			name:       "no recent samples",
+			mode:       SHADOW,
+			windows:    []StabilityWindow{{SampleSize: 500, SuccessfulMatches: 500, MatchRate: 1.0}, {}, {}, {}},
+			want:       false,
+			wantReason: "3 of 4 windows below criteria",
+		},
+		{
+			name:       "too few samples overall",
+			mode:       PARALLEL,
+			windows:    []StabilityWindow{{SampleSize: 25, SuccessfulMatches: 25, MatchRate: 1.0}, {SampleSize: 25, SuccessfulMatches: 25, MatchRate: 1.0}, {SampleSize: 25, SuccessfulMatches: 25, MatchRate: 1.0}, {SampleSize: 24, SuccessfulMatches: 24, MatchRate: 1.0}},
+			want:       false,
+			wantReason: "1 of 4 windows below criteria, samples 99 < 100",
+		},
+		{
+			name:       "pass rate lower bound below threshold",
+			mode:       PARALLEL,
+			windows:    []StabilityWindow{{SampleSize: 50, SuccessfulMatches: 48, MatchRate: 0.99}, {SampleSize: 50, SuccessfulMatches: 48, MatchRate: 0.99}, {SampleSize: 50, SuccessfulMatches: 48, MatchRate: 0.99}, {SampleSize: 50, SuccessfulMatches: 48, MatchRate: 0.99}},
+			want:       false,
+			wantReason: "pass rate lower bound 0.9304 < 0.9500",
+		},
+		{
+			name:       "not parallel or shadow",
+			mode:       MODERN_ONLY,
+			windows:    []StabilityWindow{stable, stable, stable, stable},
+			want:       false,
+			wantReason: "auto transition not applicable in MODERN_ONLY mode",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := newStabilityRule()
+			rule.CurrentMode = tt.mode
+			windows := rule.StabilityWindows(now)
+			for i := range windows {
+				windows[i].SampleSize = tt.windows[i].SampleSize
+				windows[i].SuccessfulMatches = tt.windows[i].SuccessfulMatches
+				windows[i].MatchRate = tt.windows[i].MatchRate
+			}
+
+			evaluation := rule.EvaluateStability(windows, now)
+			if evaluation.CanTransition != tt.want {
+				t.Errorf("expected can transition=%v, got %v (%s)", tt.want, evaluation.CanTransition, evaluation.Reason)
+			}
+			if evaluation.Reason != tt.wantReason {
+				t.Errorf("expected reason %q, got %q", tt.wantReason, evaluation.Reason)
+			}
+		})
+	}
+}
+
+func TestTransitionConfig_StabilityValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(c *TransitionConfig)
+		wantErr bool
+	}{
+		{"defaults", func(c *TransitionConfig) {}, false},
+		{"custom windows", func(c *TransitionConfig) {
+			c.StabilityWindows = 6
+			c.MinSamplesPerWindow = 10
+			c.ConfidenceLevel = 0.99
+		}, false},
+		{"negative windows", func(c *TransitionConfig) { c.StabilityWindows = -1 }, true},
+		{"confidence too low", func(c *TransitionConfig) { c.ConfidenceLevel = 0.3 }, true},
+		{"confidence of one", func(c *TransitionConfig) { c.ConfidenceLevel = 1.0 }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := newStabilityRule()
+			tt.modify(&rule.TransitionConfig)
+
+			err := rule.IsValid()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error=%v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
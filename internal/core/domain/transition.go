@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// TransitionTrigger는 모드 전환을 일으킨 주체를 나타냅니다.
+type TransitionTrigger string
+
+const (
+	TransitionTriggerAuto     TransitionTrigger = "auto"     // 전환 컨트롤러의 자동 전환
+	TransitionTriggerManual   TransitionTrigger = "manual"   // 관리 API를 통한 수동 전환
+	TransitionTriggerRollback TransitionTrigger = "rollback" // 롤백에 의한 전환
+)
+
+// TransitionSignals는 관측 구간 동안 저장된 비교 결과로 집계한 전환 판단 지표입니다.
+type TransitionSignals struct {
+	SampleSize     int           // 비교 샘플 수
+	MatchRate      float64       // 평균 일치율
+	ErrorRate      float64       // 모던 API 에러율 (호출 실패 또는 5xx)
+	AverageLatency time.Duration // 모던 API 평균 응답 시간
+}
+
+// TransitionEvent는 오케스트레이션 규칙의 모드/트래픽 비율 변경 이력입니다.
+type TransitionEvent struct {
+	ID             string            // 이벤트 고유 ID
+	RuleID         string            // 오케스트레이션 규칙 ID
+	FromMode       APIMode           // 전환 전 모드
+	ToMode         APIMode           // 전환 후 모드
+	FromPercentage int               // 전환 전 모던 API 트래픽 비율
+	ToPercentage   int               // 전환 후 모던 API 트래픽 비율
+	Trigger        TransitionTrigger // 전환 주체
+	MatchRate      float64           // 전환 시점의 평균 일치율
+	ErrorRate      float64           // 전환 시점의 모던 API 에러율
+	SampleSize     int               // 판단에 사용한 비교 샘플 수
+	Reason         string            // 전환 사유
+	Actor          string            // 전환을 실행한 사용자 또는 시스템
+	Timestamp      time.Time         // 전환 시점
+}
+
+// NewRampTransitionEvent는 단계적 전환 결과로부터 전환 이벤트를 생성합니다.
+func NewRampTransitionEvent(ruleID string, decision RampDecision, now time.Time) *TransitionEvent {
+	return &TransitionEvent{
+		ID:             fmt.Sprintf("%s-%d", ruleID, now.UnixNano()),
+		RuleID:         ruleID,
+		FromMode:       decision.FromMode,
+		ToMode:         decision.ToMode,
+		FromPercentage: decision.FromPercentage,
+		ToPercentage:   decision.ToPercentage,
+		Trigger:        TransitionTriggerAuto,
+		MatchRate:      decision.Signals.MatchRate,
+		ErrorRate:      decision.Signals.ErrorRate,
+		SampleSize:     decision.Signals.SampleSize,
+		Reason:         decision.Reason,
+		Actor:          "transition-controller",
+		Timestamp:      now,
+	}
+}
+
+// NewRollbackTransitionEvent는 자동 롤백으로부터 전환 이벤트를 생성합니다.
+func NewRollbackTransitionEvent(ruleID string, fromMode, toMode APIMode, signals TransitionSignals, reason string, now time.Time) *TransitionEvent {
+	return &TransitionEvent{
+		ID:             fmt.Sprintf("%s-%d", ruleID, now.UnixNano()),
+		RuleID:         ruleID,
+		FromMode:       fromMode,
+		ToMode:         toMode,
+		FromPercentage: 100,
+		ToPercentage:   0,
+		Trigger:        TransitionTriggerRollback,
+		MatchRate:      signals.MatchRate,
+		ErrorRate:      signals.ErrorRate,
+		SampleSize:     signals.SampleSize,
+		Reason:         reason,
+		Actor:          "transition-controller",
+		Timestamp:      now,
+	}
+}
+
+// ApplyModeTransition은 규칙을 newMode로 전환하고, 전환 전후 상태를 담은 전환 이벤트를 반환합니다.
+// 단계적 전환의 관측 구간도 새 모드 기준으로 다시 시작합니다.
+func (o *OrchestrationRule) ApplyModeTransition(newMode APIMode, trigger TransitionTrigger, actor string, signals TransitionSignals, now time.Time) *TransitionEvent {
+	event := &TransitionEvent{
+		ID:             fmt.Sprintf("%s-%d", o.ID, now.UnixNano()),
+		RuleID:         o.ID,
+		FromMode:       o.CurrentMode,
+		ToMode:         newMode,
+		FromPercentage: o.modernPercentage(),
+		Trigger:        trigger,
+		MatchRate:      signals.MatchRate,
+		ErrorRate:      signals.ErrorRate,
+		SampleSize:     signals.SampleSize,
+		Reason:         fmt.Sprintf("%s transition from %s to %s", trigger, o.CurrentMode, newMode),
+		Actor:          actor,
+		Timestamp:      now,
+	}
+
+	o.CurrentMode = newMode
+	o.TransitionConfig.RampState = RampState{StageStartedAt: now}
+	o.UpdatedAt = now
+
+	event.ToPercentage = o.modernPercentage()
+	return event
+}
@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOrchestrationRule_ApplyModeTransition(t *testing.T) {
+	now := time.Date(2025, 1, 5, 12, 0, 0, 0, time.UTC)
+	signals := TransitionSignals{SampleSize: 120, MatchRate: 0.97, ErrorRate: 0.02}
+
+	tests := []struct {
+		name               string
+		fromMode           APIMode
+		canaryPercentage   int
+		toMode             APIMode
+		wantFromPercentage int
+		wantToPercentage   int
+	}{
+		{"parallel to modern only", PARALLEL, 0, MODERN_ONLY, 0, 100},
+		{"canary to legacy only", CANARY, 25, LEGACY_ONLY, 25, 0},
+		{"legacy only to canary", LEGACY_ONLY, 10, CANARY, 0, 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := NewOrchestrationRule("orch-1", "transition", "route-1", "legacy-1", "modern-1")
+			rule.CurrentMode = tt.fromMode
+			rule.TransitionConfig.Canary.Percentage = tt.canaryPercentage
+			rule.TransitionConfig.RampState = RampState{StageIndex: 2, StageStartedAt: now.Add(-time.Hour)}
+
+			event := rule.ApplyModeTransition(tt.toMode, TransitionTriggerManual, "alice", signals, now)
+
+			if rule.CurrentMode != tt.toMode {
+				t.Errorf("CurrentMode = %s, want %s", rule.CurrentMode, tt.toMode)
+			}
+			if rule.TransitionConfig.RampState != (RampState{StageStartedAt: now}) {
+				t.Errorf("RampState = %+v, want reset at %s", rule.TransitionConfig.RampState, now)
+			}
+			if event.RuleID != "orch-1" || event.FromMode != tt.fromMode || event.ToMode != tt.toMode {
+				t.Errorf("event modes = %s -> %s for %s", event.FromMode, event.ToMode, event.RuleID)
+			}
+			if event.FromPercentage != tt.wantFromPercentage || event.ToPercentage != tt.wantToPercentage {
+				t.Errorf("event percentages = %d -> %d, want %d -> %d",
+					event.FromPercentage, event.ToPercentage, tt.wantFromPercentage, tt.wantToPercentage)
+			}
+			if event.Trigger != TransitionTriggerManual || event.Actor != "alice" || !event.Timestamp.Equal(now) {
+				t.Errorf("event trigger/actor/timestamp = %s/%s/%s", event.Trigger, event.Actor, event.Timestamp)
+			}
+			if event.MatchRate != 0.97 || event.ErrorRate != 0.02 || event.SampleSize != 120 {
+				t.Errorf("event snapshot = %+v, want %+v", event, signals)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package port
 import (
 	"context"
 	"demo-api-bridge/internal/core/domain"
+	"time"
 
 	"github.com/sony/gobreaker"
 )
@@ -18,6 +19,9 @@ type BridgeService interface {
 
 	// GetEndpoint는 엔드포인트 ID로 엔드포인트 정보를 조회합니다.
 	GetEndpoint(ctx context.Context, endpointID string) (*domain.APIEndpoint, error)
+
+	// RefreshRoutingRules는 저장소의 라우팅 규칙으로 인메모리 라우트 인덱스를 재구성합니다.
+	RefreshRoutingRules(ctx context.Context) error
 }
 
 // RoutingService는 라우팅 관리를 담당하는 인바운드 포트입니다.
@@ -71,7 +75,13 @@ type HealthCheckService interface {
 // OrchestrationService는 API 오케스트레이션을 담당하는 인바운드 포트입니다.
 type OrchestrationService interface {
 	// ProcessParallelRequest는 레거시와 모던 API를 병렬로 호출하고 결과를 비교합니다.
-	ProcessParallelRequest(ctx context.Context, request *domain.Request, legacyEndpoint, modernEndpoint *domain.APIEndpoint) (*domain.APIComparison, error)
+	ProcessParallelRequest(ctx context.Context, rule *domain.OrchestrationRule, request *domain.Request, legacyEndpoint, modernEndpoint *domain.APIEndpoint) (*domain.APIComparison, error)
+
+	// CompareShadowResponse는 이미 받은 레거시 응답을 모던 API 응답과 비교합니다 (SHADOW 모드).
+	CompareShadowResponse(ctx context.Context, rule *domain.OrchestrationRule, request *domain.Request, legacyResponse *domain.Response, modernEndpoint *domain.APIEndpoint) (*domain.APIComparison, error)
+
+	// CompareCanaryResponse는 이미 받은 모던 응답을 레거시 API 응답과 비교합니다 (CANARY 모드, MODERN_ONLY 롤백 샘플링).
+	CompareCanaryResponse(ctx context.Context, rule *domain.OrchestrationRule, request *domain.Request, modernResponse *domain.Response, legacyEndpoint *domain.APIEndpoint) (*domain.APIComparison, error)
 
 	// GetOrchestrationRule은 오케스트레이션 규칙을 조회합니다.
 	GetOrchestrationRule(ctx context.Context, routingRuleID string) (*domain.OrchestrationRule, error)
@@ -82,11 +92,20 @@ type OrchestrationService interface {
 	// UpdateOrchestrationRule은 오케스트레이션 규칙을 수정합니다.
 	UpdateOrchestrationRule(ctx context.Context, rule *domain.OrchestrationRule) error
 
-	// EvaluateTransition는 전환 가능성을 평가합니다.
-	EvaluateTransition(ctx context.Context, rule *domain.OrchestrationRule) (bool, error)
+	// EvaluateTransition는 안정성 확인 기간의 구간별 비교 통계로 전환 가능성을 평가합니다.
+	EvaluateTransition(ctx context.Context, rule *domain.OrchestrationRule) (*domain.TransitionEvaluation, error)
+
+	// ExecuteTransition는 API 모드를 전환하고 전환 이력을 기록합니다.
+	ExecuteTransition(ctx context.Context, rule *domain.OrchestrationRule, newMode domain.APIMode, trigger domain.TransitionTrigger, actor string) error
+
+	// GetTransitionHistory는 오케스트레이션 규칙의 전환 이력을 최신순으로 조회합니다.
+	GetTransitionHistory(ctx context.Context, ruleID string, limit int) ([]*domain.TransitionEvent, error)
 
-	// ExecuteTransition는 API 모드를 전환합니다.
-	ExecuteTransition(ctx context.Context, rule *domain.OrchestrationRule, newMode domain.APIMode) error
+	// GetComparison은 저장된 비교 결과를 ID로 조회합니다.
+	GetComparison(ctx context.Context, id string) (*domain.APIComparison, error)
+
+	// GetComparisonInsights는 라우팅 규칙의 비교 결과 분석(불일치 경로 순위, 차이점 유형 분포, 일치율 추이)을 조회합니다.
+	GetComparisonInsights(ctx context.Context, routingRuleID string, from, to time.Time, bucket domain.InsightBucket, limit int) (*domain.ComparisonInsights, error)
 }
 
 // CircuitBreakerService는 Circuit Breaker 관리를 담당하는 인바운드 포트입니다.
@@ -105,4 +124,15 @@ type CircuitBreakerService interface {
 
 	// ResetBreaker는 Circuit Breaker를 리셋합니다.
 	ResetBreaker(breakerName string) error
+
+	// SetOverride는 Circuit Breaker 상태를 수동으로 고정합니다 (유지보수 등).
+	// duration이 0보다 크면 그 시간이 지난 뒤 자동으로 해제됩니다.
+	SetOverride(breakerName string, override domain.CircuitBreakerOverride, reason string, duration time.Duration) error
+
+	// ClearOverride는 수동 상태 고정을 해제합니다.
+	ClearOverride(breakerName string) error
+
+	// Subscribe는 Circuit Breaker 상태 전환 이벤트를 구독합니다.
+	// 반환된 함수를 호출하면 구독을 해지하고 채널을 닫습니다.
+	Subscribe() (<-chan domain.CircuitBreakerEvent, func())
 }
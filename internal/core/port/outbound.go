@@ -14,6 +14,10 @@ type ExternalAPIClient interface {
 
 	// SendWithRetry는 재시도 로직을 포함하여 외부 API에 요청을 전송합니다.
 	SendWithRetry(ctx context.Context, endpoint *domain.APIEndpoint, request *domain.Request) (*domain.Response, error)
+
+	// SendStream은 요청/응답 본문을 버퍼링하지 않고 외부 API에 요청을 전송합니다.
+	// 응답 본문은 Response.BodyStream으로 반환하며, 호출자가 닫아야 합니다.
+	SendStream(ctx context.Context, endpoint *domain.APIEndpoint, request *domain.Request) (*domain.Response, error)
 }
 
 // CacheRepository는 캐시 저장소를 담당하는 아웃바운드 포트입니다.
@@ -167,15 +171,39 @@ type ComparisonRepository interface {
 	// GetRecentComparisons는 최근 비교 결과들을 조회합니다.
 	GetRecentComparisons(ctx context.Context, routingRuleID string, limit int) ([]*domain.APIComparison, error)
 
+	// GetComparison은 ID로 비교 결과를 조회합니다.
+	GetComparison(ctx context.Context, id string) (*domain.APIComparison, error)
+
 	// GetComparisonStatistics는 비교 통계를 조회합니다.
+	// 보존 기간이 지나 요약된 비교 결과는 기간 안에 온전히 포함되는 날의 일별 요약(ComparisonRollup)만 포함합니다.
 	GetComparisonStatistics(ctx context.Context, routingRuleID string, from, to time.Time) (*ComparisonStatistics, error)
+
+	// GetComparisonInsights는 기간 내 비교 결과를 불일치 경로, 차이점 유형, 시간대별 일치율로 집계합니다.
+	GetComparisonInsights(ctx context.Context, routingRuleID string, from, to time.Time, bucket domain.InsightBucket, limit int) (*domain.ComparisonInsights, error)
+
+	// StripComparisonBodies는 before 이전 비교 결과의 응답 본문을 지웁니다. 상태 코드, 일치율 등 지표는 유지합니다.
+	StripComparisonBodies(ctx context.Context, before time.Time) (int, error)
+
+	// RollupComparisons는 before 이전 비교 결과를 규칙별 일별 요약에 합산한 뒤 삭제하고, 삭제한 건수를 반환합니다.
+	RollupComparisons(ctx context.Context, before time.Time) (int, error)
 }
 
 // ComparisonStatistics는 비교 통계를 나타냅니다.
 type ComparisonStatistics struct {
-	RoutingRuleID     string    // 라우팅 규칙 ID
-	TotalComparisons  int       // 총 비교 횟수
-	SuccessfulMatches int       // 성공적인 일치 횟수
-	AverageMatchRate  float64   // 평균 일치율
-	LastComparison    time.Time // 마지막 비교 시점
+	RoutingRuleID     string        // 라우팅 규칙 ID
+	TotalComparisons  int           // 총 비교 횟수
+	SuccessfulMatches int           // 성공적인 일치 횟수
+	AverageMatchRate  float64       // 평균 일치율
+	ModernErrors      int           // 모던 API 호출 실패 또는 5xx 응답 횟수
+	AverageLatency    time.Duration // 모던 API 평균 응답 시간 (응답이 있는 비교만 집계)
+	LastComparison    time.Time     // 마지막 비교 시점
+}
+
+// TransitionHistoryRepository는 오케스트레이션 모드 전환 이력 저장소를 담당하는 아웃바운드 포트입니다.
+type TransitionHistoryRepository interface {
+	// Save는 전환 이벤트를 저장합니다.
+	Save(ctx context.Context, event *domain.TransitionEvent) error
+
+	// FindByRuleID는 규칙의 전환 이력을 최신순으로 최대 limit개 조회합니다.
+	FindByRuleID(ctx context.Context, ruleID string, limit int) ([]*domain.TransitionEvent, error)
 }
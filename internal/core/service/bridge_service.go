@@ -9,17 +9,13 @@ import (
 	"demo-api-bridge/internal/core/domain"
 	"demo-api-bridge/internal/core/port"
 	"fmt"
+	"math/rand"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
-// routingRuleCacheEntry는 라우팅 규칙 캐시 엔트리입니다.
-type routingRuleCacheEntry struct {
-	rules     []*domain.RoutingRule
-	timestamp time.Time
-}
-
 // bridgeService
 // : BridgeService 인터페이스를 구현하는 핵심 서비스입니다.
 //
@@ -44,10 +40,38 @@ type bridgeService struct {
 	logger            port.Logger                  // 로거
 	metrics           port.MetricsCollector        // 메트릭 수집기
 
-	// 라우팅 규칙 캐시
-	routingRuleCache    map[string]*routingRuleCacheEntry // 캐시 맵 (key: method:path)
-	routingRuleCacheMu  sync.RWMutex                      // 캐시 락
-	routingRuleCacheTTL time.Duration                     // 캐시 TTL (기본: 60초)
+	// 라우트 인덱스 (재구성 시 원자적으로 교체)
+	routeIndex atomic.Pointer[domain.RouteIndex] // 현재 라우트 인덱스
+	refreshMu  sync.Mutex                        // 인덱스 재구성 직렬화 락
+
+	// SHADOW 모드 백그라운드 워커 풀 (주입되지 않으면 첫 사용 시 기본 설정으로 생성)
+	shadowPool *ShadowPool
+	shadowOnce sync.Once
+
+	// 규칙별 비교 결과 저장 샘플링
+	sampler *comparisonSampler
+
+	// 비교/캐시가 필요 없는 요청의 본문 스트리밍 여부
+	streaming bool
+}
+
+// BridgeServiceOption은 bridgeService의 선택적 구성 요소를 설정합니다.
+type BridgeServiceOption func(*bridgeService)
+
+// WithShadowPool은 SHADOW 모드에서 사용할 워커 풀을 주입합니다.
+// 풀의 종료(Close)는 풀을 생성한 쪽에서 관리합니다.
+func WithShadowPool(pool *ShadowPool) BridgeServiceOption {
+	return func(s *bridgeService) {
+		s.shadowPool = pool
+	}
+}
+
+// WithStreaming은 응답 비교가 필요 없는 LEGACY_ONLY / MODERN_ONLY 요청의
+// 요청/응답 본문을 버퍼링하지 않고 그대로 전달하도록 설정합니다.
+func WithStreaming(enabled bool) BridgeServiceOption {
+	return func(s *bridgeService) {
+		s.streaming = enabled
+	}
 }
 
 // NewBridgeService
@@ -66,6 +90,7 @@ type bridgeService struct {
 //   - cache: 라우팅 규칙 및 응답을 캐싱하는 저장소
 //   - logger: 구조화된 로깅을 제공하는 로거
 //   - metrics: Prometheus 메트릭을 수집하는 컬렉터
+//   - opts: 선택적 구성 요소 (예: WithShadowPool, WithStreaming)
 //
 // Returns:
 //   - port.BridgeService: 완전히 초기화된 Bridge 서비스 인터페이스
@@ -79,20 +104,24 @@ func NewBridgeService(
 	cache port.CacheRepository,
 	logger port.Logger,
 	metrics port.MetricsCollector,
+	opts ...BridgeServiceOption,
 ) port.BridgeService {
-	return &bridgeService{
-		routingRepo:         routingRepo,
-		endpointRepo:        endpointRepo,
-		orchestrationRepo:   orchestrationRepo,
-		comparisonRepo:      comparisonRepo,
-		orchestrationSvc:    orchestrationSvc,
-		externalAPI:         externalAPI,
-		cache:               cache,
-		logger:              logger,
-		metrics:             metrics,
-		routingRuleCache:    make(map[string]*routingRuleCacheEntry),
-		routingRuleCacheTTL: 60 * time.Second, // 60초 TTL
-	}
+	s := &bridgeService{
+		routingRepo:       routingRepo,
+		endpointRepo:      endpointRepo,
+		orchestrationRepo: orchestrationRepo,
+		comparisonRepo:    comparisonRepo,
+		orchestrationSvc:  orchestrationSvc,
+		externalAPI:       externalAPI,
+		cache:             cache,
+		logger:            logger,
+		metrics:           metrics,
+		sampler:           newComparisonSampler(),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 // ProcessRequest
@@ -100,12 +129,14 @@ func NewBridgeService(
 //
 // 이 메서드는 API Bridge의 핵심 로직으로, 다음 단계를 수행합니다:
 //  1. 요청 유효성 검증
-//  2. 라우팅 규칙 조회 (캐시 우선, DB 조회)
+//  2. 라우팅 규칙 조회 (인메모리 라우트 인덱스)
 //  3. 오케스트레이션 규칙 확인
 //  4. 요청 처리 모드 결정:
 //     - PARALLEL: 레거시/모던 API 병렬 호출 후 레거시 응답 반환
 //     - MODERN_ONLY: 모던 API만 호출
 //     - LEGACY_ONLY: 레거시 API만 호출
+//     - CANARY: 설정된 비율의 요청만 모던 API, 나머지는 레거시 API 호출
+//     - SHADOW: 레거시 응답 즉시 반환, 모던 API 호출/비교는 백그라운드 수행
 //  5. 응답 비교 및 전환 로직 실행 (PARALLEL 모드)
 //  6. 메트릭 수집 및 로깅
 //
@@ -121,6 +152,9 @@ func NewBridgeService(
 //   - domain.ErrInvalidRequest: 요청 검증 실패
 //   - domain.ErrRouteNotFound: 매칭되는 라우팅 규칙 없음
 //   - domain.ErrExternalAPIFailed: 외부 API 호출 실패
+//   - domain.ErrExternalAPITimeout: 외부 API 응답 시간 초과
+//   - domain.ErrCircuitOpen: Circuit Breaker가 열려 외부 API 호출 차단 (domain.CircuitOpenError)
+//   - domain.ErrAllAPICallsFailed: 병렬 호출에서 레거시/모던 API 모두 실패
 func (s *bridgeService) ProcessRequest(ctx context.Context, request *domain.Request) (*domain.Response, error) {
 	start := time.Now()
 
@@ -145,6 +179,7 @@ func (s *bridgeService) ProcessRequest(ctx context.Context, request *domain.Requ
 		s.metrics.RecordRequest(request.Method, request.Path, 404, time.Since(start))
 		return nil, err
 	}
+	s.bindRouteParams(ctx, request, rule)
 
 	// 3. 오케스트레이션 규칙 확인
 	orchestrationRule, err := s.orchestrationRepo.FindByRoutingRuleID(ctx, rule.ID)
@@ -176,8 +211,8 @@ func (s *bridgeService) ProcessRequest(ctx context.Context, request *domain.Requ
 // : 요청에 매칭되는 라우팅 규칙을 조회합니다.
 //
 // 이 메서드는 다음 순서로 라우팅 규칙을 찾습니다:
-//  1. 인메모리 캐시에서 조회 (TTL 기반)
-//  2. 캐시 미스 시 DB에서 조회 후 캐시에 저장
+//  1. 인메모리 라우트 인덱스에서 경로/메서드로 조회 (DB 조회 없음)
+//  2. 인덱스가 아직 없으면 저장소의 전체 규칙으로 인덱스를 구성
 //  3. 매칭되는 규칙이 없으면 기본 레거시 엔드포인트로 fallback
 //
 // Parameters:
@@ -188,61 +223,32 @@ func (s *bridgeService) ProcessRequest(ctx context.Context, request *domain.Requ
 //   - *domain.RoutingRule: 매칭된 라우팅 규칙 (또는 기본 규칙)
 //   - error: 조회 중 발생한 에러
 func (s *bridgeService) GetRoutingRule(ctx context.Context, request *domain.Request) (*domain.RoutingRule, error) {
-	cacheKey := s.generateRoutingCacheKey(request)
-
-	// 1. 캐시에서 조회
-	s.routingRuleCacheMu.RLock()
-	if entry, exists := s.routingRuleCache[cacheKey]; exists {
-		// TTL 체크
-		if time.Since(entry.timestamp) < s.routingRuleCacheTTL {
-			s.routingRuleCacheMu.RUnlock()
-
-			// 캐시된 규칙이 있으면 우선순위 기반 선택
-			if len(entry.rules) > 0 {
-				return s.selectHighestPriorityRule(entry.rules), nil
-			}
-
-			// 캐시에 규칙이 없으면 기본 레거시 엔드포인트로 fallback
-			s.logger.WithContext(ctx).Info("no cached routing rules, using default legacy endpoint",
+	// 1. 라우트 인덱스 조회 (최초 요청 시 구성)
+	index := s.routeIndex.Load()
+	if index == nil {
+		if err := s.RefreshRoutingRules(ctx); err != nil {
+			// 에러 메시지의 개행/탭 문자를 공백으로 치환하여 한 줄로 출력
+			errorMsg := strings.ReplaceAll(err.Error(), "\n", " ")
+			errorMsg = strings.ReplaceAll(errorMsg, "\t", " ")
+			errorMsg = strings.TrimSpace(errorMsg)
+
+			s.logger.WithContext(ctx).Warn("failed to build route index, using default legacy endpoint",
+				"error", errorMsg,
 				"request", fmt.Sprintf("%s %s", request.Method, request.Path),
 			)
+			// 인덱스 구성 실패 시에도 기본 레거시 엔드포인트로 fallback
 			s.metrics.RecordDefaultRoutingUsed(request.Method, request.Path)
 			return s.createDefaultRoutingRule(ctx, request)
 		}
+		index = s.routeIndex.Load()
 	}
-	s.routingRuleCacheMu.RUnlock()
 
-	// 2. DB에서 조회
-	rules, err := s.routingRepo.FindMatchingRules(ctx, request)
-	if err != nil {
-		// 에러 메시지의 개행/탭 문자를 공백으로 치환하여 한 줄로 출력
-		errorMsg := strings.ReplaceAll(err.Error(), "\n", " ")
-		errorMsg = strings.ReplaceAll(errorMsg, "\t", " ")
-		errorMsg = strings.TrimSpace(errorMsg)
-
-		s.logger.WithContext(ctx).Warn("DB query failed, using default legacy endpoint",
-			"error", errorMsg,
-			"request", fmt.Sprintf("%s %s", request.Method, request.Path),
-		)
-		// DB 조회 실패 시에도 기본 레거시 엔드포인트로 fallback
-		s.metrics.RecordDefaultRoutingUsed(request.Method, request.Path)
-		return s.createDefaultRoutingRule(ctx, request)
-	}
-
-	// 3. 캐시에 저장 (규칙이 없어도 저장하여 반복 DB 조회 방지)
-	s.routingRuleCacheMu.Lock()
-	s.routingRuleCache[cacheKey] = &routingRuleCacheEntry{
-		rules:     rules,
-		timestamp: time.Now(),
-	}
-	s.routingRuleCacheMu.Unlock()
-
-	// 4. 매칭된 규칙이 있으면 반환
-	if len(rules) > 0 {
+	// 2. 매칭된 규칙이 있으면 우선순위 기반 선택
+	if rules := index.Match(request); len(rules) > 0 {
 		return s.selectHighestPriorityRule(rules), nil
 	}
 
-	// 5. 매칭된 규칙이 없으면 기본 레거시 엔드포인트로 fallback
+	// 3. 매칭된 규칙이 없으면 기본 레거시 엔드포인트로 fallback
 	s.logger.WithContext(ctx).Info("no matching routing rules, using default legacy endpoint",
 		"request", fmt.Sprintf("%s %s", request.Method, request.Path),
 	)
@@ -250,16 +256,86 @@ func (s *bridgeService) GetRoutingRule(ctx context.Context, request *domain.Requ
 	return s.createDefaultRoutingRule(ctx, request)
 }
 
+// RefreshRoutingRules
+// : 저장소의 전체 라우팅 규칙으로 라우트 인덱스를 다시 구성합니다.
+//
+// 새 인덱스는 완전히 구성된 뒤 원자적으로 교체되므로, 재구성 중에도
+// 요청은 이전 인덱스로 중단 없이 처리됩니다. 경로 패턴이 잘못된 규칙은
+// 경고 로그를 남기고 인덱스에서 제외합니다.
+//
+// Parameters:
+//   - ctx: 요청 컨텍스트
+//
+// Returns:
+//   - error: 라우팅 규칙 조회 실패 시 (기존 인덱스는 유지)
+func (s *bridgeService) RefreshRoutingRules(ctx context.Context) error {
+	s.refreshMu.Lock()
+	defer s.refreshMu.Unlock()
+
+	rules, err := s.routingRepo.FindAll(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load routing rules: %w", err)
+	}
+
+	index, err := domain.NewRouteIndex(rules)
+	if err != nil {
+		s.logger.WithContext(ctx).Warn("some routing rules were skipped", "error", err)
+	}
+
+	s.routeIndex.Store(index)
+	s.logger.WithContext(ctx).Info("route index rebuilt", "rule_count", index.Size())
+
+	return nil
+}
+
+// bindRouteParams는 매칭된 규칙이 캡처한 경로 파라미터와 재작성된 업스트림 경로,
+// 미러 호출에만 추가할 헤더(MirrorPolicy)를 요청에 기록합니다.
+//
+// 기록된 값은 외부 API 호출 URL 구성, 비교, 로깅, 캐싱에서 사용됩니다.
+func (s *bridgeService) bindRouteParams(ctx context.Context, request *domain.Request, rule *domain.RoutingRule) {
+	request.RoutingRuleID = rule.ID
+	request.MirrorHeaders = rule.MirrorPolicy.MirrorHeaders(request.Method)
+
+	var params map[string]string
+	var ok bool
+	if index := s.routeIndex.Load(); index != nil {
+		params, ok = index.ExtractPathParams(rule, request.Path)
+	} else {
+		params, ok = rule.ExtractPathParams(request.Path)
+	}
+	if !ok {
+		return
+	}
+	request.PathParams = params
+	request.UpstreamPath = rule.RewritePath(params)
+
+	if request.UpstreamPath != "" {
+		s.logger.WithContext(ctx).Debug("request path rewritten",
+			"rule_id", rule.ID,
+			"path", request.Path,
+			"upstream_path", request.UpstreamPath,
+			"path_params", params,
+		)
+	}
+}
+
 // selectHighestPriorityRule은 우선순위가 가장 높은 (숫자가 낮은) 규칙을 선택합니다.
+// 우선순위가 같으면 헤더/쿼리 파라미터 조건이 더 구체적인 규칙을 선택합니다.
 func (s *bridgeService) selectHighestPriorityRule(rules []*domain.RoutingRule) *domain.RoutingRule {
 	if len(rules) == 0 {
 		return nil
 	}
 
 	selectedRule := rules[0]
-	for _, rule := range rules {
-		if rule.Priority < selectedRule.Priority {
+	selectedSpecificity := selectedRule.Specificity()
+	for _, rule := range rules[1:] {
+		if rule.Priority > selectedRule.Priority {
+			continue
+		}
+		specificity := rule.Specificity()
+		if rule.Priority < selectedRule.Priority || specificity > selectedSpecificity {
 			selectedRule = rule
+			selectedSpecificity = specificity
 		}
 	}
 
@@ -272,7 +348,7 @@ func (s *bridgeService) createDefaultRoutingRule(ctx context.Context, request *d
 	defaultEndpoint, err := s.endpointRepo.FindDefaultLegacyEndpoint(ctx)
 	if err != nil {
 		s.logger.WithContext(ctx).Error("failed to find default legacy endpoint", "error", err)
-		return nil, fmt.Errorf("no routing rule found and failed to get default endpoint: %w", err)
+		return nil, fmt.Errorf("no routing rule found and failed to get default endpoint: %w: %w", domain.ErrRouteNotFound, err)
 	}
 
 	// 동적으로 라우팅 규칙 생성
@@ -361,11 +437,6 @@ func (s *bridgeService) createDefaultOrchestrationRule(
 	return defaultOrchRule, nil
 }
 
-// generateRoutingCacheKey는 라우팅 캐시 키를 생성합니다.
-func (s *bridgeService) generateRoutingCacheKey(request *domain.Request) string {
-	return fmt.Sprintf("abs:routing:%s:%s", request.Method, request.Path)
-}
-
 // GetEndpoint
 // : 엔드포인트 ID로 엔드포인트 정보를 조회합니다.
 func (s *bridgeService) GetEndpoint(ctx context.Context, endpointID string) (*domain.APIEndpoint, error) {
@@ -393,6 +464,12 @@ func (s *bridgeService) processSingleAPIRequest(ctx context.Context, request *do
 
 	// 캐시 확인 (캐시가 활성화된 경우)
 	if rule.CacheEnabled {
+		// 응답을 캐시하려면 재시도 로직으로 전송해야 하므로 본문을 버퍼링
+		if err := request.BufferBody(); err != nil {
+			s.logger.WithContext(ctx).Error("failed to read request body", "error", err)
+			return nil, err
+		}
+
 		cacheKey := s.generateCacheKey(request)
 		if cached, err := s.cache.Get(ctx, cacheKey); err == nil {
 			s.logger.WithContext(ctx).Info("cache hit", "key", cacheKey)
@@ -409,9 +486,14 @@ func (s *bridgeService) processSingleAPIRequest(ctx context.Context, request *do
 		s.metrics.RecordCacheHit(false)
 	}
 
-	// 외부 API 호출
+	// 외부 API 호출 (캐시가 필요 없으면 스트리밍 설정에 따라 직접 전달)
+	send := s.sendDirect
+	if rule.CacheEnabled {
+		send = s.externalAPI.SendWithRetry
+	}
+
 	apiStart := time.Now()
-	response, err := s.externalAPI.SendWithRetry(ctx, endpoint, request)
+	response, err := send(ctx, endpoint, request)
 	apiDuration := time.Since(apiStart)
 
 	if err != nil {
@@ -452,15 +534,31 @@ func (s *bridgeService) processOrchestratedRequest(ctx context.Context, request
 		"current_mode", orchestrationRule.CurrentMode,
 	)
 
+	// 응답 비교가 필요한 모드는 요청 본문을 양쪽 API에 보내야 하므로 미리 버퍼링
+	// (LEGACY_ONLY / MODERN_ONLY는 비교가 필요할 때만 각 처리 함수에서 버퍼링)
+	if mode := orchestrationRule.CurrentMode; mode != domain.LEGACY_ONLY && mode != domain.MODERN_ONLY {
+		if err := request.BufferBody(); err != nil {
+			s.logger.WithContext(ctx).Error("failed to read request body", "error", err)
+			return nil, err
+		}
+	}
+
+	// 미러 호출(클라이언트에 반환하지 않는 쪽 API 호출)은 라우팅 규칙의 미러링 정책을 따름
+	mirror := rule.MirrorPolicy
+
 	switch orchestrationRule.CurrentMode {
 	case domain.LEGACY_ONLY:
 		return s.processLegacyOnlyRequest(ctx, request, orchestrationRule, start)
 	case domain.MODERN_ONLY:
-		return s.processModernOnlyRequest(ctx, request, orchestrationRule, start)
+		return s.processModernOnlyRequest(ctx, request, orchestrationRule, mirror, start)
 	case domain.PARALLEL:
-		return s.processParallelRequest(ctx, request, orchestrationRule, start)
+		return s.processParallelRequest(ctx, request, orchestrationRule, mirror, start)
+	case domain.CANARY:
+		return s.processCanaryRequest(ctx, request, orchestrationRule, mirror, start)
+	case domain.SHADOW:
+		return s.processShadowRequest(ctx, request, orchestrationRule, mirror, start)
 	default:
-		return s.processParallelRequest(ctx, request, orchestrationRule, start)
+		return s.processParallelRequest(ctx, request, orchestrationRule, mirror, start)
 	}
 }
 
@@ -472,7 +570,7 @@ func (s *bridgeService) processLegacyOnlyRequest(ctx context.Context, request *d
 		return nil, err
 	}
 
-	response, err := s.externalAPI.SendWithRetry(ctx, legacyEndpoint, request)
+	response, err := s.sendDirect(ctx, legacyEndpoint, request)
 	if err != nil {
 		s.logger.WithContext(ctx).Error("legacy API call failed", "error", err)
 		return nil, err
@@ -491,21 +589,40 @@ func (s *bridgeService) processLegacyOnlyRequest(ctx context.Context, request *d
 }
 
 // processModernOnlyRequest는 모던 API만 호출합니다.
-func (s *bridgeService) processModernOnlyRequest(ctx context.Context, request *domain.Request, rule *domain.OrchestrationRule, start time.Time) (*domain.Response, error) {
+func (s *bridgeService) processModernOnlyRequest(ctx context.Context, request *domain.Request, rule *domain.OrchestrationRule, mirror domain.MirrorPolicy, start time.Time) (*domain.Response, error) {
 	modernEndpoint, err := s.GetEndpoint(ctx, rule.ModernEndpointID)
 	if err != nil {
 		s.logger.WithContext(ctx).Error("modern endpoint not found", "error", err)
 		return nil, err
 	}
 
-	response, err := s.externalAPI.SendWithRetry(ctx, modernEndpoint, request)
+	// 자동 롤백 판단을 위해 일부 요청만 레거시 응답과 비교 (레거시 샌드박스가 없는 쓰기 요청은 제외)
+	sampled := s.shouldSampleRollback(rule) && s.allowsLegacyMirror(ctx, request, rule, mirror)
+
+	// 비교 대상 요청은 레거시에도 보내고 응답 본문을 비교해야 하므로 버퍼링
+	send := s.sendDirect
+	if sampled {
+		if err := request.BufferBody(); err != nil {
+			s.logger.WithContext(ctx).Error("failed to read request body", "error", err)
+			return nil, err
+		}
+		send = s.externalAPI.SendWithRetry
+	}
+
+	response, err := send(ctx, modernEndpoint, request)
 	if err != nil {
 		s.logger.WithContext(ctx).Error("modern API call failed", "error", err)
+		if sampled {
+			s.submitLegacyComparison(ctx, request, rule, mirror, nil)
+		}
 		return nil, err
 	}
 
 	response.SetDuration(start)
 	s.metrics.RecordRequest(request.Method, request.Path, response.StatusCode, time.Since(start))
+	if sampled {
+		s.submitLegacyComparison(ctx, request, rule, mirror, response)
+	}
 
 	s.logger.WithContext(ctx).Info("modern-only request processed successfully",
 		"request_id", request.ID,
@@ -516,8 +633,253 @@ func (s *bridgeService) processModernOnlyRequest(ctx context.Context, request *d
 	return response, nil
 }
 
+// sendDirect는 응답 비교나 캐시가 필요 없는 요청을 외부 API로 전달합니다.
+// 스트리밍이 활성화되어 있으면 요청/응답 본문을 버퍼링하지 않고,
+// 아니면 요청 본문을 읽어 재시도 로직으로 전송합니다.
+func (s *bridgeService) sendDirect(ctx context.Context, endpoint *domain.APIEndpoint, request *domain.Request) (*domain.Response, error) {
+	if s.streaming {
+		return s.externalAPI.SendStream(ctx, endpoint, request)
+	}
+	if err := request.BufferBody(); err != nil {
+		return nil, err
+	}
+	return s.externalAPI.SendWithRetry(ctx, endpoint, request)
+}
+
+// processCanaryRequest는 요청을 카나리 그룹에 배정하고 해당 그룹의 API만 호출합니다.
+//
+// 그룹별 에러율과 지연 시간을 비교할 수 있도록 canary_requests 카운터와
+// canary_request_duration 히스토그램을 rule_id / group 레이블로 기록합니다.
+func (s *bridgeService) processCanaryRequest(ctx context.Context, request *domain.Request, rule *domain.OrchestrationRule, mirror domain.MirrorPolicy, start time.Time) (*domain.Response, error) {
+	group := rule.TransitionConfig.Canary.AssignGroup(rule.ID, request)
+
+	// 단계적 전환 규칙은 모던 그룹 응답을 레거시와 비교 (레거시 샌드박스가 없는 쓰기 요청은 제외)
+	compare := group == domain.CanaryGroupModern && rule.HasRampPlan() && s.allowsLegacyMirror(ctx, request, rule, mirror)
+
+	endpointID := rule.LegacyEndpointID
+	if group == domain.CanaryGroupModern {
+		endpointID = rule.ModernEndpointID
+	}
+
+	endpoint, err := s.GetEndpoint(ctx, endpointID)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("canary endpoint not found", "group", group, "error", err)
+		return nil, err
+	}
+
+	apiStart := time.Now()
+	response, err := s.externalAPI.SendWithRetry(ctx, endpoint, request)
+	s.recordCanaryMetrics(rule, group, response, err, time.Since(apiStart))
+	if err != nil {
+		s.logger.WithContext(ctx).Error("canary API call failed", "group", group, "error", err)
+		if compare {
+			s.submitLegacyComparison(ctx, request, rule, mirror, nil)
+		}
+		return nil, err
+	}
+
+	response.Source = string(group)
+	response.SetDuration(start)
+	if compare {
+		s.submitLegacyComparison(ctx, request, rule, mirror, response)
+	}
+	s.metrics.RecordRequest(request.Method, request.Path, response.StatusCode, time.Since(start))
+
+	s.logger.WithContext(ctx).Info("canary request processed successfully",
+		"request_id", request.ID,
+		"status_code", response.StatusCode,
+		"group", group,
+	)
+
+	return response, nil
+}
+
+// submitLegacyComparison은 클라이언트에 반환한 모던 응답을 섀도우 워커에서
+// 레거시 응답과 비교하도록 제출합니다. 비교 결과는 단계적 전환과 자동 롤백 판단에 사용됩니다.
+// 모던 API 호출이 실패한 경우에도 에러율 집계를 위해 응답 없이(nil) 비교를 제출합니다.
+// 쓰기 요청의 비교 호출은 운영 레거시가 아닌 미러링 정책의 레거시 샌드박스 엔드포인트로 보냅니다.
+func (s *bridgeService) submitLegacyComparison(ctx context.Context, request *domain.Request, rule *domain.OrchestrationRule, mirror domain.MirrorPolicy, modernResponse *domain.Response) {
+	if !rule.ComparisonConfig.Enabled || !rule.ComparisonConfig.SaveComparisonHistory {
+		return
+	}
+
+	legacyEndpoint, err := s.GetEndpoint(ctx, mirror.LegacyMirrorEndpointID(request.Method, rule.LegacyEndpointID))
+	if err != nil {
+		s.logger.WithContext(ctx).Warn("legacy endpoint unavailable, skipping legacy comparison", "rule_id", rule.ID, "error", err)
+		return
+	}
+
+	s.shadowWorkers().Submit(ctx, rule.ID, func(jobCtx context.Context) {
+		comparison, err := s.orchestrationSvc.CompareCanaryResponse(jobCtx, rule, request, modernResponse, legacyEndpoint)
+		if err != nil {
+			s.logger.WithContext(jobCtx).Warn("legacy comparison failed", "rule_id", rule.ID, "error", err)
+			return
+		}
+		s.saveComparison(jobCtx, rule, comparison)
+	})
+}
+
+// saveComparison은 규칙의 샘플링 정책에 따라 비교 결과를 저장합니다.
+// 샘플링 정책이 있는 규칙은 저장/제외 건수를 comparison_samples 메트릭으로 기록합니다.
+func (s *bridgeService) saveComparison(ctx context.Context, rule *domain.OrchestrationRule, comparison *domain.APIComparison) {
+	if !rule.ComparisonConfig.Sampling.IsZero() {
+		result := "stored"
+		if !s.sampler.shouldStore(rule, comparison) {
+			result = "dropped"
+		}
+		s.metrics.IncrementCounter("comparison_samples", map[string]string{
+			"rule_id": rule.ID,
+			"result":  result,
+		})
+		if result == "dropped" {
+			return
+		}
+	}
+
+	if err := s.comparisonRepo.SaveComparison(ctx, comparison); err != nil {
+		s.logger.WithContext(ctx).Warn("failed to save comparison result", "rule_id", rule.ID, "error", err)
+	}
+}
+
+// shouldSampleRollback은 MODERN_ONLY 요청을 롤백 판단용 비교 대상으로 샘플링할지 결정합니다.
+func (s *bridgeService) shouldSampleRollback(rule *domain.OrchestrationRule) bool {
+	rate := rule.TransitionConfig.Rollback.SampleRate
+	return rate > 0 && (rate >= 1 || rand.Float64() < rate)
+}
+
+// allowsMirror는 라우팅 규칙의 미러링 정책이 요청의 미러 호출을 허용하는지 확인합니다.
+// 허용하지 않으면 건너뛴 미러 호출을 mirror_skipped 메트릭으로 기록합니다.
+func (s *bridgeService) allowsMirror(ctx context.Context, request *domain.Request, rule *domain.OrchestrationRule, mirror domain.MirrorPolicy) bool {
+	if mirror.AllowsMirror(request.Method) {
+		return true
+	}
+	s.recordMirrorSkipped(ctx, request, rule)
+	return false
+}
+
+// allowsLegacyMirror는 라우팅 규칙의 미러링 정책이 요청의 레거시 비교 호출을 허용하는지 확인합니다.
+// 쓰기 요청은 레거시 샌드박스 엔드포인트가 없으면 허용하지 않고 mirror_skipped 메트릭으로 기록합니다.
+func (s *bridgeService) allowsLegacyMirror(ctx context.Context, request *domain.Request, rule *domain.OrchestrationRule, mirror domain.MirrorPolicy) bool {
+	if mirror.AllowsLegacyMirror(request.Method) {
+		return true
+	}
+	s.recordMirrorSkipped(ctx, request, rule)
+	return false
+}
+
+// recordMirrorSkipped는 건너뛴 미러 호출을 로그와 mirror_skipped 메트릭으로 기록합니다.
+func (s *bridgeService) recordMirrorSkipped(ctx context.Context, request *domain.Request, rule *domain.OrchestrationRule) {
+	s.logger.WithContext(ctx).Debug("mirror call skipped for unsafe method",
+		"request_id", request.ID,
+		"rule_id", rule.ID,
+		"method", request.Method,
+		"current_mode", rule.CurrentMode,
+	)
+	s.metrics.IncrementCounter("mirror_skipped", map[string]string{
+		"rule_id": rule.ID,
+		"method":  request.Method,
+		"mode":    string(rule.CurrentMode),
+	})
+}
+
+// recordCanaryMetrics는 카나리 그룹별 요청 결과와 지연 시간을 기록합니다.
+// 호출 실패 또는 5xx 응답은 result=error로 집계합니다.
+func (s *bridgeService) recordCanaryMetrics(rule *domain.OrchestrationRule, group domain.CanaryGroup, response *domain.Response, err error, duration time.Duration) {
+	result := "success"
+	if err != nil || response == nil || response.StatusCode >= 500 {
+		result = "error"
+	}
+
+	s.metrics.IncrementCounter("canary_requests", map[string]string{
+		"rule_id": rule.ID,
+		"group":   string(group),
+		"result":  result,
+	})
+	s.metrics.RecordHistogram("canary_request_duration", float64(duration.Milliseconds()), map[string]string{
+		"rule_id": rule.ID,
+		"group":   string(group),
+	})
+}
+
+// processShadowRequest는 레거시 API 응답을 즉시 반환하고,
+// 모던 API 호출과 응답 비교는 섀도우 워커 풀에 맡깁니다.
+//
+// 워커 풀이 포화 상태면 섀도우 작업은 버려지며 클라이언트 응답에는 영향을 주지 않습니다.
+// 미러링 정책이 허용하지 않는 쓰기 요청은 레거시 API만 호출합니다.
+func (s *bridgeService) processShadowRequest(ctx context.Context, request *domain.Request, rule *domain.OrchestrationRule, mirror domain.MirrorPolicy, start time.Time) (*domain.Response, error) {
+	// 미러링할 수 없는 쓰기 요청은 레거시만 호출
+	if !s.allowsMirror(ctx, request, rule, mirror) {
+		return s.processLegacyOnlyRequest(ctx, request, rule, start)
+	}
+
+	legacyEndpoint, err := s.GetEndpoint(ctx, rule.LegacyEndpointID)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("legacy endpoint not found", "error", err)
+		return nil, err
+	}
+
+	response, err := s.externalAPI.SendWithRetry(ctx, legacyEndpoint, request)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("legacy API call failed", "error", err)
+		return nil, err
+	}
+
+	response.Source = "legacy"
+	response.SetDuration(start)
+	s.metrics.RecordRequest(request.Method, request.Path, response.StatusCode, time.Since(start))
+
+	// 모던 엔드포인트 문제는 섀도우 비교만 건너뛰고 응답에는 영향을 주지 않음
+	modernEndpoint, err := s.GetEndpoint(ctx, mirror.MirrorEndpointID(request.Method, rule.ModernEndpointID))
+	if err != nil {
+		s.logger.WithContext(ctx).Warn("modern endpoint unavailable, skipping shadow comparison", "rule_id", rule.ID, "error", err)
+		return response, nil
+	}
+
+	queued := s.shadowWorkers().Submit(ctx, rule.ID, func(jobCtx context.Context) {
+		s.runShadowComparison(jobCtx, request, rule, response, modernEndpoint)
+	})
+
+	s.logger.WithContext(ctx).Info("shadow request processed successfully",
+		"request_id", request.ID,
+		"status_code", response.StatusCode,
+		"shadow_queued", queued,
+	)
+
+	return response, nil
+}
+
+// runShadowComparison은 섀도우 워커에서 모던 API를 호출해 레거시 응답과 비교하고 결과를 저장합니다.
+func (s *bridgeService) runShadowComparison(ctx context.Context, request *domain.Request, rule *domain.OrchestrationRule, legacyResponse *domain.Response, modernEndpoint *domain.APIEndpoint) {
+	comparison, err := s.orchestrationSvc.CompareShadowResponse(ctx, rule, request, legacyResponse, modernEndpoint)
+	if err != nil {
+		s.logger.WithContext(ctx).Warn("shadow comparison failed", "rule_id", rule.ID, "error", err)
+		return
+	}
+
+	// 비교가 비활성화된 규칙은 저장할 결과가 없음
+	if rule.ComparisonConfig.SaveComparisonHistory && !comparison.Skipped {
+		s.saveComparison(ctx, rule, comparison)
+	}
+}
+
+// shadowWorkers는 섀도우 워커 풀을 반환하며, 주입된 풀이 없으면 기본 설정으로 생성합니다.
+func (s *bridgeService) shadowWorkers() *ShadowPool {
+	s.shadowOnce.Do(func() {
+		if s.shadowPool == nil {
+			s.shadowPool = NewShadowPool(ShadowPoolConfig{}, s.logger, s.metrics)
+		}
+	})
+	return s.shadowPool
+}
+
 // processParallelRequest : 레거시와 모던 API를 병렬로 호출합니다.
-func (s *bridgeService) processParallelRequest(ctx context.Context, request *domain.Request, rule *domain.OrchestrationRule, start time.Time) (*domain.Response, error) {
+// 미러링 정책이 허용하지 않는 쓰기 요청은 레거시 API만 호출하고,
+// 허용한 쓰기 요청의 모던 호출은 정책의 샌드박스 엔드포인트와 dry-run 헤더를 따릅니다.
+func (s *bridgeService) processParallelRequest(ctx context.Context, request *domain.Request, rule *domain.OrchestrationRule, mirror domain.MirrorPolicy, start time.Time) (*domain.Response, error) {
+	if !s.allowsMirror(ctx, request, rule, mirror) {
+		return s.processLegacyOnlyRequest(ctx, request, rule, start)
+	}
+
 	// 엔드포인트 조회
 	legacyEndpoint, err := s.GetEndpoint(ctx, rule.LegacyEndpointID)
 	if err != nil {
@@ -525,24 +887,22 @@ func (s *bridgeService) processParallelRequest(ctx context.Context, request *dom
 		return nil, err
 	}
 
-	modernEndpoint, err := s.GetEndpoint(ctx, rule.ModernEndpointID)
+	modernEndpoint, err := s.GetEndpoint(ctx, mirror.MirrorEndpointID(request.Method, rule.ModernEndpointID))
 	if err != nil {
 		s.logger.WithContext(ctx).Error("modern endpoint not found", "error", err)
 		return nil, err
 	}
 
 	// 병렬 호출 및 비교
-	comparison, err := s.orchestrationSvc.ProcessParallelRequest(ctx, request, legacyEndpoint, modernEndpoint)
+	comparison, err := s.orchestrationSvc.ProcessParallelRequest(ctx, rule, request, legacyEndpoint, modernEndpoint)
 	if err != nil {
 		s.logger.WithContext(ctx).Error("parallel request processing failed", "error", err)
 		return nil, err
 	}
 
-	// 비교 결과 저장
-	if rule.ComparisonConfig.SaveComparisonHistory {
-		if err := s.comparisonRepo.SaveComparison(ctx, comparison); err != nil {
-			s.logger.WithContext(ctx).Warn("failed to save comparison result", "error", err)
-		}
+	// 비교 결과 저장 (비교가 비활성화된 규칙은 저장하지 않음)
+	if rule.ComparisonConfig.SaveComparisonHistory && !comparison.Skipped {
+		s.saveComparison(ctx, rule, comparison)
 	}
 
 	// 응답 결정 (레거시 우선)
@@ -554,7 +914,7 @@ func (s *bridgeService) processParallelRequest(ctx context.Context, request *dom
 		response = comparison.ModernResponse
 		response.Source = "modern"
 	} else {
-		return nil, fmt.Errorf("both API calls failed")
+		return nil, domain.ErrAllAPICallsFailed
 	}
 
 	response.SetDuration(start)
@@ -567,28 +927,9 @@ func (s *bridgeService) processParallelRequest(ctx context.Context, request *dom
 		"returned_source", response.Source,
 	)
 
-	// 전환 평가 (백그라운드)
-	go s.evaluateTransitionAsync(ctx, rule)
-
 	return response, nil
 }
 
-// evaluateTransitionAsync는 백그라운드에서 전환을 평가합니다.
-func (s *bridgeService) evaluateTransitionAsync(ctx context.Context, rule *domain.OrchestrationRule) {
-	canTransition, err := s.orchestrationSvc.EvaluateTransition(ctx, rule)
-	if err != nil {
-		s.logger.WithContext(ctx).Error("failed to evaluate transition", "rule_id", rule.ID, "error", err)
-		return
-	}
-
-	if canTransition {
-		s.logger.WithContext(ctx).Info("transition condition met, executing transition", "rule_id", rule.ID)
-		if err := s.orchestrationSvc.ExecuteTransition(ctx, rule, domain.MODERN_ONLY); err != nil {
-			s.logger.WithContext(ctx).Error("failed to execute transition", "rule_id", rule.ID, "error", err)
-		}
-	}
-}
-
 // generateCacheKey는 요청으로부터 캐시 키를 생성합니다.
 func (s *bridgeService) generateCacheKey(request *domain.Request) string {
 	return fmt.Sprintf("api_bridge:%s:%s", request.Method, request.Path)
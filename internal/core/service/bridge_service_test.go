@@ -5,6 +5,8 @@ import (
 	"demo-api-bridge/internal/core/domain"
 	"demo-api-bridge/internal/core/port"
 	"errors"
+	"io"
+	"strings"
 	"testing"
 	"time"
 
@@ -162,6 +164,22 @@ func (m *MockComparisonRepository) GetRecentComparisons(ctx context.Context, rou
 	return args.Get(0).([]*domain.APIComparison), args.Error(1)
 }
 
+func (m *MockComparisonRepository) GetComparison(ctx context.Context, id string) (*domain.APIComparison, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.APIComparison), args.Error(1)
+}
+
+func (m *MockComparisonRepository) GetComparisonInsights(ctx context.Context, routingRuleID string, from, to time.Time, bucket domain.InsightBucket, limit int) (*domain.ComparisonInsights, error) {
+	args := m.Called(ctx, routingRuleID, from, to, bucket, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ComparisonInsights), args.Error(1)
+}
+
 func (m *MockComparisonRepository) GetComparisonStatistics(ctx context.Context, routingRuleID string, from, to time.Time) (*port.ComparisonStatistics, error) {
 	args := m.Called(ctx, routingRuleID, from, to)
 	if args.Get(0) == nil {
@@ -170,12 +188,38 @@ func (m *MockComparisonRepository) GetComparisonStatistics(ctx context.Context,
 	return args.Get(0).(*port.ComparisonStatistics), args.Error(1)
 }
 
+func (m *MockComparisonRepository) StripComparisonBodies(ctx context.Context, before time.Time) (int, error) {
+	args := m.Called(ctx, before)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockComparisonRepository) RollupComparisons(ctx context.Context, before time.Time) (int, error) {
+	args := m.Called(ctx, before)
+	return args.Int(0), args.Error(1)
+}
+
 type MockOrchestrationService struct {
 	mock.Mock
 }
 
-func (m *MockOrchestrationService) ProcessParallelRequest(ctx context.Context, request *domain.Request, legacyEndpoint, modernEndpoint *domain.APIEndpoint) (*domain.APIComparison, error) {
-	args := m.Called(ctx, request, legacyEndpoint, modernEndpoint)
+func (m *MockOrchestrationService) ProcessParallelRequest(ctx context.Context, rule *domain.OrchestrationRule, request *domain.Request, legacyEndpoint, modernEndpoint *domain.APIEndpoint) (*domain.APIComparison, error) {
+	args := m.Called(ctx, rule, request, legacyEndpoint, modernEndpoint)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.APIComparison), args.Error(1)
+}
+
+func (m *MockOrchestrationService) CompareShadowResponse(ctx context.Context, rule *domain.OrchestrationRule, request *domain.Request, legacyResponse *domain.Response, modernEndpoint *domain.APIEndpoint) (*domain.APIComparison, error) {
+	args := m.Called(ctx, rule, request, legacyResponse, modernEndpoint)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.APIComparison), args.Error(1)
+}
+
+func (m *MockOrchestrationService) CompareCanaryResponse(ctx context.Context, rule *domain.OrchestrationRule, request *domain.Request, modernResponse *domain.Response, legacyEndpoint *domain.APIEndpoint) (*domain.APIComparison, error) {
+	args := m.Called(ctx, rule, request, modernResponse, legacyEndpoint)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -200,16 +244,43 @@ func (m *MockOrchestrationService) UpdateOrchestrationRule(ctx context.Context,
 	return args.Error(0)
 }
 
-func (m *MockOrchestrationService) EvaluateTransition(ctx context.Context, rule *domain.OrchestrationRule) (bool, error) {
+func (m *MockOrchestrationService) EvaluateTransition(ctx context.Context, rule *domain.OrchestrationRule) (*domain.TransitionEvaluation, error) {
 	args := m.Called(ctx, rule)
-	return args.Bool(0), args.Error(1)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.TransitionEvaluation), args.Error(1)
 }
 
-func (m *MockOrchestrationService) ExecuteTransition(ctx context.Context, rule *domain.OrchestrationRule, newMode domain.APIMode) error {
-	args := m.Called(ctx, rule, newMode)
+func (m *MockOrchestrationService) ExecuteTransition(ctx context.Context, rule *domain.OrchestrationRule, newMode domain.APIMode, trigger domain.TransitionTrigger, actor string) error {
+	args := m.Called(ctx, rule, newMode, trigger, actor)
 	return args.Error(0)
 }
 
+func (m *MockOrchestrationService) GetTransitionHistory(ctx context.Context, ruleID string, limit int) ([]*domain.TransitionEvent, error) {
+	args := m.Called(ctx, ruleID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.TransitionEvent), args.Error(1)
+}
+
+func (m *MockOrchestrationService) GetComparison(ctx context.Context, id string) (*domain.APIComparison, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.APIComparison), args.Error(1)
+}
+
+func (m *MockOrchestrationService) GetComparisonInsights(ctx context.Context, routingRuleID string, from, to time.Time, bucket domain.InsightBucket, limit int) (*domain.ComparisonInsights, error) {
+	args := m.Called(ctx, routingRuleID, from, to, bucket, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ComparisonInsights), args.Error(1)
+}
+
 type MockExternalAPIClient struct {
 	mock.Mock
 }
@@ -230,6 +301,14 @@ func (m *MockExternalAPIClient) SendWithRetry(ctx context.Context, endpoint *dom
 	return args.Get(0).(*domain.Response), args.Error(1)
 }
 
+func (m *MockExternalAPIClient) SendStream(ctx context.Context, endpoint *domain.APIEndpoint, request *domain.Request) (*domain.Response, error) {
+	args := m.Called(ctx, endpoint, request)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Response), args.Error(1)
+}
+
 type MockCacheRepository struct {
 	mock.Mock
 }
@@ -378,8 +457,8 @@ func TestBridgeService_ProcessRequest_InvalidRequest(t *testing.T) {
 	assert.Equal(t, domain.ErrInvalidRequestID, err)
 }
 
-// TestBridgeService_GetRoutingRule_CacheHit tests cache hit scenario
-func TestBridgeService_GetRoutingRule_CacheHit(t *testing.T) {
+// TestBridgeService_GetRoutingRule_IndexHit tests lookup from a prebuilt route index
+func TestBridgeService_GetRoutingRule_IndexHit(t *testing.T) {
 	// Given
 	service := NewBridgeService(
 		&MockRoutingRepository{},
@@ -397,20 +476,15 @@ func TestBridgeService_GetRoutingRule_CacheHit(t *testing.T) {
 	request := &domain.Request{
 		ID:     "test-request-id",
 		Method: "GET",
-		Path:   "/api/users",
+		Path:   "/api/users/42",
 	}
 
-	// Pre-populate cache
-	cachedRule := &domain.RoutingRule{
-		ID:         "cached-rule-1",
-		EndpointID: "endpoint-1",
-		Priority:   10,
-	}
-	cacheKey := service.generateRoutingCacheKey(request)
-	service.routingRuleCache[cacheKey] = &routingRuleCacheEntry{
-		rules:     []*domain.RoutingRule{cachedRule},
-		timestamp: time.Now(),
-	}
+	// Pre-build route index
+	index, err := domain.NewRouteIndex([]*domain.RoutingRule{
+		{ID: "indexed-rule-1", PathPattern: "/api/users/{id}", MethodPattern: "GET", EndpointID: "endpoint-1", Priority: 10, IsActive: true},
+	})
+	assert.NoError(t, err)
+	service.routeIndex.Store(index)
 
 	// When
 	rule, err := service.GetRoutingRule(ctx, request)
@@ -418,11 +492,11 @@ func TestBridgeService_GetRoutingRule_CacheHit(t *testing.T) {
 	// Then
 	assert.NoError(t, err)
 	assert.NotNil(t, rule)
-	assert.Equal(t, "cached-rule-1", rule.ID)
+	assert.Equal(t, "indexed-rule-1", rule.ID)
 }
 
-// TestBridgeService_GetRoutingRule_DBLookup tests DB lookup with cache miss
-func TestBridgeService_GetRoutingRule_DBLookup(t *testing.T) {
+// TestBridgeService_GetRoutingRule_BuildsIndexOnce tests that the index is built lazily from FindAll only once
+func TestBridgeService_GetRoutingRule_BuildsIndexOnce(t *testing.T) {
 	// Given
 	mockRoutingRepo := &MockRoutingRepository{}
 	mockLogger := &MockLogger{}
@@ -447,29 +521,116 @@ func TestBridgeService_GetRoutingRule_DBLookup(t *testing.T) {
 	}
 
 	dbRule := &domain.RoutingRule{
-		ID:         "db-rule-1",
-		EndpointID: "endpoint-1",
-		Priority:   5,
+		ID:            "db-rule-1",
+		PathPattern:   "/api/users",
+		MethodPattern: "*",
+		EndpointID:    "endpoint-1",
+		Priority:      5,
+		IsActive:      true,
 	}
 
-	mockRoutingRepo.On("FindMatchingRules", ctx, request).Return([]*domain.RoutingRule{dbRule}, nil)
+	mockLogger.On("WithContext", ctx).Return(mockLogger)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	mockRoutingRepo.On("FindAll", ctx).Return([]*domain.RoutingRule{dbRule}, nil).Once()
 
 	// When
-	rule, err := service.GetRoutingRule(ctx, request)
+	first, err1 := service.GetRoutingRule(ctx, request)
+	second, err2 := service.GetRoutingRule(ctx, request)
 
 	// Then
-	assert.NoError(t, err)
-	assert.NotNil(t, rule)
-	assert.Equal(t, "db-rule-1", rule.ID)
+	assert.NoError(t, err1)
+	assert.NoError(t, err2)
+	assert.Equal(t, "db-rule-1", first.ID)
+	assert.Equal(t, "db-rule-1", second.ID)
 	mockRoutingRepo.AssertExpectations(t)
+	assert.Equal(t, 1, service.routeIndex.Load().Size())
+}
 
-	// Verify cache was populated
-	cacheKey := service.generateRoutingCacheKey(request)
-	service.routingRuleCacheMu.RLock()
-	cachedEntry := service.routingRuleCache[cacheKey]
-	service.routingRuleCacheMu.RUnlock()
-	assert.NotNil(t, cachedEntry)
-	assert.Len(t, cachedEntry.rules, 1)
+// TestBridgeService_RefreshRoutingRules_SwapsIndex tests that refreshing replaces the route index
+func TestBridgeService_RefreshRoutingRules_SwapsIndex(t *testing.T) {
+	// Given
+	mockRoutingRepo := &MockRoutingRepository{}
+	mockLogger := &MockLogger{}
+
+	service := NewBridgeService(
+		mockRoutingRepo,
+		&MockEndpointRepository{},
+		&MockOrchestrationRepository{},
+		&MockComparisonRepository{},
+		&MockOrchestrationService{},
+		&MockExternalAPIClient{},
+		&MockCacheRepository{},
+		mockLogger,
+		&MockMetricsCollector{},
+	)
+
+	ctx := context.Background()
+	request := &domain.Request{
+		ID:     "test-request-id",
+		Method: "GET",
+		Path:   "/api/orders/1",
+	}
+
+	before := []*domain.RoutingRule{
+		{ID: "rule-old", PathPattern: "/api/orders/*", MethodPattern: "*", EndpointID: "endpoint-1", Priority: 10, IsActive: true},
+	}
+	after := []*domain.RoutingRule{
+		{ID: "rule-new", PathPattern: "/api/orders/{id}", MethodPattern: "GET", EndpointID: "endpoint-2", Priority: 10, IsActive: true},
+	}
+
+	mockLogger.On("WithContext", ctx).Return(mockLogger)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	mockRoutingRepo.On("FindAll", ctx).Return(before, nil).Once()
+	mockRoutingRepo.On("FindAll", ctx).Return(after, nil).Once()
+
+	// When
+	assert.NoError(t, service.RefreshRoutingRules(ctx))
+	oldRule, _ := service.GetRoutingRule(ctx, request)
+	assert.NoError(t, service.RefreshRoutingRules(ctx))
+	newRule, _ := service.GetRoutingRule(ctx, request)
+
+	// Then
+	assert.Equal(t, "rule-old", oldRule.ID)
+	assert.Equal(t, "rule-new", newRule.ID)
+	mockRoutingRepo.AssertExpectations(t)
+}
+
+// TestBridgeService_RefreshRoutingRules_KeepsIndexOnError tests that a failed refresh keeps the previous index
+func TestBridgeService_RefreshRoutingRules_KeepsIndexOnError(t *testing.T) {
+	// Given
+	mockRoutingRepo := &MockRoutingRepository{}
+	mockLogger := &MockLogger{}
+
+	service := NewBridgeService(
+		mockRoutingRepo,
+		&MockEndpointRepository{},
+		&MockOrchestrationRepository{},
+		&MockComparisonRepository{},
+		&MockOrchestrationService{},
+		&MockExternalAPIClient{},
+		&MockCacheRepository{},
+		mockLogger,
+		&MockMetricsCollector{},
+	).(*bridgeService)
+
+	ctx := context.Background()
+	rules := []*domain.RoutingRule{
+		{ID: "rule-1", PathPattern: "/api/users", MethodPattern: "*", EndpointID: "endpoint-1", IsActive: true},
+	}
+
+	mockLogger.On("WithContext", ctx).Return(mockLogger)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	mockRoutingRepo.On("FindAll", ctx).Return(rules, nil).Once()
+	mockRoutingRepo.On("FindAll", ctx).Return([]*domain.RoutingRule(nil), errors.New("db down")).Once()
+
+	// When
+	assert.NoError(t, service.RefreshRoutingRules(ctx))
+	previous := service.routeIndex.Load()
+	err := service.RefreshRoutingRules(ctx)
+
+	// Then
+	assert.Error(t, err)
+	assert.Same(t, previous, service.routeIndex.Load())
 }
 
 // TestBridgeService_GetRoutingRule_DefaultFallback tests fallback to default legacy endpoint
@@ -507,7 +668,7 @@ func TestBridgeService_GetRoutingRule_DefaultFallback(t *testing.T) {
 
 	mockLogger.On("WithContext", ctx).Return(mockLogger)
 	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
-	mockRoutingRepo.On("FindMatchingRules", ctx, request).Return([]*domain.RoutingRule{}, nil)
+	mockRoutingRepo.On("FindAll", ctx).Return([]*domain.RoutingRule{}, nil)
 	mockEndpointRepo.On("FindDefaultLegacyEndpoint", ctx).Return(defaultEndpoint, nil)
 	mockMetrics.On("RecordDefaultRoutingUsed", "GET", "/api/users").Return()
 
@@ -528,6 +689,7 @@ func TestBridgeService_GetRoutingRule_DefaultFallback(t *testing.T) {
 func TestBridgeService_GetRoutingRule_HighestPriority(t *testing.T) {
 	// Given
 	mockRoutingRepo := &MockRoutingRepository{}
+	mockLogger := &MockLogger{}
 
 	service := NewBridgeService(
 		mockRoutingRepo,
@@ -537,7 +699,7 @@ func TestBridgeService_GetRoutingRule_HighestPriority(t *testing.T) {
 		&MockOrchestrationService{},
 		&MockExternalAPIClient{},
 		&MockCacheRepository{},
-		&MockLogger{},
+		mockLogger,
 		&MockMetricsCollector{},
 	)
 
@@ -549,12 +711,14 @@ func TestBridgeService_GetRoutingRule_HighestPriority(t *testing.T) {
 	}
 
 	rules := []*domain.RoutingRule{
-		{ID: "rule-1", Priority: 10},
-		{ID: "rule-2", Priority: 5}, // Highest priority (lowest number)
-		{ID: "rule-3", Priority: 15},
+		{ID: "rule-1", PathPattern: "/api/*", MethodPattern: "*", Priority: 10, IsActive: true},
+		{ID: "rule-2", PathPattern: "/api/users", MethodPattern: "GET", Priority: 5, IsActive: true}, // Highest priority (lowest number)
+		{ID: "rule-3", PathPattern: "/api/**", MethodPattern: "*", Priority: 15, IsActive: true},
 	}
 
-	mockRoutingRepo.On("FindMatchingRules", ctx, request).Return(rules, nil)
+	mockLogger.On("WithContext", ctx).Return(mockLogger)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	mockRoutingRepo.On("FindAll", ctx).Return(rules, nil)
 
 	// When
 	selectedRule, err := service.GetRoutingRule(ctx, request)
@@ -667,9 +831,12 @@ func TestBridgeService_ProcessRequest_SingleAPI_Success(t *testing.T) {
 	}
 
 	routingRule := &domain.RoutingRule{
-		ID:           "rule-1",
-		EndpointID:   "endpoint-1",
-		CacheEnabled: false,
+		ID:            "rule-1",
+		PathPattern:   "/api/users",
+		MethodPattern: "*",
+		EndpointID:    "endpoint-1",
+		IsActive:      true,
+		CacheEnabled:  false,
 	}
 
 	endpoint := &domain.APIEndpoint{
@@ -688,7 +855,8 @@ func TestBridgeService_ProcessRequest_SingleAPI_Success(t *testing.T) {
 
 	mockLogger.On("WithContext", ctx).Return(mockLogger)
 	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
-	mockRoutingRepo.On("FindMatchingRules", ctx, request).Return([]*domain.RoutingRule{routingRule}, nil)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	mockRoutingRepo.On("FindAll", ctx).Return([]*domain.RoutingRule{routingRule}, nil)
 	mockOrchestrationRepo.On("FindByRoutingRuleID", ctx, "rule-1").Return(nil, errors.New("not found"))
 	mockEndpointRepo.On("FindByID", ctx, "endpoint-1").Return(endpoint, nil)
 	mockExternalAPI.On("SendWithRetry", ctx, endpoint, request).Return(expectedResponse, nil)
@@ -708,6 +876,79 @@ func TestBridgeService_ProcessRequest_SingleAPI_Success(t *testing.T) {
 	mockExternalAPI.AssertExpectations(t)
 }
 
+// TestBridgeService_ProcessRequest_RewritesPath tests path parameter capture and upstream path rewrite
+func TestBridgeService_ProcessRequest_RewritesPath(t *testing.T) {
+	// Given
+	mockRoutingRepo := &MockRoutingRepository{}
+	mockEndpointRepo := &MockEndpointRepository{}
+	mockOrchestrationRepo := &MockOrchestrationRepository{}
+	mockExternalAPI := &MockExternalAPIClient{}
+	mockLogger := &MockLogger{}
+	mockMetrics := &MockMetricsCollector{}
+
+	service := NewBridgeService(
+		mockRoutingRepo,
+		mockEndpointRepo,
+		mockOrchestrationRepo,
+		&MockComparisonRepository{},
+		&MockOrchestrationService{},
+		mockExternalAPI,
+		&MockCacheRepository{},
+		mockLogger,
+		mockMetrics,
+	)
+
+	ctx := context.Background()
+	request := &domain.Request{
+		ID:     "test-request-id",
+		Method: "GET",
+		Path:   "/api/v1/users/42/orders/7",
+	}
+
+	routingRule := &domain.RoutingRule{
+		ID:              "rule-1",
+		PathPattern:     "/api/v1/users/{id}/orders/*",
+		RewriteTemplate: "/v2/customers/{id}/orders/{*}",
+		MethodPattern:   "GET",
+		EndpointID:      "endpoint-1",
+		IsActive:        true,
+	}
+
+	endpoint := &domain.APIEndpoint{
+		ID:       "endpoint-1",
+		BaseURL:  "https://api.example.com",
+		IsActive: true,
+	}
+
+	expectedResponse := &domain.Response{
+		RequestID:  "test-request-id",
+		StatusCode: 200,
+		Body:       []byte(`{"orders": []}`),
+	}
+
+	mockLogger.On("WithContext", ctx).Return(mockLogger)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	mockLogger.On("Debug", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	mockRoutingRepo.On("FindAll", ctx).Return([]*domain.RoutingRule{routingRule}, nil)
+	mockOrchestrationRepo.On("FindByRoutingRuleID", ctx, "rule-1").Return(nil, errors.New("not found"))
+	mockEndpointRepo.On("FindByID", ctx, "endpoint-1").Return(endpoint, nil)
+	mockExternalAPI.On("SendWithRetry", ctx, endpoint, request).Return(expectedResponse, nil)
+	mockMetrics.On("RecordExternalAPICall", mock.Anything, true, mock.AnythingOfType("time.Duration")).Return()
+	mockMetrics.On("RecordRequest", "GET", "/api/v1/users/42/orders/7", 200, mock.AnythingOfType("time.Duration")).Return()
+
+	// When
+	response, err := service.ProcessRequest(ctx, request)
+
+	// Then
+	assert.NoError(t, err)
+	assert.NotNil(t, response)
+	assert.Equal(t, "rule-1", request.RoutingRuleID)
+	assert.Equal(t, map[string]string{"id": "42", "*": "7"}, request.PathParams)
+	assert.Equal(t, "/v2/customers/42/orders/7", request.UpstreamPath)
+	mockExternalAPI.AssertExpectations(t)
+}
+
 // TestBridgeService_ProcessRequest_CacheHit tests cache hit scenario
 func TestBridgeService_ProcessRequest_CacheHit(t *testing.T) {
 	// Given
@@ -738,10 +979,13 @@ func TestBridgeService_ProcessRequest_CacheHit(t *testing.T) {
 	}
 
 	routingRule := &domain.RoutingRule{
-		ID:           "rule-1",
-		EndpointID:   "endpoint-1",
-		CacheEnabled: true,
-		CacheTTL:     300,
+		ID:            "rule-1",
+		PathPattern:   "/api/users",
+		MethodPattern: "*",
+		EndpointID:    "endpoint-1",
+		IsActive:      true,
+		CacheEnabled:  true,
+		CacheTTL:      300,
 	}
 
 	endpoint := &domain.APIEndpoint{
@@ -755,7 +999,7 @@ func TestBridgeService_ProcessRequest_CacheHit(t *testing.T) {
 	mockLogger.On("WithContext", ctx).Return(mockLogger)
 	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
 	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
-	mockRoutingRepo.On("FindMatchingRules", ctx, request).Return([]*domain.RoutingRule{routingRule}, nil)
+	mockRoutingRepo.On("FindAll", ctx).Return([]*domain.RoutingRule{routingRule}, nil)
 	mockOrchestrationRepo.On("FindByRoutingRuleID", ctx, "rule-1").Return(nil, errors.New("not found"))
 	mockEndpointRepo.On("FindByID", ctx, "endpoint-1").Return(endpoint, nil)
 	mockCache.On("Get", ctx, "api_bridge:GET:/api/users").Return(cachedData, nil)
@@ -775,6 +1019,100 @@ func TestBridgeService_ProcessRequest_CacheHit(t *testing.T) {
 	mockCache.AssertExpectations(t)
 }
 
+// TestBridgeService_ProcessRequest_SingleAPIStreaming tests that single API requests
+// stream the body unless the rule caches responses
+func TestBridgeService_ProcessRequest_SingleAPIStreaming(t *testing.T) {
+	tests := []struct {
+		name         string
+		cacheEnabled bool
+		method       string
+	}{
+		{"cache disabled", false, "SendStream"},
+		{"cache enabled", true, "SendWithRetry"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			mockRoutingRepo := &MockRoutingRepository{}
+			mockEndpointRepo := &MockEndpointRepository{}
+			mockOrchestrationRepo := &MockOrchestrationRepository{}
+			mockExternalAPI := &MockExternalAPIClient{}
+			mockCache := &MockCacheRepository{}
+			mockLogger := &MockLogger{}
+			mockMetrics := &MockMetricsCollector{}
+
+			service := NewBridgeService(
+				mockRoutingRepo,
+				mockEndpointRepo,
+				mockOrchestrationRepo,
+				&MockComparisonRepository{},
+				&MockOrchestrationService{},
+				mockExternalAPI,
+				mockCache,
+				mockLogger,
+				mockMetrics,
+				WithStreaming(true),
+			)
+
+			ctx := context.Background()
+			request := domain.NewRequest("test-request-id", "POST", "/api/uploads")
+			request.BodyStream = strings.NewReader("large payload")
+			request.ContentLength = -1
+
+			routingRule := &domain.RoutingRule{
+				ID:            "rule-1",
+				PathPattern:   "/api/uploads",
+				MethodPattern: "*",
+				EndpointID:    "endpoint-1",
+				IsActive:      true,
+				CacheEnabled:  tt.cacheEnabled,
+				CacheTTL:      300,
+			}
+
+			endpoint := &domain.APIEndpoint{
+				ID:       "endpoint-1",
+				BaseURL:  "https://api.example.com",
+				IsActive: true,
+			}
+
+			expectedResponse := &domain.Response{
+				RequestID:  "test-request-id",
+				StatusCode: 500,
+				Body:       []byte(`{"error": "upstream"}`),
+			}
+
+			mockLogger.On("WithContext", ctx).Return(mockLogger)
+			mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+			mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+			mockRoutingRepo.On("FindAll", ctx).Return([]*domain.RoutingRule{routingRule}, nil)
+			mockOrchestrationRepo.On("FindByRoutingRuleID", ctx, "rule-1").Return(nil, errors.New("not found"))
+			mockEndpointRepo.On("FindByID", ctx, "endpoint-1").Return(endpoint, nil)
+			mockCache.On("Get", ctx, mock.Anything).Return(nil, errors.New("cache miss"))
+			mockExternalAPI.On(tt.method, ctx, endpoint, request).Return(expectedResponse, nil)
+			mockMetrics.On("RecordCacheHit", false).Return()
+			mockMetrics.On("RecordExternalAPICall", mock.Anything, true, mock.AnythingOfType("time.Duration")).Return()
+			mockMetrics.On("RecordRequest", "POST", "/api/uploads", 500, mock.AnythingOfType("time.Duration")).Return()
+
+			// When
+			response, err := service.ProcessRequest(ctx, request)
+
+			// Then
+			assert.NoError(t, err)
+			assert.Equal(t, 500, response.StatusCode)
+			if tt.cacheEnabled {
+				assert.Nil(t, request.BodyStream)
+				assert.Equal(t, []byte("large payload"), request.Body)
+			} else {
+				assert.NotNil(t, request.BodyStream, "request body should be streamed, not buffered")
+				assert.Nil(t, request.Body)
+				mockCache.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+			}
+			mockExternalAPI.AssertExpectations(t)
+		})
+	}
+}
+
 // TestBridgeService_ProcessRequest_Parallel_Success tests parallel request flow
 func TestBridgeService_ProcessRequest_Parallel_Success(t *testing.T) {
 	// Given
@@ -806,8 +1144,11 @@ func TestBridgeService_ProcessRequest_Parallel_Success(t *testing.T) {
 	}
 
 	routingRule := &domain.RoutingRule{
-		ID:         "rule-1",
-		EndpointID: "endpoint-1",
+		ID:            "rule-1",
+		PathPattern:   "/api/users",
+		MethodPattern: "*",
+		EndpointID:    "endpoint-1",
+		IsActive:      true,
 	}
 
 	orchestrationRule := &domain.OrchestrationRule{
@@ -851,14 +1192,13 @@ func TestBridgeService_ProcessRequest_Parallel_Success(t *testing.T) {
 	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
 	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
 	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
-	mockRoutingRepo.On("FindMatchingRules", ctx, request).Return([]*domain.RoutingRule{routingRule}, nil)
+	mockRoutingRepo.On("FindAll", ctx).Return([]*domain.RoutingRule{routingRule}, nil)
 	mockOrchestrationRepo.On("FindByRoutingRuleID", ctx, "rule-1").Return(orchestrationRule, nil)
 	mockEndpointRepo.On("FindByID", ctx, "legacy-endpoint-1").Return(legacyEndpoint, nil)
 	mockEndpointRepo.On("FindByID", ctx, "modern-endpoint-1").Return(modernEndpoint, nil)
-	mockOrchestrationSvc.On("ProcessParallelRequest", ctx, request, legacyEndpoint, modernEndpoint).Return(comparison, nil)
+	mockOrchestrationSvc.On("ProcessParallelRequest", ctx, orchestrationRule, request, legacyEndpoint, modernEndpoint).Return(comparison, nil)
 	mockComparisonRepo.On("SaveComparison", ctx, comparison).Return(nil)
 	mockMetrics.On("RecordRequest", "GET", "/api/users", 200, mock.AnythingOfType("time.Duration")).Return()
-	mockOrchestrationSvc.On("EvaluateTransition", mock.Anything, mock.Anything).Return(false, nil).Maybe()
 
 	// When
 	response, err := service.ProcessRequest(ctx, request)
@@ -903,8 +1243,11 @@ func TestBridgeService_ProcessRequest_LegacyOnly(t *testing.T) {
 	}
 
 	routingRule := &domain.RoutingRule{
-		ID:         "rule-1",
-		EndpointID: "endpoint-1",
+		ID:            "rule-1",
+		PathPattern:   "/api/users",
+		MethodPattern: "*",
+		EndpointID:    "endpoint-1",
+		IsActive:      true,
 	}
 
 	orchestrationRule := &domain.OrchestrationRule{
@@ -929,7 +1272,7 @@ func TestBridgeService_ProcessRequest_LegacyOnly(t *testing.T) {
 	mockLogger.On("WithContext", ctx).Return(mockLogger)
 	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
 	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
-	mockRoutingRepo.On("FindMatchingRules", ctx, request).Return([]*domain.RoutingRule{routingRule}, nil)
+	mockRoutingRepo.On("FindAll", ctx).Return([]*domain.RoutingRule{routingRule}, nil)
 	mockOrchestrationRepo.On("FindByRoutingRuleID", ctx, "rule-1").Return(orchestrationRule, nil)
 	mockEndpointRepo.On("FindByID", ctx, "legacy-endpoint-1").Return(legacyEndpoint, nil)
 	mockExternalAPI.On("SendWithRetry", ctx, legacyEndpoint, request).Return(expectedResponse, nil)
@@ -945,6 +1288,100 @@ func TestBridgeService_ProcessRequest_LegacyOnly(t *testing.T) {
 	mockExternalAPI.AssertExpectations(t)
 }
 
+// TestBridgeService_ProcessRequest_LegacyOnlyStreaming tests that legacy-only requests
+// stream the request body when streaming is enabled and buffer it otherwise
+func TestBridgeService_ProcessRequest_LegacyOnlyStreaming(t *testing.T) {
+	tests := []struct {
+		name      string
+		streaming bool
+		method    string
+	}{
+		{"streaming enabled", true, "SendStream"},
+		{"streaming disabled", false, "SendWithRetry"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			mockRoutingRepo := &MockRoutingRepository{}
+			mockEndpointRepo := &MockEndpointRepository{}
+			mockOrchestrationRepo := &MockOrchestrationRepository{}
+			mockExternalAPI := &MockExternalAPIClient{}
+			mockLogger := &MockLogger{}
+			mockMetrics := &MockMetricsCollector{}
+
+			service := NewBridgeService(
+				mockRoutingRepo,
+				mockEndpointRepo,
+				mockOrchestrationRepo,
+				&MockComparisonRepository{},
+				&MockOrchestrationService{},
+				mockExternalAPI,
+				&MockCacheRepository{},
+				mockLogger,
+				mockMetrics,
+				WithStreaming(tt.streaming),
+			)
+
+			ctx := context.Background()
+			request := domain.NewRequest("test-request-id", "POST", "/api/uploads")
+			request.BodyStream = strings.NewReader("large payload")
+			request.ContentLength = -1
+
+			routingRule := &domain.RoutingRule{
+				ID:            "rule-1",
+				PathPattern:   "/api/uploads",
+				MethodPattern: "*",
+				EndpointID:    "endpoint-1",
+				IsActive:      true,
+			}
+
+			orchestrationRule := &domain.OrchestrationRule{
+				ID:               "orch-1",
+				RoutingRuleID:    "rule-1",
+				LegacyEndpointID: "legacy-endpoint-1",
+				CurrentMode:      domain.LEGACY_ONLY,
+			}
+
+			legacyEndpoint := &domain.APIEndpoint{
+				ID:       "legacy-endpoint-1",
+				BaseURL:  "https://legacy-api.example.com",
+				IsActive: true,
+			}
+
+			expectedResponse := &domain.Response{
+				RequestID:  "test-request-id",
+				StatusCode: 200,
+				BodyStream: io.NopCloser(strings.NewReader(`{"data": "legacy"}`)),
+			}
+
+			mockLogger.On("WithContext", ctx).Return(mockLogger)
+			mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+			mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+			mockRoutingRepo.On("FindAll", ctx).Return([]*domain.RoutingRule{routingRule}, nil)
+			mockOrchestrationRepo.On("FindByRoutingRuleID", ctx, "rule-1").Return(orchestrationRule, nil)
+			mockEndpointRepo.On("FindByID", ctx, "legacy-endpoint-1").Return(legacyEndpoint, nil)
+			mockExternalAPI.On(tt.method, ctx, legacyEndpoint, request).Return(expectedResponse, nil)
+			mockMetrics.On("RecordRequest", "POST", "/api/uploads", 200, mock.AnythingOfType("time.Duration")).Return()
+
+			// When
+			response, err := service.ProcessRequest(ctx, request)
+
+			// Then
+			assert.NoError(t, err)
+			assert.Equal(t, 200, response.StatusCode)
+			if tt.streaming {
+				assert.NotNil(t, request.BodyStream, "request body should be streamed, not buffered")
+				assert.Nil(t, request.Body)
+			} else {
+				assert.Nil(t, request.BodyStream)
+				assert.Equal(t, []byte("large payload"), request.Body)
+			}
+			mockExternalAPI.AssertExpectations(t)
+		})
+	}
+}
+
 // TestBridgeService_ProcessRequest_ModernOnly tests modern-only mode
 func TestBridgeService_ProcessRequest_ModernOnly(t *testing.T) {
 	// Given
@@ -975,8 +1412,11 @@ func TestBridgeService_ProcessRequest_ModernOnly(t *testing.T) {
 	}
 
 	routingRule := &domain.RoutingRule{
-		ID:         "rule-1",
-		EndpointID: "endpoint-1",
+		ID:            "rule-1",
+		PathPattern:   "/api/users",
+		MethodPattern: "*",
+		EndpointID:    "endpoint-1",
+		IsActive:      true,
 	}
 
 	orchestrationRule := &domain.OrchestrationRule{
@@ -1001,7 +1441,7 @@ func TestBridgeService_ProcessRequest_ModernOnly(t *testing.T) {
 	mockLogger.On("WithContext", ctx).Return(mockLogger)
 	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
 	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
-	mockRoutingRepo.On("FindMatchingRules", ctx, request).Return([]*domain.RoutingRule{routingRule}, nil)
+	mockRoutingRepo.On("FindAll", ctx).Return([]*domain.RoutingRule{routingRule}, nil)
 	mockOrchestrationRepo.On("FindByRoutingRuleID", ctx, "rule-1").Return(orchestrationRule, nil)
 	mockEndpointRepo.On("FindByID", ctx, "modern-endpoint-1").Return(modernEndpoint, nil)
 	mockExternalAPI.On("SendWithRetry", ctx, modernEndpoint, request).Return(expectedResponse, nil)
@@ -1017,6 +1457,611 @@ func TestBridgeService_ProcessRequest_ModernOnly(t *testing.T) {
 	mockExternalAPI.AssertExpectations(t)
 }
 
+// TestBridgeService_ProcessRequest_Canary tests canary group routing and per-group metrics
+func TestBridgeService_ProcessRequest_Canary(t *testing.T) {
+	tests := []struct {
+		name       string
+		percentage int
+		endpointID string
+		group      string
+	}{
+		{"legacy group", 0, "legacy-endpoint-1", "legacy"},
+		{"modern group", 100, "modern-endpoint-1", "modern"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			mockRoutingRepo := &MockRoutingRepository{}
+			mockEndpointRepo := &MockEndpointRepository{}
+			mockOrchestrationRepo := &MockOrchestrationRepository{}
+			mockExternalAPI := &MockExternalAPIClient{}
+			mockLogger := &MockLogger{}
+			mockMetrics := &MockMetricsCollector{}
+
+			service := NewBridgeService(
+				mockRoutingRepo,
+				mockEndpointRepo,
+				mockOrchestrationRepo,
+				&MockComparisonRepository{},
+				&MockOrchestrationService{},
+				mockExternalAPI,
+				&MockCacheRepository{},
+				mockLogger,
+				mockMetrics,
+			)
+
+			ctx := context.Background()
+			request := &domain.Request{
+				ID:       "test-request-id",
+				Method:   "GET",
+				Path:     "/api/users",
+				ClientIP: "10.0.0.1",
+			}
+
+			routingRule := &domain.RoutingRule{
+				ID:            "rule-1",
+				PathPattern:   "/api/users",
+				MethodPattern: "*",
+				EndpointID:    "endpoint-1",
+				IsActive:      true,
+			}
+
+			orchestrationRule := &domain.OrchestrationRule{
+				ID:               "orch-1",
+				RoutingRuleID:    "rule-1",
+				LegacyEndpointID: "legacy-endpoint-1",
+				ModernEndpointID: "modern-endpoint-1",
+				CurrentMode:      domain.CANARY,
+				TransitionConfig: domain.TransitionConfig{
+					Canary: domain.CanaryConfig{Percentage: tt.percentage, StickyKeySource: domain.StickyKeyClientIP},
+				},
+			}
+
+			endpoint := &domain.APIEndpoint{
+				ID:       tt.endpointID,
+				BaseURL:  "https://api.example.com",
+				IsActive: true,
+			}
+
+			expectedResponse := &domain.Response{
+				RequestID:  "test-request-id",
+				StatusCode: 200,
+				Body:       []byte(`{"data": "ok"}`),
+			}
+
+			mockLogger.On("WithContext", ctx).Return(mockLogger)
+			mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+			mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+			mockRoutingRepo.On("FindAll", ctx).Return([]*domain.RoutingRule{routingRule}, nil)
+			mockOrchestrationRepo.On("FindByRoutingRuleID", ctx, "rule-1").Return(orchestrationRule, nil)
+			mockEndpointRepo.On("FindByID", ctx, tt.endpointID).Return(endpoint, nil)
+			mockExternalAPI.On("SendWithRetry", ctx, endpoint, request).Return(expectedResponse, nil)
+			mockMetrics.On("IncrementCounter", "canary_requests", map[string]string{
+				"rule_id": "orch-1",
+				"group":   tt.group,
+				"result":  "success",
+			}).Return()
+			mockMetrics.On("RecordHistogram", "canary_request_duration", mock.AnythingOfType("float64"), map[string]string{
+				"rule_id": "orch-1",
+				"group":   tt.group,
+			}).Return()
+			mockMetrics.On("RecordRequest", "GET", "/api/users", 200, mock.AnythingOfType("time.Duration")).Return()
+
+			// When
+			response, err := service.ProcessRequest(ctx, request)
+
+			// Then
+			assert.NoError(t, err)
+			assert.NotNil(t, response)
+			assert.Equal(t, tt.group, response.Source)
+			mockExternalAPI.AssertExpectations(t)
+			mockMetrics.AssertExpectations(t)
+		})
+	}
+}
+
+// TestBridgeService_ProcessRequest_CanaryRampComparison tests that modern group responses of a ramping rule are compared with legacy in the background
+func TestBridgeService_ProcessRequest_CanaryRampComparison(t *testing.T) {
+	// Given
+	mockRoutingRepo := &MockRoutingRepository{}
+	mockEndpointRepo := &MockEndpointRepository{}
+	mockOrchestrationRepo := &MockOrchestrationRepository{}
+	mockComparisonRepo := &MockComparisonRepository{}
+	mockOrchestrationSvc := &MockOrchestrationService{}
+	mockExternalAPI := &MockExternalAPIClient{}
+	mockLogger := &MockLogger{}
+	mockMetrics := &MockMetricsCollector{}
+
+	pool := NewShadowPool(ShadowPoolConfig{Workers: 1, QueueSize: 10}, mockLogger, mockMetrics)
+
+	service := NewBridgeService(
+		mockRoutingRepo,
+		mockEndpointRepo,
+		mockOrchestrationRepo,
+		mockComparisonRepo,
+		mockOrchestrationSvc,
+		mockExternalAPI,
+		&MockCacheRepository{},
+		mockLogger,
+		mockMetrics,
+		WithShadowPool(pool),
+	)
+
+	ctx := context.Background()
+	request := &domain.Request{
+		ID:       "test-request-id",
+		Method:   "GET",
+		Path:     "/api/users",
+		ClientIP: "10.0.0.1",
+	}
+
+	routingRule := &domain.RoutingRule{
+		ID:            "rule-1",
+		PathPattern:   "/api/users",
+		MethodPattern: "*",
+		EndpointID:    "endpoint-1",
+		IsActive:      true,
+	}
+
+	orchestrationRule := &domain.OrchestrationRule{
+		ID:               "orch-1",
+		RoutingRuleID:    "rule-1",
+		LegacyEndpointID: "legacy-endpoint-1",
+		ModernEndpointID: "modern-endpoint-1",
+		CurrentMode:      domain.CANARY,
+		TransitionConfig: domain.TransitionConfig{
+			Canary:   domain.CanaryConfig{Percentage: 100, StickyKeySource: domain.StickyKeyClientIP},
+			RampPlan: []domain.RampStage{{Percentage: 100}},
+		},
+		ComparisonConfig: domain.ComparisonConfig{Enabled: true, SaveComparisonHistory: true},
+	}
+
+	legacyEndpoint := &domain.APIEndpoint{ID: "legacy-endpoint-1", BaseURL: "https://legacy-api.example.com", IsActive: true}
+	modernEndpoint := &domain.APIEndpoint{ID: "modern-endpoint-1", BaseURL: "https://modern-api.example.com", IsActive: true}
+
+	modernResponse := &domain.Response{
+		RequestID:  "test-request-id",
+		StatusCode: 200,
+		Body:       []byte(`{"data": "modern"}`),
+	}
+	comparison := &domain.APIComparison{ID: "cmp-1", RequestID: "test-request-id", MatchRate: 1.0}
+
+	mockLogger.On("WithContext", mock.Anything).Return(mockLogger)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	mockRoutingRepo.On("FindAll", ctx).Return([]*domain.RoutingRule{routingRule}, nil)
+	mockOrchestrationRepo.On("FindByRoutingRuleID", ctx, "rule-1").Return(orchestrationRule, nil)
+	mockEndpointRepo.On("FindByID", ctx, "legacy-endpoint-1").Return(legacyEndpoint, nil)
+	mockEndpointRepo.On("FindByID", ctx, "modern-endpoint-1").Return(modernEndpoint, nil)
+	mockExternalAPI.On("SendWithRetry", ctx, modernEndpoint, request).Return(modernResponse, nil)
+	mockMetrics.On("IncrementCounter", "canary_requests", mock.AnythingOfType("map[string]string")).Return()
+	mockMetrics.On("RecordHistogram", "canary_request_duration", mock.AnythingOfType("float64"), mock.AnythingOfType("map[string]string")).Return()
+	mockMetrics.On("RecordRequest", "GET", "/api/users", 200, mock.AnythingOfType("time.Duration")).Return()
+	mockOrchestrationSvc.On("CompareCanaryResponse", mock.Anything, orchestrationRule, request, modernResponse, legacyEndpoint).Return(comparison, nil)
+	mockComparisonRepo.On("SaveComparison", mock.Anything, comparison).Return(nil)
+
+	// When
+	response, err := service.ProcessRequest(ctx, request)
+	assert.NoError(t, pool.Close(context.Background()))
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "modern", response.Source)
+	mockExternalAPI.AssertNotCalled(t, "SendWithRetry", mock.Anything, legacyEndpoint, mock.Anything)
+	mockOrchestrationSvc.AssertExpectations(t)
+	mockComparisonRepo.AssertExpectations(t)
+}
+
+// TestBridgeService_ProcessRequest_ModernOnlyRollbackSampling tests that sampled modern-only responses are compared with legacy in the background
+func TestBridgeService_ProcessRequest_ModernOnlyRollbackSampling(t *testing.T) {
+	// Given
+	mockRoutingRepo := &MockRoutingRepository{}
+	mockEndpointRepo := &MockEndpointRepository{}
+	mockOrchestrationRepo := &MockOrchestrationRepository{}
+	mockComparisonRepo := &MockComparisonRepository{}
+	mockOrchestrationSvc := &MockOrchestrationService{}
+	mockExternalAPI := &MockExternalAPIClient{}
+	mockLogger := &MockLogger{}
+	mockMetrics := &MockMetricsCollector{}
+
+	pool := NewShadowPool(ShadowPoolConfig{Workers: 1, QueueSize: 10}, mockLogger, mockMetrics)
+
+	service := NewBridgeService(
+		mockRoutingRepo,
+		mockEndpointRepo,
+		mockOrchestrationRepo,
+		mockComparisonRepo,
+		mockOrchestrationSvc,
+		mockExternalAPI,
+		&MockCacheRepository{},
+		mockLogger,
+		mockMetrics,
+		WithShadowPool(pool),
+	)
+
+	ctx := context.Background()
+	request := &domain.Request{
+		ID:     "test-request-id",
+		Method: "GET",
+		Path:   "/api/users",
+	}
+
+	routingRule := &domain.RoutingRule{
+		ID:            "rule-1",
+		PathPattern:   "/api/users",
+		MethodPattern: "*",
+		EndpointID:    "endpoint-1",
+		IsActive:      true,
+	}
+
+	orchestrationRule := &domain.OrchestrationRule{
+		ID:               "orch-1",
+		RoutingRuleID:    "rule-1",
+		LegacyEndpointID: "legacy-endpoint-1",
+		ModernEndpointID: "modern-endpoint-1",
+		CurrentMode:      domain.MODERN_ONLY,
+		TransitionConfig: domain.TransitionConfig{
+			Rollback: domain.RollbackConfig{SampleRate: 1.0},
+		},
+		ComparisonConfig: domain.ComparisonConfig{Enabled: true, SaveComparisonHistory: true},
+	}
+
+	legacyEndpoint := &domain.APIEndpoint{ID: "legacy-endpoint-1", BaseURL: "https://legacy-api.example.com", IsActive: true}
+	modernEndpoint := &domain.APIEndpoint{ID: "modern-endpoint-1", BaseURL: "https://modern-api.example.com", IsActive: true}
+
+	modernResponse := &domain.Response{
+		RequestID:  "test-request-id",
+		StatusCode: 200,
+		Body:       []byte(`{"data": "modern"}`),
+	}
+	comparison := &domain.APIComparison{ID: "cmp-1", RequestID: "test-request-id", MatchRate: 1.0}
+
+	mockLogger.On("WithContext", mock.Anything).Return(mockLogger)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	mockRoutingRepo.On("FindAll", ctx).Return([]*domain.RoutingRule{routingRule}, nil)
+	mockOrchestrationRepo.On("FindByRoutingRuleID", ctx, "rule-1").Return(orchestrationRule, nil)
+	mockEndpointRepo.On("FindByID", ctx, "legacy-endpoint-1").Return(legacyEndpoint, nil)
+	mockEndpointRepo.On("FindByID", ctx, "modern-endpoint-1").Return(modernEndpoint, nil)
+	mockExternalAPI.On("SendWithRetry", ctx, modernEndpoint, request).Return(modernResponse, nil)
+	mockMetrics.On("RecordRequest", "GET", "/api/users", 200, mock.AnythingOfType("time.Duration")).Return()
+	mockOrchestrationSvc.On("CompareCanaryResponse", mock.Anything, orchestrationRule, request, modernResponse, legacyEndpoint).Return(comparison, nil)
+	mockComparisonRepo.On("SaveComparison", mock.Anything, comparison).Return(nil)
+
+	// When
+	response, err := service.ProcessRequest(ctx, request)
+	assert.NoError(t, pool.Close(context.Background()))
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, 200, response.StatusCode)
+	mockExternalAPI.AssertNotCalled(t, "SendWithRetry", mock.Anything, legacyEndpoint, mock.Anything)
+	mockOrchestrationSvc.AssertExpectations(t)
+	mockComparisonRepo.AssertExpectations(t)
+}
+
+// TestBridgeService_ProcessRequest_ModernOnlyRollbackSamplingWrite tests that sampled write requests are compared only against the legacy sandbox endpoint
+func TestBridgeService_ProcessRequest_ModernOnlyRollbackSamplingWrite(t *testing.T) {
+	tests := []struct {
+		name           string
+		policy         domain.MirrorPolicy
+		wantComparedOn string
+	}{
+		{"no legacy sandbox skips comparison", domain.MirrorPolicy{AllowUnsafeMethods: true}, ""},
+		{"legacy sandbox receives comparison", domain.MirrorPolicy{AllowUnsafeMethods: true, LegacyDryRunEndpointID: "legacy-sandbox-1"}, "legacy-sandbox-1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			mockRoutingRepo := &MockRoutingRepository{}
+			mockEndpointRepo := &MockEndpointRepository{}
+			mockOrchestrationRepo := &MockOrchestrationRepository{}
+			mockComparisonRepo := &MockComparisonRepository{}
+			mockOrchestrationSvc := &MockOrchestrationService{}
+			mockExternalAPI := &MockExternalAPIClient{}
+			mockLogger := &MockLogger{}
+			mockMetrics := &MockMetricsCollector{}
+
+			pool := NewShadowPool(ShadowPoolConfig{Workers: 1, QueueSize: 10}, mockLogger, mockMetrics)
+
+			service := NewBridgeService(
+				mockRoutingRepo,
+				mockEndpointRepo,
+				mockOrchestrationRepo,
+				mockComparisonRepo,
+				mockOrchestrationSvc,
+				mockExternalAPI,
+				&MockCacheRepository{},
+				mockLogger,
+				mockMetrics,
+				WithShadowPool(pool),
+			)
+
+			ctx := context.Background()
+			request := &domain.Request{ID: "test-request-id", Method: "POST", Path: "/api/users", Body: []byte(`{"name":"kim"}`)}
+			routingRule := &domain.RoutingRule{
+				ID:            "rule-1",
+				PathPattern:   "/api/users",
+				MethodPattern: "*",
+				EndpointID:    "endpoint-1",
+				IsActive:      true,
+				MirrorPolicy:  tt.policy,
+			}
+			orchestrationRule := &domain.OrchestrationRule{
+				ID:               "orch-1",
+				RoutingRuleID:    "rule-1",
+				LegacyEndpointID: "legacy-endpoint-1",
+				ModernEndpointID: "modern-endpoint-1",
+				CurrentMode:      domain.MODERN_ONLY,
+				TransitionConfig: domain.TransitionConfig{
+					Rollback: domain.RollbackConfig{SampleRate: 1.0},
+				},
+				ComparisonConfig: domain.ComparisonConfig{Enabled: true, SaveComparisonHistory: true},
+			}
+
+			modernEndpoint := &domain.APIEndpoint{ID: "modern-endpoint-1", BaseURL: "https://modern-api.example.com", IsActive: true}
+			sandboxEndpoint := &domain.APIEndpoint{ID: "legacy-sandbox-1", BaseURL: "https://legacy-sandbox.example.com", IsActive: true}
+			modernResponse := &domain.Response{RequestID: "test-request-id", StatusCode: 201}
+			comparison := &domain.APIComparison{ID: "cmp-1", RequestID: "test-request-id", MatchRate: 1.0}
+
+			mockLogger.On("WithContext", mock.Anything).Return(mockLogger)
+			mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+			mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+			mockLogger.On("Debug", "mirror call skipped for unsafe method", "request_id", "test-request-id", "rule_id", "orch-1", "method", "POST", "current_mode", domain.MODERN_ONLY).Return()
+			mockRoutingRepo.On("FindAll", ctx).Return([]*domain.RoutingRule{routingRule}, nil)
+			mockOrchestrationRepo.On("FindByRoutingRuleID", ctx, "rule-1").Return(orchestrationRule, nil)
+			mockEndpointRepo.On("FindByID", ctx, "modern-endpoint-1").Return(modernEndpoint, nil)
+			mockEndpointRepo.On("FindByID", ctx, "legacy-sandbox-1").Return(sandboxEndpoint, nil)
+			mockExternalAPI.On("SendWithRetry", ctx, modernEndpoint, request).Return(modernResponse, nil)
+			mockMetrics.On("IncrementCounter", "mirror_skipped", map[string]string{"rule_id": "orch-1", "method": "POST", "mode": string(domain.MODERN_ONLY)}).Return()
+			mockMetrics.On("RecordRequest", "POST", "/api/users", 201, mock.AnythingOfType("time.Duration")).Return()
+			mockOrchestrationSvc.On("CompareCanaryResponse", mock.Anything, orchestrationRule, request, modernResponse, sandboxEndpoint).Return(comparison, nil)
+			mockComparisonRepo.On("SaveComparison", mock.Anything, comparison).Return(nil)
+
+			// When
+			response, err := service.ProcessRequest(ctx, request)
+			assert.NoError(t, pool.Close(context.Background()))
+
+			// Then
+			assert.NoError(t, err)
+			assert.Equal(t, 201, response.StatusCode)
+			// 쓰기 요청의 비교 호출은 운영 레거시로 보내지 않음
+			mockEndpointRepo.AssertNotCalled(t, "FindByID", mock.Anything, "legacy-endpoint-1")
+			mockExternalAPI.AssertNumberOfCalls(t, "SendWithRetry", 1)
+			if tt.wantComparedOn == "" {
+				mockOrchestrationSvc.AssertNotCalled(t, "CompareCanaryResponse", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				mockMetrics.AssertCalled(t, "IncrementCounter", "mirror_skipped", mock.Anything)
+			} else {
+				mockOrchestrationSvc.AssertExpectations(t)
+				mockComparisonRepo.AssertExpectations(t)
+			}
+		})
+	}
+}
+
+// TestBridgeService_ProcessRequest_Shadow tests that legacy is served and modern is compared in the background
+func TestBridgeService_ProcessRequest_Shadow(t *testing.T) {
+	// Given
+	mockRoutingRepo := &MockRoutingRepository{}
+	mockEndpointRepo := &MockEndpointRepository{}
+	mockOrchestrationRepo := &MockOrchestrationRepository{}
+	mockComparisonRepo := &MockComparisonRepository{}
+	mockOrchestrationSvc := &MockOrchestrationService{}
+	mockExternalAPI := &MockExternalAPIClient{}
+	mockLogger := &MockLogger{}
+	mockMetrics := &MockMetricsCollector{}
+
+	pool := NewShadowPool(ShadowPoolConfig{Workers: 1, QueueSize: 10}, mockLogger, mockMetrics)
+
+	service := NewBridgeService(
+		mockRoutingRepo,
+		mockEndpointRepo,
+		mockOrchestrationRepo,
+		mockComparisonRepo,
+		mockOrchestrationSvc,
+		mockExternalAPI,
+		&MockCacheRepository{},
+		mockLogger,
+		mockMetrics,
+		WithShadowPool(pool),
+	)
+
+	ctx := context.Background()
+	request := &domain.Request{
+		ID:     "test-request-id",
+		Method: "GET",
+		Path:   "/api/users",
+	}
+
+	routingRule := &domain.RoutingRule{
+		ID:            "rule-1",
+		PathPattern:   "/api/users",
+		MethodPattern: "*",
+		EndpointID:    "endpoint-1",
+		IsActive:      true,
+	}
+
+	orchestrationRule := &domain.OrchestrationRule{
+		ID:               "orch-1",
+		RoutingRuleID:    "rule-1",
+		LegacyEndpointID: "legacy-endpoint-1",
+		ModernEndpointID: "modern-endpoint-1",
+		CurrentMode:      domain.SHADOW,
+		ComparisonConfig: domain.ComparisonConfig{Enabled: true, SaveComparisonHistory: true},
+	}
+
+	legacyEndpoint := &domain.APIEndpoint{ID: "legacy-endpoint-1", BaseURL: "https://legacy-api.example.com", IsActive: true}
+	modernEndpoint := &domain.APIEndpoint{ID: "modern-endpoint-1", BaseURL: "https://modern-api.example.com", IsActive: true}
+
+	legacyResponse := &domain.Response{
+		RequestID:  "test-request-id",
+		StatusCode: 200,
+		Body:       []byte(`{"data": "legacy"}`),
+	}
+	comparison := &domain.APIComparison{ID: "cmp-1", RequestID: "test-request-id", MatchRate: 1.0}
+
+	mockLogger.On("WithContext", mock.Anything).Return(mockLogger)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	mockRoutingRepo.On("FindAll", ctx).Return([]*domain.RoutingRule{routingRule}, nil)
+	mockOrchestrationRepo.On("FindByRoutingRuleID", ctx, "rule-1").Return(orchestrationRule, nil)
+	mockEndpointRepo.On("FindByID", ctx, "legacy-endpoint-1").Return(legacyEndpoint, nil)
+	mockEndpointRepo.On("FindByID", ctx, "modern-endpoint-1").Return(modernEndpoint, nil)
+	mockExternalAPI.On("SendWithRetry", ctx, legacyEndpoint, request).Return(legacyResponse, nil)
+	mockMetrics.On("RecordRequest", "GET", "/api/users", 200, mock.AnythingOfType("time.Duration")).Return()
+	mockOrchestrationSvc.On("CompareShadowResponse", mock.Anything, orchestrationRule, request, legacyResponse, modernEndpoint).Return(comparison, nil)
+	mockComparisonRepo.On("SaveComparison", mock.Anything, comparison).Return(nil)
+
+	// When
+	response, err := service.ProcessRequest(ctx, request)
+	assert.NoError(t, pool.Close(context.Background()))
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "legacy", response.Source)
+	mockExternalAPI.AssertNotCalled(t, "SendWithRetry", mock.Anything, modernEndpoint, mock.Anything)
+	// 전환 평가는 요청마다가 아니라 TransitionController 주기로만 수행
+	mockOrchestrationSvc.AssertNotCalled(t, "EvaluateTransition", mock.Anything, mock.Anything)
+	mockOrchestrationSvc.AssertExpectations(t)
+	mockComparisonRepo.AssertExpectations(t)
+}
+
+// TestBridgeService_ProcessRequest_UnsafeMethodNotMirrored tests that write requests are sent to legacy only unless the routing rule opts in
+func TestBridgeService_ProcessRequest_UnsafeMethodNotMirrored(t *testing.T) {
+	for _, mode := range []domain.APIMode{domain.PARALLEL, domain.SHADOW} {
+		t.Run(string(mode), func(t *testing.T) {
+			// Given
+			mockRoutingRepo := &MockRoutingRepository{}
+			mockEndpointRepo := &MockEndpointRepository{}
+			mockOrchestrationRepo := &MockOrchestrationRepository{}
+			mockOrchestrationSvc := &MockOrchestrationService{}
+			mockExternalAPI := &MockExternalAPIClient{}
+			mockLogger := &MockLogger{}
+			mockMetrics := &MockMetricsCollector{}
+
+			service := NewBridgeService(
+				mockRoutingRepo,
+				mockEndpointRepo,
+				mockOrchestrationRepo,
+				&MockComparisonRepository{},
+				mockOrchestrationSvc,
+				mockExternalAPI,
+				&MockCacheRepository{},
+				mockLogger,
+				mockMetrics,
+			)
+
+			ctx := context.Background()
+			request := &domain.Request{ID: "test-request-id", Method: "POST", Path: "/api/users", Body: []byte(`{"name":"kim"}`)}
+			routingRule := &domain.RoutingRule{ID: "rule-1", PathPattern: "/api/users", MethodPattern: "*", EndpointID: "endpoint-1", IsActive: true}
+			orchestrationRule := &domain.OrchestrationRule{
+				ID:               "orch-1",
+				RoutingRuleID:    "rule-1",
+				LegacyEndpointID: "legacy-endpoint-1",
+				ModernEndpointID: "modern-endpoint-1",
+				CurrentMode:      mode,
+				ComparisonConfig: domain.ComparisonConfig{Enabled: true, SaveComparisonHistory: true},
+			}
+			legacyEndpoint := &domain.APIEndpoint{ID: "legacy-endpoint-1", BaseURL: "https://legacy-api.example.com", IsActive: true}
+			legacyResponse := &domain.Response{RequestID: "test-request-id", StatusCode: 201}
+
+			mockLogger.On("WithContext", mock.Anything).Return(mockLogger)
+			mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+			mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+			mockLogger.On("Debug", "mirror call skipped for unsafe method", "request_id", "test-request-id", "rule_id", "orch-1", "method", "POST", "current_mode", mode).Return()
+			mockRoutingRepo.On("FindAll", ctx).Return([]*domain.RoutingRule{routingRule}, nil)
+			mockOrchestrationRepo.On("FindByRoutingRuleID", ctx, "rule-1").Return(orchestrationRule, nil)
+			mockEndpointRepo.On("FindByID", ctx, "legacy-endpoint-1").Return(legacyEndpoint, nil)
+			mockExternalAPI.On("SendWithRetry", ctx, legacyEndpoint, request).Return(legacyResponse, nil)
+			mockMetrics.On("IncrementCounter", "mirror_skipped", map[string]string{"rule_id": "orch-1", "method": "POST", "mode": string(mode)}).Return()
+			mockMetrics.On("RecordRequest", "POST", "/api/users", 201, mock.AnythingOfType("time.Duration")).Return()
+
+			// When
+			response, err := service.ProcessRequest(ctx, request)
+
+			// Then
+			assert.NoError(t, err)
+			assert.Equal(t, 201, response.StatusCode)
+			mockEndpointRepo.AssertNotCalled(t, "FindByID", mock.Anything, "modern-endpoint-1")
+			mockOrchestrationSvc.AssertNotCalled(t, "ProcessParallelRequest", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			mockExternalAPI.AssertNumberOfCalls(t, "SendWithRetry", 1)
+			mockMetrics.AssertExpectations(t)
+		})
+	}
+}
+
+// TestBridgeService_ProcessRequest_UnsafeMethodDryRun tests that opted-in write requests are mirrored to the sandbox endpoint with the dry-run header
+func TestBridgeService_ProcessRequest_UnsafeMethodDryRun(t *testing.T) {
+	// Given
+	mockRoutingRepo := &MockRoutingRepository{}
+	mockEndpointRepo := &MockEndpointRepository{}
+	mockOrchestrationRepo := &MockOrchestrationRepository{}
+	mockOrchestrationSvc := &MockOrchestrationService{}
+	mockLogger := &MockLogger{}
+	mockMetrics := &MockMetricsCollector{}
+
+	service := NewBridgeService(
+		mockRoutingRepo,
+		mockEndpointRepo,
+		mockOrchestrationRepo,
+		&MockComparisonRepository{},
+		mockOrchestrationSvc,
+		&MockExternalAPIClient{},
+		&MockCacheRepository{},
+		mockLogger,
+		mockMetrics,
+	)
+
+	ctx := context.Background()
+	request := &domain.Request{ID: "test-request-id", Method: "POST", Path: "/api/users", Body: []byte(`{"name":"kim"}`)}
+	routingRule := &domain.RoutingRule{
+		ID:            "rule-1",
+		PathPattern:   "/api/users",
+		MethodPattern: "*",
+		EndpointID:    "endpoint-1",
+		IsActive:      true,
+		MirrorPolicy:  domain.MirrorPolicy{AllowUnsafeMethods: true, DryRunEndpointID: "sandbox-endpoint-1", DryRunHeader: true},
+	}
+	orchestrationRule := &domain.OrchestrationRule{
+		ID:               "orch-1",
+		RoutingRuleID:    "rule-1",
+		LegacyEndpointID: "legacy-endpoint-1",
+		ModernEndpointID: "modern-endpoint-1",
+		CurrentMode:      domain.PARALLEL,
+	}
+	legacyEndpoint := &domain.APIEndpoint{ID: "legacy-endpoint-1", BaseURL: "https://legacy-api.example.com", IsActive: true}
+	sandboxEndpoint := &domain.APIEndpoint{ID: "sandbox-endpoint-1", BaseURL: "https://sandbox-api.example.com", IsActive: true}
+	comparison := &domain.APIComparison{
+		RequestID:      "test-request-id",
+		MatchRate:      1.0,
+		LegacyResponse: &domain.Response{RequestID: "test-request-id", StatusCode: 201},
+		ModernResponse: &domain.Response{RequestID: "test-request-id", StatusCode: 201},
+	}
+
+	mockLogger.On("WithContext", mock.Anything).Return(mockLogger)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	mockRoutingRepo.On("FindAll", ctx).Return([]*domain.RoutingRule{routingRule}, nil)
+	mockOrchestrationRepo.On("FindByRoutingRuleID", ctx, "rule-1").Return(orchestrationRule, nil)
+	mockEndpointRepo.On("FindByID", ctx, "legacy-endpoint-1").Return(legacyEndpoint, nil)
+	mockEndpointRepo.On("FindByID", ctx, "sandbox-endpoint-1").Return(sandboxEndpoint, nil)
+	mockOrchestrationSvc.On("ProcessParallelRequest", ctx, orchestrationRule, request, legacyEndpoint, sandboxEndpoint).Return(comparison, nil)
+	mockMetrics.On("RecordRequest", "POST", "/api/users", 201, mock.AnythingOfType("time.Duration")).Return()
+
+	// When
+	response, err := service.ProcessRequest(ctx, request)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "legacy", response.Source)
+	assert.Equal(t, map[string]string{domain.DryRunHeader: "true"}, request.MirrorHeaders)
+	mockEndpointRepo.AssertNotCalled(t, "FindByID", mock.Anything, "modern-endpoint-1")
+	mockOrchestrationSvc.AssertExpectations(t)
+}
+
 // TestHelperFunctions tests helper functions
 func TestHelperFunctions(t *testing.T) {
 	service := NewBridgeService(
@@ -1039,10 +2084,6 @@ func TestHelperFunctions(t *testing.T) {
 	cacheKey := service.generateCacheKey(request)
 	assert.Equal(t, "api_bridge:GET:/api/users", cacheKey)
 
-	// Test generateRoutingCacheKey
-	routingKey := service.generateRoutingCacheKey(request)
-	assert.Equal(t, "abs:routing:GET:/api/users", routingKey)
-
 	// Test selectHighestPriorityRule
 	rules := []*domain.RoutingRule{
 		{ID: "rule-1", Priority: 10},
@@ -1055,4 +2096,13 @@ func TestHelperFunctions(t *testing.T) {
 	// Test selectHighestPriorityRule with empty array
 	emptySelected := service.selectHighestPriorityRule([]*domain.RoutingRule{})
 	assert.Nil(t, emptySelected)
+
+	// Test selectHighestPriorityRule tie-break by predicate specificity
+	tied := []*domain.RoutingRule{
+		{ID: "generic", Priority: 10},
+		{ID: "mobile", Priority: 10, Headers: map[string]string{"X-Client": "mobile"}},
+		{ID: "has-client", Priority: 10, Headers: map[string]string{"X-Client": "*"}},
+		{ID: "lower-priority", Priority: 20, Headers: map[string]string{"X-Client": "mobile"}, QueryParams: map[string]string{"version": "2"}},
+	}
+	assert.Equal(t, "mobile", service.selectHighestPriorityRule(tied).ID)
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"demo-api-bridge/internal/core/domain"
 	"demo-api-bridge/internal/core/port"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
@@ -11,6 +12,10 @@ import (
 	"github.com/sony/gobreaker"
 )
 
+// errCountedFailure는 에러 없이 끝났지만 Circuit Breaker가 실패로 집계한 호출을 나타냅니다 (5xx 응답, 느린 호출).
+// 호출자에게는 전달하지 않고 결과를 그대로 반환합니다.
+var errCountedFailure = errors.New("call counted as circuit breaker failure (5xx response or slow call)")
+
 // circuitBreakerService는 Circuit Breaker 패턴을 구현하는 서비스입니다.
 //
 // Circuit Breaker는 장애가 발생한 외부 시스템에 대한 호출을 차단하여
@@ -30,19 +35,48 @@ import (
 // 성능 특성:
 //   - Circuit Open 상태에서는 즉시 에러 반환 (지연시간 0ms)
 //   - 상태 확인은 Thread-Safe한 RWMutex 사용
+//
+// 운영 기능:
+//   - 수동 상태 고정(Override): 유지보수 중 강제 OPEN(모든 요청 차단) 또는 강제 CLOSED(실패 집계 없이 통과)
+//   - 상태 전환 이벤트 구독(Subscribe): 대시보드 등에 상태 변화를 실시간으로 전달
 type circuitBreakerService struct {
 	breakers map[string]*gobreaker.CircuitBreaker // 이름별 Circuit Breaker 맵
 	mutex    sync.RWMutex                         // Thread-Safe 접근을 위한 뮤텍스
 	logger   port.Logger                          // 로거
 	metrics  port.MetricsCollector                // 메트릭 수집기
+
+	// states는 이름별 부가 상태입니다 (설정, OPEN 시각, 마지막 에러, 수동 고정).
+	// 상태 변경 콜백은 breaker.State() 호출 중에도 실행되므로 mutex와 별도의 락을 사용하고,
+	// stateMu를 잡은 채로 breaker 메서드를 호출하지 않습니다.
+	states  map[string]*breakerState
+	stateMu sync.Mutex
+
+	subscribers map[chan domain.CircuitBreakerEvent]struct{} // 상태 전환 이벤트 구독자
+	subMu       sync.Mutex
+}
+
+// breakerState는 gobreaker가 제공하지 않는 Circuit Breaker별 부가 상태입니다.
+type breakerState struct {
+	config         domain.CircuitBreakerConfig   // 생성 시 설정 (리셋 시 재사용)
+	openedAt       time.Time                     // 마지막으로 OPEN 상태가 된 시각 (Retry-After 계산용)
+	lastError      string                        // 마지막으로 실패로 집계된 호출의 에러
+	lastErrorAt    time.Time                     // 마지막 에러 시각
+	override       domain.CircuitBreakerOverride // 수동 고정 상태
+	overrideReason string                        // 수동 고정 사유
+	overrideUntil  time.Time                     // 수동 고정 만료 시각 (0이면 해제할 때까지 유지)
 }
 
+// subscriberBufferSize는 구독자별 이벤트 버퍼 크기입니다. 버퍼가 가득 찬 구독자에게는 이벤트를 버립니다.
+const subscriberBufferSize = 16
+
 // NewCircuitBreakerService는 새로운 Circuit Breaker 서비스를 생성합니다.
 func NewCircuitBreakerService(logger port.Logger, metrics port.MetricsCollector) port.CircuitBreakerService {
 	return &circuitBreakerService{
-		breakers: make(map[string]*gobreaker.CircuitBreaker),
-		logger:   logger,
-		metrics:  metrics,
+		breakers:    make(map[string]*gobreaker.CircuitBreaker),
+		states:      make(map[string]*breakerState),
+		subscribers: make(map[chan domain.CircuitBreakerEvent]struct{}),
+		logger:      logger,
+		metrics:     metrics,
 	}
 }
 
@@ -55,44 +89,34 @@ func (s *circuitBreakerService) GetOrCreateBreaker(name string, config domain.Ci
 		return breaker
 	}
 
-	// gobreaker 설정 변환
-	gobreakerConfig := gobreaker.Settings{
-		Name:        config.Name,
+	breaker := gobreaker.NewCircuitBreaker(s.newSettings(name, config))
+	s.breakers[name] = breaker
+
+	s.stateMu.Lock()
+	s.states[name] = &breakerState{config: config}
+	s.stateMu.Unlock()
+
+	s.logger.Info("Circuit breaker created", "name", name)
+	return breaker
+}
+
+// newSettings는 도메인 설정을 gobreaker 설정으로 변환합니다.
+func (s *circuitBreakerService) newSettings(name string, config domain.CircuitBreakerConfig) gobreaker.Settings {
+	return gobreaker.Settings{
+		Name:        name,
 		MaxRequests: config.MaxRequests,
 		Interval:    config.Interval,
 		Timeout:     config.Timeout,
 		ReadyToTrip: func(counts gobreaker.Counts) bool {
-			// domain.Counts로 변환
-			domainCounts := domain.Counts{
-				Requests:             counts.Requests,
-				TotalSuccesses:       counts.TotalSuccesses,
-				TotalFailures:        counts.TotalFailures,
-				ConsecutiveSuccesses: counts.ConsecutiveSuccesses,
-				ConsecutiveFailures:  counts.ConsecutiveFailures,
-			}
-			return config.ReadyToTrip(domainCounts)
+			return config.ReadyToTrip(toDomainCounts(counts))
 		},
 		OnStateChange: func(name string, from gobreaker.State, to gobreaker.State) {
-			// domain.CircuitBreakerState로 변환
-			var fromState, toState domain.CircuitBreakerState
-			switch from {
-			case gobreaker.StateClosed:
-				fromState = domain.CLOSED
-			case gobreaker.StateOpen:
-				fromState = domain.OPEN
-			case gobreaker.StateHalfOpen:
-				fromState = domain.HALF_OPEN
-			}
-			switch to {
-			case gobreaker.StateClosed:
-				toState = domain.CLOSED
-			case gobreaker.StateOpen:
-				toState = domain.OPEN
-			case gobreaker.StateHalfOpen:
-				toState = domain.HALF_OPEN
-			}
+			fromState, toState := toDomainState(from), toDomainState(to)
 
-			config.OnStateChange(name, fromState, toState)
+			s.recordOpenedAt(name, toState)
+			if config.OnStateChange != nil {
+				config.OnStateChange(name, fromState, toState)
+			}
 
 			// 메트릭 기록
 			s.metrics.IncrementCounter("circuit_breaker_state_change", map[string]string{
@@ -106,22 +130,56 @@ func (s *circuitBreakerService) GetOrCreateBreaker(name string, config domain.Ci
 				"from", fromState,
 				"to", toState,
 			)
+
+			s.publish(domain.CircuitBreakerEvent{
+				Name:      name,
+				From:      fromState,
+				To:        toState,
+				Cause:     domain.CircuitBreakerCauseStateChange,
+				Timestamp: time.Now(),
+			})
 		},
 	}
-
-	breaker := gobreaker.NewCircuitBreaker(gobreakerConfig)
-	s.breakers[name] = breaker
-
-	s.logger.Info("Circuit breaker created", "name", name)
-	return breaker
 }
 
 // Execute는 Circuit Breaker를 통해 함수를 실행합니다.
+// 수동 고정 상태이면 강제 OPEN은 바로 차단하고, 강제 CLOSED는 실패를 집계하지 않고 실행합니다.
 func (s *circuitBreakerService) Execute(ctx context.Context, breakerName string, config domain.CircuitBreakerConfig, fn func() (interface{}, error)) (interface{}, error) {
 	breaker := s.GetOrCreateBreaker(breakerName, config)
 
+	var result interface{}
+	var err error
 	start := time.Now()
-	result, err := breaker.Execute(fn)
+	switch override, until := s.activeOverride(breakerName, breaker); override {
+	case domain.OverrideForceOpen:
+		retryAfter := config.Timeout
+		if !until.IsZero() {
+			retryAfter = time.Until(until)
+		}
+		err = &domain.CircuitOpenError{
+			Name:       breakerName,
+			RetryAfter: max(retryAfter, time.Second),
+		}
+	case domain.OverrideForceClosed:
+		result, err = fn()
+	default:
+		result, err = breaker.Execute(func() (interface{}, error) {
+			result, err := fn()
+			// 전체 실행 시간은 재시도 대기를 포함하므로, 응답 결과는 IsFailure가 시도별 처리 시간으로 판단
+			if err == nil && config.IsFailure != nil && config.IsFailure(result, time.Since(start)) {
+				return result, errCountedFailure
+			}
+			return result, err
+		})
+
+		// 차단된 요청은 다시 시도할 수 있는 시점을 담은 도메인 에러로 변환
+		if errors.Is(err, gobreaker.ErrOpenState) || errors.Is(err, gobreaker.ErrTooManyRequests) {
+			err = &domain.CircuitOpenError{
+				Name:       breakerName,
+				RetryAfter: s.retryAfter(breakerName, config.Timeout),
+			}
+		}
+	}
 	duration := time.Since(start)
 
 	// 메트릭 기록
@@ -143,132 +201,363 @@ func (s *circuitBreakerService) Execute(ctx context.Context, breakerName string,
 		)
 	}
 
+	// 차단된 요청은 호출하지 않았으므로 마지막 에러로 기록하지 않음
+	if err != nil && !errors.Is(err, domain.ErrCircuitOpen) {
+		s.recordLastError(breakerName, lastErrorMessage(result, err, duration))
+	}
+
+	if errors.Is(err, errCountedFailure) {
+		return result, nil
+	}
 	return result, err
 }
 
 // GetBreakerInfo는 Circuit Breaker의 현재 상태 정보를 반환합니다.
 func (s *circuitBreakerService) GetBreakerInfo(breakerName string) (*domain.CircuitBreakerInfo, error) {
-	s.mutex.RLock()
-	defer s.mutex.RUnlock()
-
-	breaker, exists := s.breakers[breakerName]
-	if !exists {
-		return nil, fmt.Errorf("circuit breaker '%s' not found", breakerName)
-	}
-
-	state := breaker.State()
-	counts := breaker.Counts()
-
-	// gobreaker.State를 domain.CircuitBreakerState로 변환
-	var domainState domain.CircuitBreakerState
-	switch state {
-	case gobreaker.StateClosed:
-		domainState = domain.CLOSED
-	case gobreaker.StateOpen:
-		domainState = domain.OPEN
-	case gobreaker.StateHalfOpen:
-		domainState = domain.HALF_OPEN
-	}
-
-	// gobreaker.Counts를 domain.Counts로 변환
-	domainCounts := domain.Counts{
-		Requests:             counts.Requests,
-		TotalSuccesses:       counts.TotalSuccesses,
-		TotalFailures:        counts.TotalFailures,
-		ConsecutiveSuccesses: counts.ConsecutiveSuccesses,
-		ConsecutiveFailures:  counts.ConsecutiveFailures,
+	breaker, err := s.lookup(breakerName)
+	if err != nil {
+		return nil, err
 	}
-
-	return &domain.CircuitBreakerInfo{
-		Name:        breakerName,
-		State:       domainState,
-		Counts:      domainCounts,
-		MaxRequests: 3,                                // 기본값
-		Expiry:      time.Now().Add(10 * time.Second), // 임시 값
-	}, nil
+	return s.buildInfo(breakerName, breaker), nil
 }
 
 // GetAllBreakerInfos는 모든 Circuit Breaker의 상태 정보를 반환합니다.
 func (s *circuitBreakerService) GetAllBreakerInfos() map[string]*domain.CircuitBreakerInfo {
 	s.mutex.RLock()
-	defer s.mutex.RUnlock()
-
-	infos := make(map[string]*domain.CircuitBreakerInfo)
+	breakers := make(map[string]*gobreaker.CircuitBreaker, len(s.breakers))
 	for name, breaker := range s.breakers {
-		state := breaker.State()
-		counts := breaker.Counts()
-
-		var domainState domain.CircuitBreakerState
-		switch state {
-		case gobreaker.StateClosed:
-			domainState = domain.CLOSED
-		case gobreaker.StateOpen:
-			domainState = domain.OPEN
-		case gobreaker.StateHalfOpen:
-			domainState = domain.HALF_OPEN
-		}
-
-		domainCounts := domain.Counts{
-			Requests:             counts.Requests,
-			TotalSuccesses:       counts.TotalSuccesses,
-			TotalFailures:        counts.TotalFailures,
-			ConsecutiveSuccesses: counts.ConsecutiveSuccesses,
-			ConsecutiveFailures:  counts.ConsecutiveFailures,
-		}
+		breakers[name] = breaker
+	}
+	s.mutex.RUnlock()
 
-		infos[name] = &domain.CircuitBreakerInfo{
-			Name:        name,
-			State:       domainState,
-			Counts:      domainCounts,
-			MaxRequests: 3,                                // 기본값
-			Expiry:      time.Now().Add(10 * time.Second), // 임시 값
-		}
+	infos := make(map[string]*domain.CircuitBreakerInfo, len(breakers))
+	for name, breaker := range breakers {
+		infos[name] = s.buildInfo(name, breaker)
 	}
 
 	return infos
 }
 
 // ResetBreaker는 Circuit Breaker를 리셋합니다.
+// 생성 시 설정을 그대로 사용하며, 수동 고정 상태는 유지합니다.
 func (s *circuitBreakerService) ResetBreaker(breakerName string) error {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
-	_, exists := s.breakers[breakerName]
+	previous, exists := s.breakers[breakerName]
 	if !exists {
-		return fmt.Errorf("circuit breaker '%s' not found", breakerName)
+		return fmt.Errorf("%w: '%s'", domain.ErrCircuitBreakerNotFound, breakerName)
 	}
+	from := toDomainState(previous.State())
 
-	// Circuit Breaker를 새로 생성하여 리셋 효과
-	config := domain.NewCircuitBreakerConfig(breakerName)
-	gobreakerConfig := gobreaker.Settings{
-		Name:        config.Name,
-		MaxRequests: config.MaxRequests,
-		Interval:    config.Interval,
-		Timeout:     config.Timeout,
-		ReadyToTrip: func(counts gobreaker.Counts) bool {
-			return config.ReadyToTrip(domain.Counts{
-				Requests:             counts.Requests,
-				TotalSuccesses:       counts.TotalSuccesses,
-				TotalFailures:        counts.TotalFailures,
-				ConsecutiveSuccesses: counts.ConsecutiveSuccesses,
-				ConsecutiveFailures:  counts.ConsecutiveFailures,
-			})
-		},
-		OnStateChange: func(name string, from gobreaker.State, to gobreaker.State) {
-			config.OnStateChange(name, domain.CircuitBreakerState(from.String()), domain.CircuitBreakerState(to.String()))
-		},
-	}
+	s.stateMu.Lock()
+	state := s.states[breakerName]
+	config := state.config
+	state.openedAt = time.Time{}
+	s.stateMu.Unlock()
 
-	s.breakers[breakerName] = gobreaker.NewCircuitBreaker(gobreakerConfig)
+	// Circuit Breaker를 새로 생성하여 리셋 효과
+	s.breakers[breakerName] = gobreaker.NewCircuitBreaker(s.newSettings(breakerName, config))
 	s.logger.Info("Circuit breaker reset", "name", breakerName)
 
 	s.metrics.IncrementCounter("circuit_breaker_reset", map[string]string{
 		"name": breakerName,
 	})
 
+	s.publish(domain.CircuitBreakerEvent{
+		Name:      breakerName,
+		From:      from,
+		To:        domain.CLOSED,
+		Cause:     domain.CircuitBreakerCauseReset,
+		Timestamp: time.Now(),
+	})
+
+	return nil
+}
+
+// SetOverride는 Circuit Breaker 상태를 수동으로 고정합니다 (유지보수 등).
+// duration이 0보다 크면 그 시간이 지난 뒤 자동으로 해제됩니다.
+func (s *circuitBreakerService) SetOverride(breakerName string, override domain.CircuitBreakerOverride, reason string, duration time.Duration) error {
+	forced, ok := override.State()
+	if !ok {
+		return fmt.Errorf("invalid circuit breaker override '%s'", override)
+	}
+	if duration < 0 {
+		return fmt.Errorf("circuit breaker override duration must not be negative")
+	}
+
+	breaker, err := s.lookup(breakerName)
+	if err != nil {
+		return err
+	}
+	from := s.buildInfo(breakerName, breaker).State
+
+	s.stateMu.Lock()
+	state := s.states[breakerName]
+	state.override = override
+	state.overrideReason = reason
+	state.overrideUntil = time.Time{}
+	if duration > 0 {
+		state.overrideUntil = time.Now().Add(duration)
+	}
+	s.stateMu.Unlock()
+
+	s.logger.Warn("Circuit breaker override set",
+		"name", breakerName,
+		"override", override,
+		"reason", reason,
+		"duration", duration,
+	)
+
+	s.metrics.IncrementCounter("circuit_breaker_override", map[string]string{
+		"name":     breakerName,
+		"override": string(override),
+	})
+
+	s.publish(domain.CircuitBreakerEvent{
+		Name:      breakerName,
+		From:      from,
+		To:        forced,
+		Cause:     domain.CircuitBreakerCauseOverride,
+		Reason:    reason,
+		Timestamp: time.Now(),
+	})
+
 	return nil
 }
 
+// ClearOverride는 수동 상태 고정을 해제합니다. 고정 상태가 없으면 아무것도 하지 않습니다.
+func (s *circuitBreakerService) ClearOverride(breakerName string) error {
+	breaker, err := s.lookup(breakerName)
+	if err != nil {
+		return err
+	}
+
+	override, reason := s.clearOverride(breakerName, false)
+	if override == domain.OverrideNone {
+		return nil
+	}
+
+	s.logger.Info("Circuit breaker override cleared", "name", breakerName, "override", override)
+
+	s.metrics.IncrementCounter("circuit_breaker_override", map[string]string{
+		"name":     breakerName,
+		"override": "cleared",
+	})
+
+	s.publishOverrideEnd(breakerName, breaker, override, reason, domain.CircuitBreakerCauseOverrideCleared)
+	return nil
+}
+
+// Subscribe는 Circuit Breaker 상태 전환 이벤트를 구독합니다.
+// 이벤트를 제때 읽지 않아 버퍼가 가득 차면 그 사이의 이벤트는 버려집니다.
+// 반환된 함수를 호출하면 구독을 해지하고 채널을 닫습니다.
+func (s *circuitBreakerService) Subscribe() (<-chan domain.CircuitBreakerEvent, func()) {
+	ch := make(chan domain.CircuitBreakerEvent, subscriberBufferSize)
+
+	s.subMu.Lock()
+	s.subscribers[ch] = struct{}{}
+	s.subMu.Unlock()
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			s.subMu.Lock()
+			defer s.subMu.Unlock()
+			delete(s.subscribers, ch)
+			close(ch)
+		})
+	}
+	return ch, unsubscribe
+}
+
+// publish는 모든 구독자에게 이벤트를 전달합니다. 느린 구독자 때문에 요청 처리가 막히지 않도록 대기하지 않습니다.
+func (s *circuitBreakerService) publish(event domain.CircuitBreakerEvent) {
+	s.subMu.Lock()
+	defer s.subMu.Unlock()
+
+	for ch := range s.subscribers {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
+
+// lookup은 이름에 해당하는 Circuit Breaker를 반환합니다.
+func (s *circuitBreakerService) lookup(name string) (*gobreaker.CircuitBreaker, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	breaker, exists := s.breakers[name]
+	if !exists {
+		return nil, fmt.Errorf("%w: '%s'", domain.ErrCircuitBreakerNotFound, name)
+	}
+	return breaker, nil
+}
+
+// buildInfo는 Circuit Breaker의 현재 상태 정보를 만듭니다.
+func (s *circuitBreakerService) buildInfo(name string, breaker *gobreaker.CircuitBreaker) *domain.CircuitBreakerInfo {
+	s.activeOverride(name, breaker)
+	state := toDomainState(breaker.State())
+	counts := toDomainCounts(breaker.Counts())
+
+	s.stateMu.Lock()
+	defer s.stateMu.Unlock()
+
+	extra := s.states[name]
+	info := &domain.CircuitBreakerInfo{
+		Name:           name,
+		State:          state,
+		Counts:         counts,
+		MaxRequests:    extra.config.MaxRequests,
+		LastError:      extra.lastError,
+		LastErrorAt:    extra.lastErrorAt,
+		Override:       extra.override,
+		OverrideReason: extra.overrideReason,
+	}
+	if state == domain.OPEN && !extra.openedAt.IsZero() {
+		info.Expiry = extra.openedAt.Add(extra.config.Timeout)
+	}
+	if forced, ok := extra.override.State(); ok {
+		info.State = forced
+		info.Expiry = extra.overrideUntil
+	}
+	return info
+}
+
+// activeOverride는 현재 수동 고정 상태와 만료 시각을 반환합니다. 만료된 고정 상태는 해제합니다.
+func (s *circuitBreakerService) activeOverride(name string, breaker *gobreaker.CircuitBreaker) (domain.CircuitBreakerOverride, time.Time) {
+	s.stateMu.Lock()
+	state := s.states[name]
+	override, until := state.override, state.overrideUntil
+	s.stateMu.Unlock()
+
+	if override == domain.OverrideNone || until.IsZero() || time.Now().Before(until) {
+		return override, until
+	}
+
+	// 동시에 만료를 확인한 다른 호출이 이미 해제했으면 이벤트를 다시 보내지 않음
+	expired, reason := s.clearOverride(name, true)
+	if expired != domain.OverrideNone {
+		s.logger.Info("Circuit breaker override expired", "name", name, "override", expired)
+		s.publishOverrideEnd(name, breaker, expired, reason, domain.CircuitBreakerCauseOverrideExpired)
+	}
+	return domain.OverrideNone, time.Time{}
+}
+
+// clearOverride는 수동 고정 상태를 해제하고 해제 전 상태와 사유를 반환합니다.
+// onlyExpired이면 만료된 경우에만 해제합니다.
+func (s *circuitBreakerService) clearOverride(name string, onlyExpired bool) (domain.CircuitBreakerOverride, string) {
+	s.stateMu.Lock()
+	defer s.stateMu.Unlock()
+
+	state := s.states[name]
+	if onlyExpired && (state.overrideUntil.IsZero() || time.Now().Before(state.overrideUntil)) {
+		return domain.OverrideNone, ""
+	}
+
+	override, reason := state.override, state.overrideReason
+	state.override = domain.OverrideNone
+	state.overrideReason = ""
+	state.overrideUntil = time.Time{}
+	return override, reason
+}
+
+// publishOverrideEnd는 수동 고정 상태가 끝나 실제 Circuit Breaker 상태로 돌아간 이벤트를 전달합니다.
+func (s *circuitBreakerService) publishOverrideEnd(name string, breaker *gobreaker.CircuitBreaker, override domain.CircuitBreakerOverride, reason, cause string) {
+	from, _ := override.State()
+	s.publish(domain.CircuitBreakerEvent{
+		Name:      name,
+		From:      from,
+		To:        toDomainState(breaker.State()),
+		Cause:     cause,
+		Reason:    reason,
+		Timestamp: time.Now(),
+	})
+}
+
+// recordOpenedAt은 Circuit Breaker가 OPEN 상태가 된 시각을 기록하고, 다른 상태가 되면 지웁니다.
+func (s *circuitBreakerService) recordOpenedAt(name string, state domain.CircuitBreakerState) {
+	s.stateMu.Lock()
+	defer s.stateMu.Unlock()
+
+	extra, ok := s.states[name]
+	if !ok {
+		return
+	}
+	if state == domain.OPEN {
+		extra.openedAt = time.Now()
+		return
+	}
+	extra.openedAt = time.Time{}
+}
+
+// recordLastError는 마지막으로 실패로 집계된 호출의 에러를 기록합니다.
+func (s *circuitBreakerService) recordLastError(name, message string) {
+	s.stateMu.Lock()
+	defer s.stateMu.Unlock()
+
+	if extra, ok := s.states[name]; ok {
+		extra.lastError = message
+		extra.lastErrorAt = time.Now()
+	}
+}
+
+// retryAfter는 OPEN 상태의 Circuit Breaker가 HALF_OPEN으로 전환될 때까지 남은 시간을 반환합니다.
+// HALF_OPEN 상태에서 허용 요청 수를 넘었거나 남은 시간이 없으면 1초를 반환합니다.
+func (s *circuitBreakerService) retryAfter(name string, timeout time.Duration) time.Duration {
+	s.stateMu.Lock()
+	var openedAt time.Time
+	if extra, ok := s.states[name]; ok {
+		openedAt = extra.openedAt
+	}
+	s.stateMu.Unlock()
+
+	if openedAt.IsZero() {
+		return time.Second
+	}
+	if remaining := timeout - time.Since(openedAt); remaining > time.Second {
+		return remaining
+	}
+	return time.Second
+}
+
+// lastErrorMessage는 실패로 집계된 호출을 마지막 에러 메시지로 변환합니다.
+// 에러 없이 실패로 집계된 호출(5xx 응답, 느린 호출)은 그 이유를 기록합니다.
+func lastErrorMessage(result interface{}, err error, duration time.Duration) string {
+	if !errors.Is(err, errCountedFailure) {
+		return err.Error()
+	}
+	if response, ok := result.(*domain.Response); ok && response != nil && response.StatusCode >= 500 {
+		return fmt.Sprintf("upstream responded with status %d", response.StatusCode)
+	}
+	return fmt.Sprintf("slow call took %dms", duration.Milliseconds())
+}
+
+// toDomainState는 gobreaker.State를 domain.CircuitBreakerState로 변환합니다.
+func toDomainState(state gobreaker.State) domain.CircuitBreakerState {
+	switch state {
+	case gobreaker.StateOpen:
+		return domain.OPEN
+	case gobreaker.StateHalfOpen:
+		return domain.HALF_OPEN
+	default:
+		return domain.CLOSED
+	}
+}
+
+// toDomainCounts는 gobreaker.Counts를 domain.Counts로 변환합니다.
+func toDomainCounts(counts gobreaker.Counts) domain.Counts {
+	return domain.Counts{
+		Requests:             counts.Requests,
+		TotalSuccesses:       counts.TotalSuccesses,
+		TotalFailures:        counts.TotalFailures,
+		ConsecutiveSuccesses: counts.ConsecutiveSuccesses,
+		ConsecutiveFailures:  counts.ConsecutiveFailures,
+	}
+}
+
 // getResultLabel은 실행 결과에 따른 라벨을 반환합니다.
 func (s *circuitBreakerService) getResultLabel(err error) string {
 	if err != nil {
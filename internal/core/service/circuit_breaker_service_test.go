@@ -210,3 +210,220 @@ func TestCircuitBreakerService_StateChange_TriggersLoggingAndMetrics(t *testing.
 	logger.AssertExpectations(t)
 	metrics.AssertExpectations(t)
 }
+
+func TestCircuitBreakerService_Execute_OpenStateReturnsCircuitOpenError(t *testing.T) {
+	logger := &cbMockLogger{}
+	metrics := &cbMockMetrics{}
+	svc := NewCircuitBreakerService(logger, metrics)
+
+	cfg := domain.NewCircuitBreakerConfig("open-breaker")
+	cfg.Timeout = time.Minute
+	cfg.ReadyToTrip = func(c domain.Counts) bool { return c.ConsecutiveFailures >= 1 }
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	logger.On("Warn", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	metrics.On("RecordHistogram", mock.Anything, mock.Anything, mock.Anything).Return()
+	metrics.On("IncrementCounter", mock.Anything, mock.Anything).Return()
+
+	// 첫 실패로 OPEN 전환
+	_, err := svc.Execute(context.Background(), "open-breaker", cfg, func() (interface{}, error) { return nil, errors.New("fail") })
+	assert.Error(t, err)
+
+	called := false
+	_, err = svc.Execute(context.Background(), "open-breaker", cfg, func() (interface{}, error) {
+		called = true
+		return nil, nil
+	})
+
+	var circuitErr *domain.CircuitOpenError
+	assert.False(t, called)
+	assert.ErrorIs(t, err, domain.ErrCircuitOpen)
+	if assert.ErrorAs(t, err, &circuitErr) {
+		assert.Equal(t, "open-breaker", circuitErr.Name)
+		assert.InDelta(t, time.Minute.Seconds(), circuitErr.RetryAfter.Seconds(), 1)
+	}
+}
+
+func TestCircuitBreakerService_Execute_CountsServerErrorResponsesAsFailures(t *testing.T) {
+	logger := &cbMockLogger{}
+	metrics := &cbMockMetrics{}
+	svc := NewCircuitBreakerService(logger, metrics)
+
+	policy := domain.CircuitBreakerPolicy{Strategy: domain.TripConsecutiveFailures, ConsecutiveFailures: 2}
+	cfg := policy.WithDefaults(domain.DefaultCircuitBreakerPolicy()).Config("status-breaker")
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	logger.On("Warn", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	metrics.On("RecordHistogram", mock.Anything, mock.Anything, mock.Anything).Return()
+	metrics.On("IncrementCounter", mock.Anything, mock.Anything).Return()
+
+	upstream := &domain.Response{StatusCode: 503}
+	for i := 0; i < 2; i++ {
+		// 5xx 응답은 실패로 집계하지만 호출자에게는 응답을 그대로 반환
+		result, err := svc.Execute(context.Background(), "status-breaker", cfg, func() (interface{}, error) { return upstream, nil })
+		assert.NoError(t, err)
+		assert.Same(t, upstream, result)
+	}
+
+	info, err := svc.GetBreakerInfo("status-breaker")
+	assert.NoError(t, err)
+	assert.Equal(t, domain.OPEN, info.State)
+	assert.Equal(t, "upstream responded with status 503", info.LastError)
+	assert.False(t, info.LastErrorAt.IsZero())
+	assert.False(t, info.Expiry.IsZero())
+}
+
+// allowAllCircuitBreakerCalls는 로그/메트릭 호출을 모두 허용합니다 (인자 개수별).
+func allowAllCircuitBreakerCalls(logger *cbMockLogger, metrics *cbMockMetrics) {
+	for _, level := range []string{"Debug", "Info", "Warn"} {
+		for _, argc := range []int{3, 5, 7, 9} {
+			args := make([]interface{}, argc)
+			for i := range args {
+				args[i] = mock.Anything
+			}
+			logger.On(level, args...).Return()
+		}
+	}
+	metrics.On("RecordHistogram", mock.Anything, mock.Anything, mock.Anything).Return()
+	metrics.On("IncrementCounter", mock.Anything, mock.Anything).Return()
+}
+
+// receiveEvent는 구독 채널에서 이벤트 하나를 기다립니다.
+func receiveEvent(t *testing.T, events <-chan domain.CircuitBreakerEvent) domain.CircuitBreakerEvent {
+	t.Helper()
+	select {
+	case event := <-events:
+		return event
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for circuit breaker event")
+		return domain.CircuitBreakerEvent{}
+	}
+}
+
+func TestCircuitBreakerService_Override_ForceOpenAndForceClosed(t *testing.T) {
+	logger := &cbMockLogger{}
+	metrics := &cbMockMetrics{}
+	svc := NewCircuitBreakerService(logger, metrics)
+	allowAllCircuitBreakerCalls(logger, metrics)
+
+	cfg := domain.NewCircuitBreakerConfig("override-breaker")
+	cfg.ReadyToTrip = func(c domain.Counts) bool { return c.ConsecutiveFailures >= 1 }
+	svc.GetOrCreateBreaker("override-breaker", cfg)
+
+	// 강제 OPEN: 호출하지 않고 고정 만료까지 남은 시간을 Retry-After로 반환
+	assert.NoError(t, svc.SetOverride("override-breaker", domain.OverrideForceOpen, "DB maintenance", 10*time.Minute))
+
+	called := false
+	_, err := svc.Execute(context.Background(), "override-breaker", cfg, func() (interface{}, error) {
+		called = true
+		return nil, nil
+	})
+	var circuitErr *domain.CircuitOpenError
+	assert.False(t, called)
+	if assert.ErrorAs(t, err, &circuitErr) {
+		assert.InDelta(t, (10 * time.Minute).Seconds(), circuitErr.RetryAfter.Seconds(), 1)
+	}
+
+	info, err := svc.GetBreakerInfo("override-breaker")
+	assert.NoError(t, err)
+	assert.Equal(t, domain.OPEN, info.State)
+	assert.Equal(t, domain.OverrideForceOpen, info.Override)
+	assert.Equal(t, "DB maintenance", info.OverrideReason)
+	assert.WithinDuration(t, time.Now().Add(10*time.Minute), info.Expiry, time.Second)
+	assert.Empty(t, info.LastError, "blocked calls are not recorded as errors")
+
+	// 강제 CLOSED: 실패해도 집계하지 않아 OPEN으로 전환되지 않음
+	assert.NoError(t, svc.SetOverride("override-breaker", domain.OverrideForceClosed, "", 0))
+	for i := 0; i < 3; i++ {
+		_, err = svc.Execute(context.Background(), "override-breaker", cfg, func() (interface{}, error) { return nil, errors.New("fail") })
+		assert.EqualError(t, err, "fail")
+	}
+
+	info, err = svc.GetBreakerInfo("override-breaker")
+	assert.NoError(t, err)
+	assert.Equal(t, domain.CLOSED, info.State)
+	assert.Equal(t, uint32(0), info.Counts.TotalFailures)
+	assert.True(t, info.Expiry.IsZero())
+	assert.Equal(t, "fail", info.LastError)
+
+	// 고정 해제 후에는 실패를 다시 집계
+	assert.NoError(t, svc.ClearOverride("override-breaker"))
+	_, _ = svc.Execute(context.Background(), "override-breaker", cfg, func() (interface{}, error) { return nil, errors.New("fail") })
+
+	info, err = svc.GetBreakerInfo("override-breaker")
+	assert.NoError(t, err)
+	assert.Equal(t, domain.OPEN, info.State)
+	assert.Equal(t, domain.OverrideNone, info.Override)
+}
+
+func TestCircuitBreakerService_Override_InvalidRequests(t *testing.T) {
+	svc := NewCircuitBreakerService(&cbMockLogger{}, &cbMockMetrics{})
+
+	err := svc.SetOverride("unknown", domain.OverrideForceOpen, "", 0)
+	assert.ErrorIs(t, err, domain.ErrCircuitBreakerNotFound)
+	assert.ErrorIs(t, svc.ClearOverride("unknown"), domain.ErrCircuitBreakerNotFound)
+	assert.ErrorIs(t, svc.ResetBreaker("unknown"), domain.ErrCircuitBreakerNotFound)
+
+	assert.Error(t, svc.SetOverride("unknown", domain.OverrideNone, "", 0))
+	assert.Error(t, svc.SetOverride("unknown", domain.OverrideForceOpen, "", -time.Minute))
+}
+
+func TestCircuitBreakerService_Subscribe_PublishesTransitions(t *testing.T) {
+	logger := &cbMockLogger{}
+	metrics := &cbMockMetrics{}
+	svc := NewCircuitBreakerService(logger, metrics)
+	allowAllCircuitBreakerCalls(logger, metrics)
+
+	cfg := domain.NewCircuitBreakerConfig("event-breaker")
+	cfg.ReadyToTrip = func(c domain.Counts) bool { return c.ConsecutiveFailures >= 1 }
+	fail := func() (interface{}, error) { return nil, errors.New("fail") }
+
+	events, unsubscribe := svc.Subscribe()
+
+	// 실패 집계에 따른 자동 전환
+	_, _ = svc.Execute(context.Background(), "event-breaker", cfg, fail)
+	event := receiveEvent(t, events)
+	assert.Equal(t, "event-breaker", event.Name)
+	assert.Equal(t, domain.CLOSED, event.From)
+	assert.Equal(t, domain.OPEN, event.To)
+	assert.Equal(t, domain.CircuitBreakerCauseStateChange, event.Cause)
+
+	// 수동 리셋
+	assert.NoError(t, svc.ResetBreaker("event-breaker"))
+	event = receiveEvent(t, events)
+	assert.Equal(t, domain.OPEN, event.From)
+	assert.Equal(t, domain.CLOSED, event.To)
+	assert.Equal(t, domain.CircuitBreakerCauseReset, event.Cause)
+
+	// 리셋 후에도 생성 시 설정(1회 실패 시 OPEN)을 유지
+	_, _ = svc.Execute(context.Background(), "event-breaker", cfg, fail)
+	event = receiveEvent(t, events)
+	assert.Equal(t, domain.OPEN, event.To)
+	assert.Equal(t, domain.CircuitBreakerCauseStateChange, event.Cause)
+
+	// 수동 고정과 만료
+	assert.NoError(t, svc.SetOverride("event-breaker", domain.OverrideForceClosed, "failover drill", 20*time.Millisecond))
+	event = receiveEvent(t, events)
+	assert.Equal(t, domain.OPEN, event.From)
+	assert.Equal(t, domain.CLOSED, event.To)
+	assert.Equal(t, domain.CircuitBreakerCauseOverride, event.Cause)
+	assert.Equal(t, "failover drill", event.Reason)
+
+	time.Sleep(30 * time.Millisecond)
+	info, err := svc.GetBreakerInfo("event-breaker")
+	assert.NoError(t, err)
+	assert.Equal(t, domain.OverrideNone, info.Override)
+
+	event = receiveEvent(t, events)
+	assert.Equal(t, domain.CLOSED, event.From)
+	assert.Equal(t, domain.OPEN, event.To)
+	assert.Equal(t, domain.CircuitBreakerCauseOverrideExpired, event.Cause)
+
+	// 구독 해지 시 채널을 닫음 (여러 번 호출해도 안전)
+	unsubscribe()
+	unsubscribe()
+	_, open := <-events
+	assert.False(t, open)
+}
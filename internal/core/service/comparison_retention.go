@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"demo-api-bridge/internal/core/port"
+	"time"
+)
+
+// ComparisonRetentionConfig는 비교 결과 보존 정책입니다. 0 이하의 값은 해당 단계를 수행하지 않습니다.
+type ComparisonRetentionConfig struct {
+	BodyRetention time.Duration // 응답 본문을 보존할 기간 (지나면 본문만 제거)
+	MaxAge        time.Duration // 비교 결과를 보존할 기간 (지나면 일별 요약에 합산 후 삭제)
+}
+
+// ComparisonRetentionJob
+// : 오래된 비교 결과를 정리하는 주기 작업입니다.
+//
+//  1. BodyRetention이 지난 비교 결과의 응답 본문 제거 (일치율, 차이점, 상태 코드, 응답 시간은 유지)
+//  2. MaxAge가 지난 비교 결과를 규칙별 일별 요약(domain.ComparisonRollup)에 합산한 뒤 삭제
+//
+// 일별 요약은 GetComparisonStatistics에 포함되므로 원본이 삭제되어도 전환 판단과 통계 조회에 필요한 이력은 유지됩니다.
+type ComparisonRetentionJob struct {
+	comparisonRepo port.ComparisonRepository // 비교 결과 저장소
+	config         ComparisonRetentionConfig // 보존 정책
+	logger         port.Logger               // 로거
+	metrics        port.MetricsCollector     // 메트릭 수집기
+	now            func() time.Time          // 현재 시간 (테스트에서 교체)
+}
+
+// NewComparisonRetentionJob은 새로운 ComparisonRetentionJob을 생성합니다.
+func NewComparisonRetentionJob(
+	comparisonRepo port.ComparisonRepository,
+	config ComparisonRetentionConfig,
+	logger port.Logger,
+	metrics port.MetricsCollector,
+) *ComparisonRetentionJob {
+	return &ComparisonRetentionJob{
+		comparisonRepo: comparisonRepo,
+		config:         config,
+		logger:         logger,
+		metrics:        metrics,
+		now:            time.Now,
+	}
+}
+
+// Run은 보존 정책을 한 번 적용합니다.
+// 본문 제거가 실패해도 요약/삭제는 계속 진행하며, 마지막으로 발생한 에러를 반환합니다.
+func (j *ComparisonRetentionJob) Run(ctx context.Context) error {
+	now := j.now()
+	var lastErr error
+
+	if j.config.BodyRetention > 0 {
+		stripped, err := j.comparisonRepo.StripComparisonBodies(ctx, now.Add(-j.config.BodyRetention))
+		if err != nil {
+			j.logger.WithContext(ctx).Error("failed to strip comparison bodies", "error", err)
+			lastErr = err
+		} else if stripped > 0 {
+			j.logger.WithContext(ctx).Info("comparison bodies stripped", "count", stripped)
+			j.metrics.RecordGauge("comparison_retention_stripped", float64(stripped), map[string]string{})
+		}
+	}
+
+	if j.config.MaxAge > 0 {
+		purged, err := j.comparisonRepo.RollupComparisons(ctx, now.Add(-j.config.MaxAge))
+		if err != nil {
+			j.logger.WithContext(ctx).Error("failed to roll up comparisons", "error", err)
+			lastErr = err
+		} else if purged > 0 {
+			j.logger.WithContext(ctx).Info("comparisons rolled up and purged", "count", purged)
+			j.metrics.RecordGauge("comparison_retention_purged", float64(purged), map[string]string{})
+		}
+	}
+
+	return lastErr
+}
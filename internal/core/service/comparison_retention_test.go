@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// newTestComparisonRetentionJob은 현재 시간이 고정된 ComparisonRetentionJob을 생성합니다.
+func newTestComparisonRetentionJob(now time.Time, config ComparisonRetentionConfig) (*ComparisonRetentionJob, *MockComparisonRepository, *MockMetricsCollector) {
+	mockComparisonRepo := &MockComparisonRepository{}
+	mockLogger := &MockLogger{}
+	mockMetrics := &MockMetricsCollector{}
+
+	mockLogger.On("WithContext", mock.Anything).Return(mockLogger)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	mockLogger.On("Error", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	job := NewComparisonRetentionJob(mockComparisonRepo, config, mockLogger, mockMetrics)
+	job.now = func() time.Time { return now }
+
+	return job, mockComparisonRepo, mockMetrics
+}
+
+func TestComparisonRetentionJob_Run(t *testing.T) {
+	// Given
+	now := time.Date(2025, 2, 5, 12, 0, 0, 0, time.UTC)
+	job, mockComparisonRepo, mockMetrics := newTestComparisonRetentionJob(now, ComparisonRetentionConfig{
+		BodyRetention: 7 * 24 * time.Hour,
+		MaxAge:        30 * 24 * time.Hour,
+	})
+	ctx := context.Background()
+
+	mockComparisonRepo.On("StripComparisonBodies", ctx, now.Add(-7*24*time.Hour)).Return(120, nil)
+	mockComparisonRepo.On("RollupComparisons", ctx, now.Add(-30*24*time.Hour)).Return(40, nil)
+	mockMetrics.On("RecordGauge", "comparison_retention_stripped", float64(120), map[string]string{}).Return()
+	mockMetrics.On("RecordGauge", "comparison_retention_purged", float64(40), map[string]string{}).Return()
+
+	// When
+	err := job.Run(ctx)
+
+	// Then
+	assert.NoError(t, err)
+	mockComparisonRepo.AssertExpectations(t)
+	mockMetrics.AssertExpectations(t)
+}
+
+func TestComparisonRetentionJob_Run_RollupContinuesAfterStripFailure(t *testing.T) {
+	// Given
+	now := time.Date(2025, 2, 5, 12, 0, 0, 0, time.UTC)
+	job, mockComparisonRepo, _ := newTestComparisonRetentionJob(now, ComparisonRetentionConfig{
+		BodyRetention: time.Hour,
+		MaxAge:        24 * time.Hour,
+	})
+	ctx := context.Background()
+	stripErr := errors.New("database unavailable")
+
+	mockComparisonRepo.On("StripComparisonBodies", ctx, now.Add(-time.Hour)).Return(0, stripErr)
+	mockComparisonRepo.On("RollupComparisons", ctx, now.Add(-24*time.Hour)).Return(0, nil)
+
+	// When
+	err := job.Run(ctx)
+
+	// Then
+	assert.ErrorIs(t, err, stripErr)
+	mockComparisonRepo.AssertExpectations(t)
+}
+
+func TestComparisonRetentionJob_Run_Disabled(t *testing.T) {
+	// Given
+	job, mockComparisonRepo, _ := newTestComparisonRetentionJob(time.Now(), ComparisonRetentionConfig{})
+
+	// When
+	err := job.Run(context.Background())
+
+	// Then
+	assert.NoError(t, err)
+	mockComparisonRepo.AssertNotCalled(t, "StripComparisonBodies", mock.Anything, mock.Anything)
+	mockComparisonRepo.AssertNotCalled(t, "RollupComparisons", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,72 @@
+package service
+
+import (
+	"demo-api-bridge/internal/core/domain"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// comparisonSampler는 규칙별 샘플링 정책(domain.ComparisonSampling)에 따라 비교 결과 저장 여부를 결정합니다.
+// 분당 상한은 규칙별 고정 1분 구간(wall clock 기준)마다 저장 건수를 세어 적용합니다.
+type comparisonSampler struct {
+	mu      sync.Mutex
+	windows map[string]*sampleWindow // 규칙 ID → 현재 1분 구간
+
+	now   func() time.Time
+	float func() float64
+}
+
+// sampleWindow는 규칙 하나의 현재 1분 구간 저장 건수입니다.
+type sampleWindow struct {
+	start  time.Time
+	stored int
+}
+
+// newComparisonSampler는 새로운 샘플러를 생성합니다.
+func newComparisonSampler() *comparisonSampler {
+	return &comparisonSampler{
+		windows: make(map[string]*sampleWindow),
+		now:     time.Now,
+		float:   rand.Float64,
+	}
+}
+
+// shouldStore는 비교 결과를 저장할지 결정합니다.
+// 판단 순서는 domain.ComparisonSampling 문서를 따르며, 항상 저장하는 불일치도 분당 건수에는 포함합니다.
+func (s *comparisonSampler) shouldStore(rule *domain.OrchestrationRule, comparison *domain.APIComparison) bool {
+	sampling := rule.ComparisonConfig.Sampling
+	if sampling.IsZero() {
+		return true
+	}
+
+	if sampling.AlwaysStoreMismatches && !comparison.IsSuccessful() {
+		s.count(rule.ID, 0)
+		return true
+	}
+
+	if rate := sampling.EffectiveRate(); rate < 1 && s.float() >= rate {
+		return false
+	}
+
+	return s.count(rule.ID, sampling.MaxPerMinute)
+}
+
+// count는 현재 1분 구간의 저장 건수를 늘립니다. limit을 넘으면 false를 반환합니다 (0이면 제한 없음).
+func (s *comparisonSampler) count(ruleID string, limit int) bool {
+	minute := s.now().Truncate(time.Minute)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	window, exists := s.windows[ruleID]
+	if !exists || !window.start.Equal(minute) {
+		window = &sampleWindow{start: minute}
+		s.windows[ruleID] = window
+	}
+	if limit > 0 && window.stored >= limit {
+		return false
+	}
+	window.stored++
+	return true
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"demo-api-bridge/internal/core/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestComparisonSampler는 현재 시간과 난수가 고정된 샘플러를 생성합니다.
+func newTestComparisonSampler(now *time.Time, random float64) *comparisonSampler {
+	sampler := newComparisonSampler()
+	sampler.now = func() time.Time { return *now }
+	sampler.float = func() float64 { return random }
+	return sampler
+}
+
+func newSampledRule(sampling domain.ComparisonSampling) *domain.OrchestrationRule {
+	rule := domain.NewOrchestrationRule("orch-1", "sampled", "rule-1", "legacy-endpoint-1", "modern-endpoint-1")
+	rule.ComparisonConfig.Sampling = sampling
+	return rule
+}
+
+func TestComparisonSampler_ShouldStore(t *testing.T) {
+	matched := &domain.APIComparison{MatchRate: 1.0}
+	mismatched := &domain.APIComparison{MatchRate: 0.5}
+
+	tests := []struct {
+		name       string
+		sampling   domain.ComparisonSampling
+		random     float64
+		comparison *domain.APIComparison
+		want       bool
+	}{
+		{"no policy stores everything", domain.ComparisonSampling{}, 0.99, matched, true},
+		{"sampled in", domain.ComparisonSampling{Rate: 0.1}, 0.05, matched, true},
+		{"sampled out", domain.ComparisonSampling{Rate: 0.1}, 0.5, matched, false},
+		{"mismatch sampled out without always store", domain.ComparisonSampling{Rate: 0.1}, 0.5, mismatched, false},
+		{"mismatch always stored", domain.ComparisonSampling{Rate: 0.1, AlwaysStoreMismatches: true}, 0.5, mismatched, true},
+		{"match with always store still sampled", domain.ComparisonSampling{Rate: 0.1, AlwaysStoreMismatches: true}, 0.5, matched, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := time.Date(2025, 1, 5, 12, 0, 0, 0, time.UTC)
+			sampler := newTestComparisonSampler(&now, tt.random)
+
+			assert.Equal(t, tt.want, sampler.shouldStore(newSampledRule(tt.sampling), tt.comparison))
+		})
+	}
+}
+
+func TestComparisonSampler_MaxPerMinute(t *testing.T) {
+	now := time.Date(2025, 1, 5, 12, 0, 10, 0, time.UTC)
+	sampler := newTestComparisonSampler(&now, 0)
+	rule := newSampledRule(domain.ComparisonSampling{MaxPerMinute: 2, AlwaysStoreMismatches: true})
+	other := newSampledRule(domain.ComparisonSampling{MaxPerMinute: 2})
+	other.ID = "orch-2"
+	matched := &domain.APIComparison{MatchRate: 1.0}
+	mismatched := &domain.APIComparison{MatchRate: 0.5}
+
+	assert.True(t, sampler.shouldStore(rule, matched))
+	assert.True(t, sampler.shouldStore(rule, matched))
+	assert.False(t, sampler.shouldStore(rule, matched), "third comparison in the same minute exceeds the cap")
+	assert.True(t, sampler.shouldStore(rule, mismatched), "mismatches bypass the cap")
+	assert.True(t, sampler.shouldStore(other, matched), "cap is tracked per rule")
+
+	now = now.Add(time.Minute)
+	assert.True(t, sampler.shouldStore(rule, matched), "cap resets in the next minute")
+}
@@ -21,17 +21,20 @@ import (
 //   - 병렬 호출로 인한 추가 지연시간: ~5-10ms (레거시 응답 대기)
 //   - JSON 비교 오버헤드: 응답 크기에 비례 (일반적으로 <10ms)
 type orchestrationService struct {
-	orchestrationRepo port.OrchestrationRepository // 오케스트레이션 규칙 저장소
-	comparisonRepo    port.ComparisonRepository    // 비교 결과 저장소
-	externalAPI       port.ExternalAPIClient       // 외부 API 클라이언트
-	logger            port.Logger                  // 로거
-	metrics           port.MetricsCollector        // 메트릭 수집기
+	orchestrationRepo port.OrchestrationRepository     // 오케스트레이션 규칙 저장소
+	comparisonRepo    port.ComparisonRepository        // 비교 결과 저장소
+	historyRepo       port.TransitionHistoryRepository // 전환 이력 저장소
+	externalAPI       port.ExternalAPIClient           // 외부 API 클라이언트
+	logger            port.Logger                      // 로거
+	metrics           port.MetricsCollector            // 메트릭 수집기
+	now               func() time.Time                 // 현재 시간 (테스트에서 교체)
 }
 
 // NewOrchestrationService : 새로운 OrchestrationService를 생성합니다.
 func NewOrchestrationService(
 	orchestrationRepo port.OrchestrationRepository,
 	comparisonRepo port.ComparisonRepository,
+	historyRepo port.TransitionHistoryRepository,
 	externalAPI port.ExternalAPIClient,
 	logger port.Logger,
 	metrics port.MetricsCollector,
@@ -39,9 +42,11 @@ func NewOrchestrationService(
 	return &orchestrationService{
 		orchestrationRepo: orchestrationRepo,
 		comparisonRepo:    comparisonRepo,
+		historyRepo:       historyRepo,
 		externalAPI:       externalAPI,
 		logger:            logger,
 		metrics:           metrics,
+		now:               time.Now,
 	}
 }
 
@@ -59,9 +64,10 @@ func NewOrchestrationService(
 //
 // Parameters:
 //   - ctx: 요청 컨텍스트 (타임아웃, 취소 신호 포함)
-//   - request: 원본 요청
+//   - rule: 오케스트레이션 규칙 (ComparisonConfig로 비교 방식 결정)
+//   - request: 원본 요청 (모던 API에는 request.ForMirror()로 미러 헤더를 추가해 전송)
 //   - legacyEndpoint: 레거시 API 엔드포인트
-//   - modernEndpoint: 모던 API 엔드포인트
+//   - modernEndpoint: 모던 API 엔드포인트 (쓰기 요청이면 라우팅 규칙의 샌드박스 엔드포인트일 수 있음)
 //
 // Returns:
 //   - *domain.APIComparison: 비교 결과 (일치율, 차이점 포함)
@@ -72,6 +78,7 @@ func NewOrchestrationService(
 //   - 한쪽만 실패 시 비교 불가로 기록하고 성공한 응답 사용
 func (s *orchestrationService) ProcessParallelRequest(
 	ctx context.Context,
+	rule *domain.OrchestrationRule,
 	request *domain.Request,
 	legacyEndpoint, modernEndpoint *domain.APIEndpoint,
 ) (*domain.APIComparison, error) {
@@ -117,7 +124,7 @@ func (s *orchestrationService) ProcessParallelRequest(
 		modernCtx, cancel := context.WithTimeout(ctx, modernEndpoint.Timeout)
 		defer cancel()
 
-		response, err := s.externalAPI.SendWithRetry(modernCtx, modernEndpoint, request)
+		response, err := s.externalAPI.SendWithRetry(modernCtx, modernEndpoint, request.ForMirror())
 		resultChan <- apiResult{
 			response: response,
 			err:      err,
@@ -169,25 +176,107 @@ func (s *orchestrationService) ProcessParallelRequest(
 		s.metrics.IncrementCounter("parallel_api_calls_failed", map[string]string{
 			"request_id": request.ID,
 		})
-		return nil, fmt.Errorf("both legacy and modern API calls failed: legacy=%v, modern=%v", legacyErr, modernErr)
+		return nil, fmt.Errorf("%w: legacy=%v, modern=%v", domain.ErrAllAPICallsFailed, legacyErr, modernErr)
 	}
 
+	return s.compareResponses(ctx, rule, request, start, legacyResponse, legacyErr, modernResponse, modernErr), nil
+}
+
+// CompareShadowResponse : 이미 받은 레거시 응답을 모던 API 응답과 비교합니다.
+//
+// SHADOW 모드에서 레거시 응답을 클라이언트에 먼저 반환한 뒤 백그라운드에서 호출됩니다.
+// 모던 API 호출이 실패해도 에러 대신 일치율 0의 비교 결과를 반환합니다.
+func (s *orchestrationService) CompareShadowResponse(
+	ctx context.Context,
+	rule *domain.OrchestrationRule,
+	request *domain.Request,
+	legacyResponse *domain.Response,
+	modernEndpoint *domain.APIEndpoint,
+) (*domain.APIComparison, error) {
+	if legacyResponse == nil {
+		return nil, fmt.Errorf("legacy response is required for shadow comparison")
+	}
+
+	start := time.Now()
+
+	modernCtx, cancel := context.WithTimeout(ctx, modernEndpoint.Timeout)
+	defer cancel()
+
+	modernResponse, modernErr := s.externalAPI.SendWithRetry(modernCtx, modernEndpoint, request.ForMirror())
+
+	s.metrics.RecordHistogram("shadow_api_call_duration", float64(time.Since(start).Milliseconds()), map[string]string{
+		"endpoint_id": modernEndpoint.ID,
+		"success":     fmt.Sprintf("%t", modernErr == nil),
+	})
+
+	return s.compareResponses(ctx, rule, request, start, legacyResponse, nil, modernResponse, modernErr), nil
+}
+
+// CompareCanaryResponse : 카나리 그룹이 받은 모던 응답을 레거시 API 응답과 비교합니다.
+//
+// CANARY 모드의 모던 그룹 응답이나 샘플링된 MODERN_ONLY 응답을 클라이언트에 반환한 뒤
+// 백그라운드에서 호출되며, 단계적 전환과 자동 롤백 판단에 필요한 일치율과 모던 API 에러율을 수집합니다.
+// modernResponse가 nil이면 모던 API 호출 실패로 보고 일치율 0의 비교 결과를 반환합니다.
+// 쓰기 요청이면 호출자가 운영 레거시 대신 미러링 정책의 레거시 샌드박스 엔드포인트를 legacyEndpoint로 넘깁니다.
+func (s *orchestrationService) CompareCanaryResponse(
+	ctx context.Context,
+	rule *domain.OrchestrationRule,
+	request *domain.Request,
+	modernResponse *domain.Response,
+	legacyEndpoint *domain.APIEndpoint,
+) (*domain.APIComparison, error) {
+	start := time.Now()
+
+	legacyCtx, cancel := context.WithTimeout(ctx, legacyEndpoint.Timeout)
+	defer cancel()
+
+	legacyResponse, legacyErr := s.externalAPI.SendWithRetry(legacyCtx, legacyEndpoint, request.ForMirror())
+
+	s.metrics.RecordHistogram("canary_comparison_call_duration", float64(time.Since(start).Milliseconds()), map[string]string{
+		"endpoint_id": legacyEndpoint.ID,
+		"success":     fmt.Sprintf("%t", legacyErr == nil),
+	})
+
+	if legacyErr != nil {
+		return nil, fmt.Errorf("legacy API call failed for canary comparison: %w", legacyErr)
+	}
+
+	return s.compareResponses(ctx, rule, request, start, legacyResponse, nil, modernResponse, nil), nil
+}
+
+// compareResponses는 레거시/모던 응답으로 비교 결과를 생성하고 일치율 메트릭을 기록합니다.
+// 두 응답 중 하나만 있으면 실패한 쪽을 차이점으로 기록하고 일치율은 0이 됩니다.
+//
+// 비교 방식은 규칙의 ComparisonConfig(무시 필드, 허용 오차, 엄격 모드)를 따르며,
+// 비교 설정이 없는 규칙은 domain.DefaultComparisonConfig를 사용합니다.
+// 비교가 비활성화(Enabled=false)된 규칙은 응답만 담고 Skipped로 표시한 결과를 반환합니다.
+func (s *orchestrationService) compareResponses(
+	ctx context.Context,
+	rule *domain.OrchestrationRule,
+	request *domain.Request,
+	start time.Time,
+	legacyResponse *domain.Response, legacyErr error,
+	modernResponse *domain.Response, modernErr error,
+) *domain.APIComparison {
+	config := rule.EffectiveComparisonConfig()
+
 	// API 비교 객체 생성
 	comparison := domain.NewAPIComparison(request.ID, request.ID, request.RoutingRuleID, legacyResponse, modernResponse)
-	comparison.ComparisonDuration = time.Since(start)
+	if rule != nil {
+		comparison.OrchestrationRuleID = rule.ID
+	}
+	comparison.StrictMode = config.StrictMode
+
+	if !config.Enabled {
+		comparison.Skipped = true
+		comparison.ComparisonDuration = time.Since(start)
+		s.logger.WithContext(ctx).Debug("API comparison skipped", "request_id", request.ID, "reason", "comparison disabled")
+		return comparison
+	}
 
 	// 응답 비교 수행
 	if legacyResponse != nil && modernResponse != nil {
-		// 실제 JSON 비교 엔진 사용
-		comparisonEngine := domain.NewComparisonEngine(domain.ComparisonConfig{
-			Enabled:               true,
-			IgnoreFields:          []string{"timestamp", "requestId", "request_id"},
-			AllowableDifference:   0.01, // 1% 허용 오차
-			StrictMode:            false,
-			SaveComparisonHistory: true,
-		})
-
-		comparisonResult := comparisonEngine.CompareResponses(legacyResponse, modernResponse)
+		comparisonResult := domain.NewComparisonEngine(config).CompareResponses(legacyResponse, modernResponse)
 		comparison.MatchRate = comparisonResult.MatchRate
 		comparison.Differences = comparisonResult.Differences
 	} else {
@@ -223,6 +312,8 @@ func (s *orchestrationService) ProcessParallelRequest(
 		}
 	}
 
+	comparison.ComparisonDuration = time.Since(start)
+
 	// 비교 결과 메트릭 기록
 	s.metrics.RecordGauge("api_comparison_match_rate", comparison.MatchRate, map[string]string{
 		"request_id": request.ID,
@@ -234,7 +325,7 @@ func (s *orchestrationService) ProcessParallelRequest(
 		"differences_count", len(comparison.Differences),
 	)
 
-	return comparison, nil
+	return comparison
 }
 
 // GetOrchestrationRule : 오케스트레이션 규칙을 조회합니다.
@@ -293,57 +384,61 @@ func (s *orchestrationService) UpdateOrchestrationRule(ctx context.Context, rule
 }
 
 // EvaluateTransition : 전환 가능성을 평가합니다.
-func (s *orchestrationService) EvaluateTransition(ctx context.Context, rule *domain.OrchestrationRule) (bool, error) {
-	if !rule.TransitionConfig.AutoTransitionEnabled {
-		return false, nil
-	}
-
-	// 최근 비교 결과 조회
-	recentComparisons, err := s.comparisonRepo.GetRecentComparisons(ctx, rule.RoutingRuleID, rule.TransitionConfig.MinRequestsForTransition)
-	if err != nil {
-		s.logger.WithContext(ctx).Error("failed to get recent comparisons", "error", err)
-		return false, err
-	}
-
-	if len(recentComparisons) < rule.TransitionConfig.MinRequestsForTransition {
-		s.logger.WithContext(ctx).Info("insufficient comparison data for transition",
-			"rule_id", rule.ID,
-			"required", rule.TransitionConfig.MinRequestsForTransition,
-			"available", len(recentComparisons),
-		)
-		return false, nil
+//
+// 안정성 확인 기간(StabilityPeriod)을 구간으로 나누어 구간별 비교 통계를 조회하고,
+// 모든 구간의 일치율과 샘플 수, 기간 전체 통과율의 신뢰 하한으로 판정합니다 (domain.EvaluateStability 참고).
+// 자동 전환 대상이 아닌 규칙은 통계를 조회하지 않고 사유만 담은 평가 결과를 반환합니다.
+func (s *orchestrationService) EvaluateTransition(ctx context.Context, rule *domain.OrchestrationRule) (*domain.TransitionEvaluation, error) {
+	now := s.now()
+	windows := rule.StabilityWindows(now)
+
+	if rule.AutoTransitionBlocker() != "" {
+		return rule.EvaluateStability(windows, now), nil
 	}
 
-	// 평균 일치율 계산
-	var totalMatchRate float64
-	for _, comp := range recentComparisons {
-		totalMatchRate += comp.MatchRate
+	// 구간별 비교 통계 조회
+	for i := range windows {
+		stats, err := s.comparisonRepo.GetComparisonStatistics(ctx, rule.RoutingRuleID, windows[i].Start, windows[i].End)
+		if err != nil {
+			s.logger.WithContext(ctx).Error("failed to get comparison statistics", "rule_id", rule.ID, "error", err)
+			return nil, err
+		}
+		windows[i].SampleSize = stats.TotalComparisons
+		windows[i].SuccessfulMatches = stats.SuccessfulMatches
+		windows[i].MatchRate = stats.AverageMatchRate
 	}
-	averageMatchRate := totalMatchRate / float64(len(recentComparisons))
 
-	// 전환 조건 확인
-	canTransition := rule.CanTransitionToModern(averageMatchRate, len(recentComparisons))
+	evaluation := rule.EvaluateStability(windows, now)
 
 	s.logger.WithContext(ctx).Info("transition evaluation completed",
 		"rule_id", rule.ID,
-		"average_match_rate", averageMatchRate,
-		"can_transition", canTransition,
-		"comparisons_count", len(recentComparisons),
+		"can_transition", evaluation.CanTransition,
+		"reason", evaluation.Reason,
+		"match_rate", evaluation.MatchRate,
+		"pass_rate_lower_bound", evaluation.PassRateLowerBound,
+		"comparisons_count", evaluation.SampleSize,
 	)
 
-	return canTransition, nil
+	return evaluation, nil
 }
 
-// ExecuteTransition : API 모드를 전환합니다.
-func (s *orchestrationService) ExecuteTransition(ctx context.Context, rule *domain.OrchestrationRule, newMode domain.APIMode) error {
+// ExecuteTransition : API 모드를 전환하고 전환 이력을 기록합니다.
+//
+// 전환 이력에는 현재 관측 구간(단계 시작 이후)의 일치율/에러율 스냅샷과
+// 전환 주체(trigger), 실행자(actor)가 함께 저장됩니다.
+// 이력 저장 실패는 전환 자체를 되돌리지 않고 경고 로그만 남깁니다.
+func (s *orchestrationService) ExecuteTransition(ctx context.Context, rule *domain.OrchestrationRule, newMode domain.APIMode, trigger domain.TransitionTrigger, actor string) error {
 	s.logger.WithContext(ctx).Info("executing API mode transition",
 		"rule_id", rule.ID,
 		"from_mode", rule.CurrentMode,
 		"to_mode", newMode,
 	)
 
-	// 모드 전환
-	rule.CurrentMode = newMode
+	now := s.now()
+	signals := s.transitionSnapshot(ctx, rule, now)
+
+	// 모드 전환 (단계적 전환의 관측 구간도 새 모드 기준으로 다시 시작)
+	event := rule.ApplyModeTransition(newMode, trigger, actor, signals, now)
 
 	// 저장
 	if err := s.orchestrationRepo.Update(ctx, rule); err != nil {
@@ -351,6 +446,10 @@ func (s *orchestrationService) ExecuteTransition(ctx context.Context, rule *doma
 		return err
 	}
 
+	if err := s.historyRepo.Save(ctx, event); err != nil {
+		s.logger.WithContext(ctx).Warn("failed to save transition event", "rule_id", rule.ID, "error", err)
+	}
+
 	s.logger.WithContext(ctx).Info("API mode transition completed successfully",
 		"rule_id", rule.ID,
 		"new_mode", newMode,
@@ -358,9 +457,51 @@ func (s *orchestrationService) ExecuteTransition(ctx context.Context, rule *doma
 
 	s.metrics.IncrementCounter("api_mode_transitions", map[string]string{
 		"rule_id":   rule.ID,
-		"from_mode": string(rule.CurrentMode),
+		"from_mode": string(event.FromMode),
 		"to_mode":   string(newMode),
+		"trigger":   string(trigger),
 	})
 
 	return nil
 }
+
+// GetTransitionHistory : 오케스트레이션 규칙의 전환 이력을 최신순으로 조회합니다.
+func (s *orchestrationService) GetTransitionHistory(ctx context.Context, ruleID string, limit int) ([]*domain.TransitionEvent, error) {
+	events, err := s.historyRepo.FindByRuleID(ctx, ruleID, limit)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("failed to get transition history", "rule_id", ruleID, "error", err)
+		return nil, err
+	}
+	return events, nil
+}
+
+// GetComparison : 저장된 비교 결과를 ID로 조회합니다.
+func (s *orchestrationService) GetComparison(ctx context.Context, id string) (*domain.APIComparison, error) {
+	comparison, err := s.comparisonRepo.GetComparison(ctx, id)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("failed to get comparison", "comparison_id", id, "error", err)
+		return nil, err
+	}
+	return comparison, nil
+}
+
+// GetComparisonInsights : 라우팅 규칙의 비교 결과 분석(불일치 경로 순위, 차이점 유형 분포, 일치율 추이)을 조회합니다.
+func (s *orchestrationService) GetComparisonInsights(ctx context.Context, routingRuleID string, from, to time.Time, bucket domain.InsightBucket, limit int) (*domain.ComparisonInsights, error) {
+	insights, err := s.comparisonRepo.GetComparisonInsights(ctx, routingRuleID, from, to, bucket, limit)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("failed to get comparison insights", "routing_rule_id", routingRuleID, "error", err)
+		return nil, err
+	}
+	return insights, nil
+}
+
+// transitionSnapshot : 현재 관측 구간의 비교 통계로 전환 시점의 지표 스냅샷을 만듭니다.
+// 통계 조회에 실패하면 빈 스냅샷을 반환합니다.
+func (s *orchestrationService) transitionSnapshot(ctx context.Context, rule *domain.OrchestrationRule, now time.Time) domain.TransitionSignals {
+	stats, err := s.comparisonRepo.GetComparisonStatistics(ctx, rule.RoutingRuleID, rule.TransitionConfig.RampState.StageStartedAt, now)
+	if err != nil {
+		s.logger.WithContext(ctx).Warn("failed to get comparison statistics for transition snapshot", "rule_id", rule.ID, "error", err)
+		return domain.TransitionSignals{}
+	}
+	return transitionSignals(stats)
+}
@@ -3,7 +3,9 @@ package service
 import (
 	"context"
 	"demo-api-bridge/internal/core/domain"
+	"demo-api-bridge/internal/core/port"
 	"errors"
+	"net/http"
 	"testing"
 	"time"
 
@@ -22,6 +24,7 @@ func TestOrchestrationService_ProcessParallelRequest_Success(t *testing.T) {
 	service := NewOrchestrationService(
 		mockOrchestrationRepo,
 		mockComparisonRepo,
+		&MockTransitionHistoryRepository{},
 		mockExternalAPI,
 		mockLogger,
 		mockMetrics,
@@ -74,7 +77,7 @@ func TestOrchestrationService_ProcessParallelRequest_Success(t *testing.T) {
 	mockLogger.On("Info", "API comparison completed", "request_id", "test-request-id", "match_rate", mock.AnythingOfType("float64"), "differences_count", mock.AnythingOfType("int")).Return()
 
 	// When
-	comparison, err := service.ProcessParallelRequest(ctx, request, legacyEndpoint, modernEndpoint)
+	comparison, err := service.ProcessParallelRequest(ctx, nil, request, legacyEndpoint, modernEndpoint)
 
 	// Then
 	assert.NoError(t, err)
@@ -88,6 +91,56 @@ func TestOrchestrationService_ProcessParallelRequest_Success(t *testing.T) {
 	mockMetrics.AssertExpectations(t)
 }
 
+func TestOrchestrationService_ProcessParallelRequest_DryRunHeaderOnModernOnly(t *testing.T) {
+	// Given
+	mockExternalAPI := &MockExternalAPIClient{}
+	mockLogger := &MockLogger{}
+	mockMetrics := &MockMetricsCollector{}
+
+	service := NewOrchestrationService(
+		&MockOrchestrationRepository{},
+		&MockComparisonRepository{},
+		&MockTransitionHistoryRepository{},
+		mockExternalAPI,
+		mockLogger,
+		mockMetrics,
+	)
+
+	ctx := context.Background()
+	request := &domain.Request{
+		ID:            "test-request-id",
+		Method:        "POST",
+		Path:          "/api/users",
+		Headers:       http.Header{"Content-Type": {"application/json"}},
+		MirrorHeaders: map[string]string{domain.DryRunHeader: "true"},
+	}
+	legacyEndpoint := &domain.APIEndpoint{ID: "legacy-endpoint-1", BaseURL: "https://legacy-api.example.com", Timeout: 30 * time.Second}
+	sandboxEndpoint := &domain.APIEndpoint{ID: "sandbox-endpoint-1", BaseURL: "https://sandbox-api.example.com", Timeout: 30 * time.Second}
+	response := &domain.Response{RequestID: "test-request-id", StatusCode: 201, Body: []byte(`{"id": 1}`)}
+
+	isDryRun := mock.MatchedBy(func(r *domain.Request) bool {
+		return r.Headers.Get(domain.DryRunHeader) == "true" && r.Headers.Get("Content-Type") == "application/json"
+	})
+
+	mockLogger.On("WithContext", ctx).Return(mockLogger)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	mockExternalAPI.On("SendWithRetry", mock.Anything, legacyEndpoint, request).Return(response, nil)
+	mockExternalAPI.On("SendWithRetry", mock.Anything, sandboxEndpoint, isDryRun).Return(response, nil)
+	mockMetrics.On("RecordHistogram", "parallel_api_call_duration", mock.Anything, mock.Anything).Return()
+	mockMetrics.On("RecordGauge", "api_comparison_match_rate", mock.Anything, mock.Anything).Return()
+
+	// When
+	comparison, err := service.ProcessParallelRequest(ctx, nil, request, legacyEndpoint, sandboxEndpoint)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, 1.0, comparison.MatchRate)
+	_, legacyHasHeader := request.GetHeader(domain.DryRunHeader)
+	assert.False(t, legacyHasHeader, "dry-run header must not leak into the legacy request")
+	mockExternalAPI.AssertExpectations(t)
+}
+
 func TestOrchestrationService_ProcessParallelRequest_BothAPIsFail(t *testing.T) {
 	// Given
 	mockOrchestrationRepo := &MockOrchestrationRepository{}
@@ -99,6 +152,7 @@ func TestOrchestrationService_ProcessParallelRequest_BothAPIsFail(t *testing.T)
 	service := NewOrchestrationService(
 		mockOrchestrationRepo,
 		mockComparisonRepo,
+		&MockTransitionHistoryRepository{},
 		mockExternalAPI,
 		mockLogger,
 		mockMetrics,
@@ -141,7 +195,7 @@ func TestOrchestrationService_ProcessParallelRequest_BothAPIsFail(t *testing.T)
 	mockMetrics.On("IncrementCounter", "parallel_api_calls_failed", mock.AnythingOfType("map[string]string")).Return()
 
 	// When
-	comparison, err := service.ProcessParallelRequest(ctx, request, legacyEndpoint, modernEndpoint)
+	comparison, err := service.ProcessParallelRequest(ctx, nil, request, legacyEndpoint, modernEndpoint)
 
 	// Then
 	assert.Error(t, err)
@@ -164,6 +218,7 @@ func TestOrchestrationService_ProcessParallelRequest_OneAPIFails(t *testing.T) {
 	service := NewOrchestrationService(
 		mockOrchestrationRepo,
 		mockComparisonRepo,
+		&MockTransitionHistoryRepository{},
 		mockExternalAPI,
 		mockLogger,
 		mockMetrics,
@@ -212,7 +267,7 @@ func TestOrchestrationService_ProcessParallelRequest_OneAPIFails(t *testing.T) {
 	mockLogger.On("Info", "API comparison completed", "request_id", "test-request-id", "match_rate", mock.AnythingOfType("float64"), "differences_count", mock.AnythingOfType("int")).Return()
 
 	// When
-	comparison, err := service.ProcessParallelRequest(ctx, request, legacyEndpoint, modernEndpoint)
+	comparison, err := service.ProcessParallelRequest(ctx, nil, request, legacyEndpoint, modernEndpoint)
 
 	// Then
 	assert.NoError(t, err)
@@ -227,22 +282,150 @@ func TestOrchestrationService_ProcessParallelRequest_OneAPIFails(t *testing.T) {
 	mockMetrics.AssertExpectations(t)
 }
 
-func TestOrchestrationService_EvaluateTransition_Success(t *testing.T) {
+func TestOrchestrationService_CompareShadowResponse(t *testing.T) {
 	// Given
-	mockOrchestrationRepo := &MockOrchestrationRepository{}
-	mockComparisonRepo := &MockComparisonRepository{}
 	mockExternalAPI := &MockExternalAPIClient{}
 	mockLogger := &MockLogger{}
 	mockMetrics := &MockMetricsCollector{}
 
 	service := NewOrchestrationService(
-		mockOrchestrationRepo,
-		mockComparisonRepo,
+		&MockOrchestrationRepository{},
+		&MockComparisonRepository{},
+		&MockTransitionHistoryRepository{},
 		mockExternalAPI,
 		mockLogger,
 		mockMetrics,
 	)
 
+	ctx := context.Background()
+	request := &domain.Request{ID: "test-request-id", Method: "GET", Path: "/api/users"}
+	modernEndpoint := &domain.APIEndpoint{
+		ID:       "modern-endpoint-1",
+		BaseURL:  "https://modern-api.example.com",
+		IsActive: true,
+		Timeout:  30 * time.Second,
+	}
+	legacyResponse := &domain.Response{StatusCode: 200, Body: []byte(`{"id": 1, "name": "John"}`)}
+	modernResponse := &domain.Response{StatusCode: 200, Body: []byte(`{"id": 1, "name": "John"}`)}
+
+	mockLogger.On("WithContext", ctx).Return(mockLogger)
+	mockExternalAPI.On("SendWithRetry", mock.AnythingOfType("*context.timerCtx"), modernEndpoint, request).Return(modernResponse, nil)
+	mockMetrics.On("RecordHistogram", "shadow_api_call_duration", mock.AnythingOfType("float64"), map[string]string{"endpoint_id": "modern-endpoint-1", "success": "true"}).Return()
+	mockMetrics.On("RecordGauge", "api_comparison_match_rate", mock.AnythingOfType("float64"), mock.AnythingOfType("map[string]string")).Return()
+	mockLogger.On("Info", "API comparison completed", "request_id", "test-request-id", "match_rate", mock.AnythingOfType("float64"), "differences_count", mock.AnythingOfType("int")).Return()
+
+	// When
+	comparison, err := service.CompareShadowResponse(ctx, nil, request, legacyResponse, modernEndpoint)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, 1.0, comparison.MatchRate)
+	assert.Same(t, legacyResponse, comparison.LegacyResponse)
+	mockExternalAPI.AssertExpectations(t)
+	mockMetrics.AssertExpectations(t)
+}
+
+func TestOrchestrationService_CompareShadowResponse_UsesRuleComparisonConfig(t *testing.T) {
+	legacyBody := []byte(`{"id": 1, "price": 100.0, "traceId": "a"}`)
+	modernBody := []byte(`{"id": 1, "price": 100.4, "traceId": "b"}`)
+	bodyOnly := domain.ComparisonWeights{Body: 1}
+
+	tests := []struct {
+		name           string
+		config         domain.ComparisonConfig
+		wantSkipped    bool
+		wantMatchRate  float64
+		wantSuccessful bool
+	}{
+		{
+			name:           "ignore fields and allowable difference from rule",
+			config:         domain.ComparisonConfig{Enabled: true, IgnoreFields: []string{"traceId"}, AllowableDifference: 0.5, Weights: bodyOnly},
+			wantMatchRate:  1.0,
+			wantSuccessful: true,
+		},
+		{
+			name:           "fields not listed in rule are compared",
+			config:         domain.ComparisonConfig{Enabled: true, AllowableDifference: 0.5, Weights: bodyOnly},
+			wantMatchRate:  2.0 / 3.0,
+			wantSuccessful: false,
+		},
+		{
+			name:           "strict mode requires no differences",
+			config:         domain.ComparisonConfig{Enabled: true, IgnoreFields: []string{"traceId"}, StrictMode: true, Weights: bodyOnly},
+			wantMatchRate:  1.0 / 2.0,
+			wantSuccessful: false,
+		},
+		{
+			name:        "comparison disabled",
+			config:      domain.ComparisonConfig{Enabled: false, SaveComparisonHistory: true},
+			wantSkipped: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockExternalAPI := &MockExternalAPIClient{}
+			mockLogger := &MockLogger{}
+			mockMetrics := &MockMetricsCollector{}
+
+			service := NewOrchestrationService(
+				&MockOrchestrationRepository{},
+				&MockComparisonRepository{},
+				&MockTransitionHistoryRepository{},
+				mockExternalAPI,
+				mockLogger,
+				mockMetrics,
+			)
+
+			ctx := context.Background()
+			rule := domain.NewOrchestrationRule("orch-1", "users", "rule-1", "legacy-endpoint-1", "modern-endpoint-1")
+			rule.ComparisonConfig = tt.config
+			request := &domain.Request{ID: "test-request-id", Method: "GET", Path: "/api/users"}
+			modernEndpoint := &domain.APIEndpoint{ID: "modern-endpoint-1", BaseURL: "https://modern-api.example.com", Timeout: 30 * time.Second}
+			legacyResponse := &domain.Response{StatusCode: 200, Body: legacyBody}
+
+			mockLogger.On("WithContext", ctx).Return(mockLogger)
+			mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+			mockLogger.On("Debug", "API comparison skipped", "request_id", "test-request-id", "reason", "comparison disabled").Return()
+			mockExternalAPI.On("SendWithRetry", mock.Anything, modernEndpoint, request).Return(&domain.Response{StatusCode: 200, Body: modernBody}, nil)
+			mockMetrics.On("RecordHistogram", "shadow_api_call_duration", mock.Anything, mock.Anything).Return()
+			mockMetrics.On("RecordGauge", "api_comparison_match_rate", mock.Anything, mock.Anything).Return()
+
+			// When
+			comparison, err := service.CompareShadowResponse(ctx, rule, request, legacyResponse, modernEndpoint)
+
+			// Then
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantSkipped, comparison.Skipped)
+			assert.Equal(t, "orch-1", comparison.OrchestrationRuleID)
+			if tt.wantSkipped {
+				assert.Empty(t, comparison.Differences)
+				mockMetrics.AssertNotCalled(t, "RecordGauge", "api_comparison_match_rate", mock.Anything, mock.Anything)
+				return
+			}
+			assert.InDelta(t, tt.wantMatchRate, comparison.MatchRate, 0.0001)
+			assert.Equal(t, tt.wantSuccessful, comparison.IsSuccessful())
+		})
+	}
+}
+
+func TestOrchestrationService_EvaluateTransition_Success(t *testing.T) {
+	// Given
+	mockComparisonRepo := &MockComparisonRepository{}
+	mockLogger := &MockLogger{}
+
+	service := NewOrchestrationService(
+		&MockOrchestrationRepository{},
+		mockComparisonRepo,
+		&MockTransitionHistoryRepository{},
+		&MockExternalAPIClient{},
+		mockLogger,
+		&MockMetricsCollector{},
+	).(*orchestrationService)
+
+	now := time.Date(2025, 1, 5, 12, 0, 0, 0, time.UTC)
+	service.now = func() time.Time { return now }
+
 	ctx := context.Background()
 	rule := &domain.OrchestrationRule{
 		ID:               "orch-1",
@@ -253,45 +436,57 @@ func TestOrchestrationService_EvaluateTransition_Success(t *testing.T) {
 		TransitionConfig: domain.TransitionConfig{
 			AutoTransitionEnabled:    true,
 			MatchRateThreshold:       0.95,
+			StabilityPeriod:          24 * time.Hour,
 			MinRequestsForTransition: 100,
 		},
 	}
 
-	recentComparisons := make([]*domain.APIComparison, 100)
-	for i := 0; i < 100; i++ {
-		recentComparisons[i] = &domain.APIComparison{MatchRate: 0.98}
+	// 6시간 구간 4개 모두 기준 충족
+	for i := 0; i < 4; i++ {
+		start := now.Add(-24 * time.Hour).Add(time.Duration(i) * 6 * time.Hour)
+		mockComparisonRepo.On("GetComparisonStatistics", ctx, "rule-1", start, start.Add(6*time.Hour)).Return(&port.ComparisonStatistics{
+			TotalComparisons:  40,
+			SuccessfulMatches: 40,
+			AverageMatchRate:  0.98,
+		}, nil).Once()
 	}
-
 	mockLogger.On("WithContext", ctx).Return(mockLogger)
-	mockComparisonRepo.On("GetRecentComparisons", ctx, "rule-1", 100).Return(recentComparisons, nil)
-	mockLogger.On("Info", "transition evaluation completed", "rule_id", "orch-1", "average_match_rate", mock.AnythingOfType("float64"), "can_transition", true, "comparisons_count", 100).Return()
+	mockLogger.On("Info", "transition evaluation completed", "rule_id", "orch-1", "can_transition", true,
+		"reason", mock.AnythingOfType("string"), "match_rate", mock.AnythingOfType("float64"),
+		"pass_rate_lower_bound", mock.AnythingOfType("float64"), "comparisons_count", 160).Return()
 
 	// When
-	canTransition, err := service.EvaluateTransition(ctx, rule)
+	evaluation, err := service.EvaluateTransition(ctx, rule)
 
 	// Then
 	assert.NoError(t, err)
-	assert.True(t, canTransition)
+	assert.True(t, evaluation.CanTransition)
+	assert.Equal(t, 160, evaluation.SampleSize)
+	assert.Len(t, evaluation.Windows, 4)
+	assert.Equal(t, now.Add(-24*time.Hour), evaluation.From)
+	assert.Equal(t, now, evaluation.To)
+	assert.Greater(t, evaluation.PassRateLowerBound, 0.95)
 
 	mockLogger.AssertExpectations(t)
 	mockComparisonRepo.AssertExpectations(t)
 }
 
-func TestOrchestrationService_EvaluateTransition_InsufficientData(t *testing.T) {
+func TestOrchestrationService_EvaluateTransition_StaleData(t *testing.T) {
 	// Given
-	mockOrchestrationRepo := &MockOrchestrationRepository{}
 	mockComparisonRepo := &MockComparisonRepository{}
-	mockExternalAPI := &MockExternalAPIClient{}
 	mockLogger := &MockLogger{}
-	mockMetrics := &MockMetricsCollector{}
 
 	service := NewOrchestrationService(
-		mockOrchestrationRepo,
+		&MockOrchestrationRepository{},
 		mockComparisonRepo,
-		mockExternalAPI,
+		&MockTransitionHistoryRepository{},
+		&MockExternalAPIClient{},
 		mockLogger,
-		mockMetrics,
-	)
+		&MockMetricsCollector{},
+	).(*orchestrationService)
+
+	now := time.Date(2025, 1, 5, 12, 0, 0, 0, time.UTC)
+	service.now = func() time.Time { return now }
 
 	ctx := context.Background()
 	rule := &domain.OrchestrationRule{
@@ -303,34 +498,108 @@ func TestOrchestrationService_EvaluateTransition_InsufficientData(t *testing.T)
 		TransitionConfig: domain.TransitionConfig{
 			AutoTransitionEnabled:    true,
 			MatchRateThreshold:       0.95,
+			StabilityPeriod:          24 * time.Hour,
 			MinRequestsForTransition: 100,
 		},
 	}
 
-	recentComparisons := []*domain.APIComparison{
-		{MatchRate: 0.98},
-		{MatchRate: 0.96},
-	}
-
+	// 가장 오래된 구간에만 비교 결과가 몰려 있고 최근 구간에는 없음
+	oldest := now.Add(-24 * time.Hour)
+	mockComparisonRepo.On("GetComparisonStatistics", ctx, "rule-1", oldest, oldest.Add(6*time.Hour)).Return(&port.ComparisonStatistics{
+		TotalComparisons:  500,
+		SuccessfulMatches: 500,
+		AverageMatchRate:  1.0,
+	}, nil).Once()
+	mockComparisonRepo.On("GetComparisonStatistics", ctx, "rule-1", mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return(&port.ComparisonStatistics{}, nil).Times(3)
 	mockLogger.On("WithContext", ctx).Return(mockLogger)
-	mockComparisonRepo.On("GetRecentComparisons", ctx, "rule-1", 100).Return(recentComparisons, nil)
-	mockLogger.On("Info", "insufficient comparison data for transition", "rule_id", "orch-1", "required", 100, "available", 2).Return()
+	mockLogger.On("Info", "transition evaluation completed", "rule_id", "orch-1", "can_transition", false,
+		"reason", "3 of 4 windows below criteria", "match_rate", 1.0,
+		"pass_rate_lower_bound", mock.AnythingOfType("float64"), "comparisons_count", 500).Return()
 
 	// When
-	canTransition, err := service.EvaluateTransition(ctx, rule)
+	evaluation, err := service.EvaluateTransition(ctx, rule)
 
 	// Then
 	assert.NoError(t, err)
-	assert.False(t, canTransition)
+	assert.False(t, evaluation.CanTransition)
+	assert.True(t, evaluation.Windows[0].Passed)
+	assert.False(t, evaluation.Windows[3].Passed)
+	assert.Equal(t, "samples 0 < 25", evaluation.Windows[3].Reason)
 
 	mockLogger.AssertExpectations(t)
 	mockComparisonRepo.AssertExpectations(t)
 }
 
+func TestOrchestrationService_EvaluateTransition_NotApplicable(t *testing.T) {
+	// Given
+	mockComparisonRepo := &MockComparisonRepository{}
+
+	service := NewOrchestrationService(
+		&MockOrchestrationRepository{},
+		mockComparisonRepo,
+		&MockTransitionHistoryRepository{},
+		&MockExternalAPIClient{},
+		&MockLogger{},
+		&MockMetricsCollector{},
+	)
+
+	rule := &domain.OrchestrationRule{
+		ID:               "orch-1",
+		RoutingRuleID:    "rule-1",
+		CurrentMode:      domain.MODERN_ONLY,
+		TransitionConfig: domain.TransitionConfig{AutoTransitionEnabled: true, MatchRateThreshold: 0.95},
+	}
+
+	// When
+	evaluation, err := service.EvaluateTransition(context.Background(), rule)
+
+	// Then
+	assert.NoError(t, err)
+	assert.False(t, evaluation.CanTransition)
+	assert.Equal(t, "auto transition not applicable in MODERN_ONLY mode", evaluation.Reason)
+	mockComparisonRepo.AssertNotCalled(t, "GetComparisonStatistics", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestOrchestrationService_EvaluateTransition_StatisticsUnavailable(t *testing.T) {
+	// Given
+	mockComparisonRepo := &MockComparisonRepository{}
+	mockLogger := &MockLogger{}
+
+	service := NewOrchestrationService(
+		&MockOrchestrationRepository{},
+		mockComparisonRepo,
+		&MockTransitionHistoryRepository{},
+		&MockExternalAPIClient{},
+		mockLogger,
+		&MockMetricsCollector{},
+	)
+
+	ctx := context.Background()
+	rule := &domain.OrchestrationRule{
+		ID:               "orch-1",
+		RoutingRuleID:    "rule-1",
+		CurrentMode:      domain.PARALLEL,
+		TransitionConfig: domain.TransitionConfig{AutoTransitionEnabled: true, MatchRateThreshold: 0.95},
+	}
+
+	mockComparisonRepo.On("GetComparisonStatistics", ctx, "rule-1", mock.Anything, mock.Anything).Return(nil, errors.New("database unavailable"))
+	mockLogger.On("WithContext", ctx).Return(mockLogger)
+	mockLogger.On("Error", "failed to get comparison statistics", "rule_id", "orch-1", "error", mock.Anything).Return()
+
+	// When
+	evaluation, err := service.EvaluateTransition(ctx, rule)
+
+	// Then
+	assert.Error(t, err)
+	assert.Nil(t, evaluation)
+	mockComparisonRepo.AssertNumberOfCalls(t, "GetComparisonStatistics", 1)
+}
+
 func TestOrchestrationService_ExecuteTransition_Success(t *testing.T) {
 	// Given
 	mockOrchestrationRepo := &MockOrchestrationRepository{}
 	mockComparisonRepo := &MockComparisonRepository{}
+	mockHistoryRepo := &MockTransitionHistoryRepository{}
 	mockExternalAPI := &MockExternalAPIClient{}
 	mockLogger := &MockLogger{}
 	mockMetrics := &MockMetricsCollector{}
@@ -338,10 +607,14 @@ func TestOrchestrationService_ExecuteTransition_Success(t *testing.T) {
 	service := NewOrchestrationService(
 		mockOrchestrationRepo,
 		mockComparisonRepo,
+		mockHistoryRepo,
 		mockExternalAPI,
 		mockLogger,
 		mockMetrics,
-	)
+	).(*orchestrationService)
+
+	now := time.Date(2025, 1, 5, 12, 0, 0, 0, time.UTC)
+	service.now = func() time.Time { return now }
 
 	ctx := context.Background()
 	rule := &domain.OrchestrationRule{
@@ -354,22 +627,176 @@ func TestOrchestrationService_ExecuteTransition_Success(t *testing.T) {
 
 	mockLogger.On("WithContext", ctx).Return(mockLogger)
 	mockLogger.On("Info", "executing API mode transition", "rule_id", "orch-1", "from_mode", domain.PARALLEL, "to_mode", domain.MODERN_ONLY).Return()
+	mockComparisonRepo.On("GetComparisonStatistics", ctx, "rule-1", time.Time{}, now).Return(&port.ComparisonStatistics{
+		TotalComparisons: 200,
+		AverageMatchRate: 0.99,
+		ModernErrors:     2,
+	}, nil)
 	mockOrchestrationRepo.On("Update", ctx, mock.AnythingOfType("*domain.OrchestrationRule")).Return(nil)
+	mockHistoryRepo.On("Save", ctx, mock.MatchedBy(func(event *domain.TransitionEvent) bool {
+		return event.RuleID == "orch-1" &&
+			event.FromMode == domain.PARALLEL &&
+			event.ToMode == domain.MODERN_ONLY &&
+			event.FromPercentage == 0 &&
+			event.ToPercentage == 100 &&
+			event.Trigger == domain.TransitionTriggerManual &&
+			event.Actor == "alice" &&
+			event.MatchRate == 0.99 &&
+			event.ErrorRate == 0.01 &&
+			event.SampleSize == 200 &&
+			event.Timestamp.Equal(now)
+	})).Return(nil)
 	mockLogger.On("Info", "API mode transition completed successfully", "rule_id", "orch-1", "new_mode", domain.MODERN_ONLY).Return()
-	mockMetrics.On("IncrementCounter", "api_mode_transitions", mock.AnythingOfType("map[string]string")).Return()
+	mockMetrics.On("IncrementCounter", "api_mode_transitions", map[string]string{
+		"rule_id":   "orch-1",
+		"from_mode": "PARALLEL",
+		"to_mode":   "MODERN_ONLY",
+		"trigger":   "manual",
+	}).Return()
 
 	// When
-	err := service.ExecuteTransition(ctx, rule, domain.MODERN_ONLY)
+	err := service.ExecuteTransition(ctx, rule, domain.MODERN_ONLY, domain.TransitionTriggerManual, "alice")
 
 	// Then
 	assert.NoError(t, err)
 	assert.Equal(t, domain.MODERN_ONLY, rule.CurrentMode)
+	assert.True(t, rule.TransitionConfig.RampState.StageStartedAt.Equal(now))
 
 	mockLogger.AssertExpectations(t)
+	mockComparisonRepo.AssertExpectations(t)
 	mockOrchestrationRepo.AssertExpectations(t)
+	mockHistoryRepo.AssertExpectations(t)
 	mockMetrics.AssertExpectations(t)
 }
 
+func TestOrchestrationService_ExecuteTransition_StatisticsUnavailable(t *testing.T) {
+	// Given
+	mockOrchestrationRepo := &MockOrchestrationRepository{}
+	mockComparisonRepo := &MockComparisonRepository{}
+	mockHistoryRepo := &MockTransitionHistoryRepository{}
+	mockLogger := &MockLogger{}
+	mockMetrics := &MockMetricsCollector{}
+
+	service := NewOrchestrationService(
+		mockOrchestrationRepo,
+		mockComparisonRepo,
+		mockHistoryRepo,
+		&MockExternalAPIClient{},
+		mockLogger,
+		mockMetrics,
+	)
+
+	ctx := context.Background()
+	rule := &domain.OrchestrationRule{
+		ID:            "orch-1",
+		RoutingRuleID: "rule-1",
+		CurrentMode:   domain.MODERN_ONLY,
+	}
+
+	mockLogger.On("WithContext", ctx).Return(mockLogger)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	mockLogger.On("Warn", "failed to get comparison statistics for transition snapshot", "rule_id", "orch-1", "error", mock.Anything).Return()
+	mockComparisonRepo.On("GetComparisonStatistics", ctx, "rule-1", mock.Anything, mock.Anything).Return(nil, errors.New("database unavailable"))
+	mockOrchestrationRepo.On("Update", ctx, rule).Return(nil)
+	mockHistoryRepo.On("Save", ctx, mock.MatchedBy(func(event *domain.TransitionEvent) bool {
+		return event.FromMode == domain.MODERN_ONLY &&
+			event.ToMode == domain.LEGACY_ONLY &&
+			event.Trigger == domain.TransitionTriggerAuto &&
+			event.SampleSize == 0
+	})).Return(nil)
+	mockMetrics.On("IncrementCounter", "api_mode_transitions", mock.Anything).Return()
+
+	// When
+	err := service.ExecuteTransition(ctx, rule, domain.LEGACY_ONLY, domain.TransitionTriggerAuto, "bridge-service")
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, domain.LEGACY_ONLY, rule.CurrentMode)
+	mockHistoryRepo.AssertExpectations(t)
+	mockLogger.AssertExpectations(t)
+}
+
+func TestOrchestrationService_GetTransitionHistory(t *testing.T) {
+	// Given
+	mockHistoryRepo := &MockTransitionHistoryRepository{}
+	service := NewOrchestrationService(
+		&MockOrchestrationRepository{},
+		&MockComparisonRepository{},
+		mockHistoryRepo,
+		&MockExternalAPIClient{},
+		&MockLogger{},
+		&MockMetricsCollector{},
+	)
+
+	ctx := context.Background()
+	events := []*domain.TransitionEvent{
+		{ID: "orch-1-2", RuleID: "orch-1", FromMode: domain.PARALLEL, ToMode: domain.MODERN_ONLY},
+		{ID: "orch-1-1", RuleID: "orch-1", FromMode: domain.LEGACY_ONLY, ToMode: domain.PARALLEL},
+	}
+	mockHistoryRepo.On("FindByRuleID", ctx, "orch-1", 10).Return(events, nil)
+
+	// When
+	result, err := service.GetTransitionHistory(ctx, "orch-1", 10)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, events, result)
+	mockHistoryRepo.AssertExpectations(t)
+}
+
+func TestOrchestrationService_GetComparison(t *testing.T) {
+	// Given
+	mockComparisonRepo := &MockComparisonRepository{}
+	service := NewOrchestrationService(
+		&MockOrchestrationRepository{},
+		mockComparisonRepo,
+		&MockTransitionHistoryRepository{},
+		&MockExternalAPIClient{},
+		&MockLogger{},
+		&MockMetricsCollector{},
+	)
+
+	ctx := context.Background()
+	comparison := &domain.APIComparison{ID: "cmp-1", RoutingRuleID: "rule-1", MatchRate: 0.9}
+	mockComparisonRepo.On("GetComparison", ctx, "cmp-1").Return(comparison, nil)
+
+	// When
+	result, err := service.GetComparison(ctx, "cmp-1")
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, comparison, result)
+	mockComparisonRepo.AssertExpectations(t)
+}
+
+func TestOrchestrationService_GetComparisonInsights(t *testing.T) {
+	// Given
+	mockComparisonRepo := &MockComparisonRepository{}
+	service := NewOrchestrationService(
+		&MockOrchestrationRepository{},
+		mockComparisonRepo,
+		&MockTransitionHistoryRepository{},
+		&MockExternalAPIClient{},
+		&MockLogger{},
+		&MockMetricsCollector{},
+	)
+
+	ctx := context.Background()
+	to := time.Now()
+	from := to.Add(-24 * time.Hour)
+	insights := domain.NewComparisonInsights("rule-1", from, to, domain.InsightBucketHour, 20).Finalize()
+	mockComparisonRepo.On("GetComparisonInsights", ctx, "rule-1", from, to, domain.InsightBucketHour, 20).Return(insights, nil)
+
+	// When
+	result, err := service.GetComparisonInsights(ctx, "rule-1", from, to, domain.InsightBucketHour, 20)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, insights, result)
+	mockComparisonRepo.AssertExpectations(t)
+}
+
 func TestOrchestrationService_CreateOrchestrationRule_Success(t *testing.T) {
 	// Given
 	mockOrchestrationRepo := &MockOrchestrationRepository{}
@@ -381,6 +808,7 @@ func TestOrchestrationService_CreateOrchestrationRule_Success(t *testing.T) {
 	service := NewOrchestrationService(
 		mockOrchestrationRepo,
 		mockComparisonRepo,
+		&MockTransitionHistoryRepository{},
 		mockExternalAPI,
 		mockLogger,
 		mockMetrics,
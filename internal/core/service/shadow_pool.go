@@ -0,0 +1,200 @@
+package service
+
+import (
+	"context"
+	"demo-api-bridge/internal/core/port"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ShadowDropPolicy는 섀도우 작업 큐가 가득 찼을 때의 처리 방식입니다.
+type ShadowDropPolicy string
+
+const (
+	// ShadowDropNewest: 새로 들어온 작업을 버림 (기본값)
+	ShadowDropNewest ShadowDropPolicy = "drop_newest"
+	// ShadowDropOldest: 가장 오래 대기한 작업을 버리고 새 작업을 넣음
+	ShadowDropOldest ShadowDropPolicy = "drop_oldest"
+)
+
+// 섀도우 워커 풀 기본값
+const (
+	defaultShadowWorkers    = 8
+	defaultShadowQueueSize  = 1000
+	defaultShadowJobTimeout = 30 * time.Second
+)
+
+// ShadowPoolConfig는 섀도우 워커 풀 설정입니다.
+// 0 이하의 값은 기본값으로 대체됩니다.
+type ShadowPoolConfig struct {
+	Workers        int              // 동시에 실행할 워커 수
+	QueueSize      int              // 대기 큐 크기
+	DropPolicy     ShadowDropPolicy // 큐가 가득 찼을 때의 처리 방식
+	EnqueueTimeout time.Duration    // 큐가 가득 찼을 때 버리기 전 대기할 최대 시간 (0이면 대기 없음)
+	JobTimeout     time.Duration    // 작업 하나의 최대 실행 시간
+}
+
+// ShadowJob은 섀도우 워커에서 실행되는 작업입니다.
+// ctx는 원본 요청의 값을 유지하지만 요청 취소와는 분리되어 있습니다.
+type ShadowJob func(ctx context.Context)
+
+// shadowTask는 큐에 대기 중인 작업입니다.
+type shadowTask struct {
+	ctx    context.Context
+	ruleID string
+	job    ShadowJob
+}
+
+// ShadowPool
+// : SHADOW 모드의 모던 API 호출 및 비교를 백그라운드에서 실행하는 제한된 워커 풀입니다.
+//
+// 큐가 가득 차면 EnqueueTimeout 동안 대기(backpressure)한 뒤 DropPolicy에 따라
+// 작업을 버리고 shadow_jobs_dropped 메트릭을 기록합니다. 요청 처리 경로가
+// 섀도우 작업 때문에 지연되지 않도록 버려진 작업은 재시도하지 않습니다.
+type ShadowPool struct {
+	config  ShadowPoolConfig
+	tasks   chan shadowTask
+	logger  port.Logger
+	metrics port.MetricsCollector
+
+	mu     sync.RWMutex // tasks 채널 송신과 Close 직렬화
+	closed bool
+	wg     sync.WaitGroup
+}
+
+// NewShadowPool은 새로운 섀도우 워커 풀을 생성하고 워커를 시작합니다.
+func NewShadowPool(config ShadowPoolConfig, logger port.Logger, metrics port.MetricsCollector) *ShadowPool {
+	if config.Workers <= 0 {
+		config.Workers = defaultShadowWorkers
+	}
+	if config.QueueSize <= 0 {
+		config.QueueSize = defaultShadowQueueSize
+	}
+	if config.DropPolicy != ShadowDropOldest {
+		config.DropPolicy = ShadowDropNewest
+	}
+	if config.JobTimeout <= 0 {
+		config.JobTimeout = defaultShadowJobTimeout
+	}
+
+	pool := &ShadowPool{
+		config:  config,
+		tasks:   make(chan shadowTask, config.QueueSize),
+		logger:  logger,
+		metrics: metrics,
+	}
+
+	pool.wg.Add(config.Workers)
+	for i := 0; i < config.Workers; i++ {
+		go pool.worker()
+	}
+
+	return pool
+}
+
+// Submit은 섀도우 작업을 큐에 넣습니다.
+// 작업이 수락되면 true, 큐가 가득 차거나 풀이 종료되어 버려지면 false를 반환합니다.
+func (p *ShadowPool) Submit(ctx context.Context, ruleID string, job ShadowJob) bool {
+	task := shadowTask{ctx: context.WithoutCancel(ctx), ruleID: ruleID, job: job}
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	if p.closed {
+		p.recordDrop(ruleID, "closed")
+		return false
+	}
+
+	select {
+	case p.tasks <- task:
+		return true
+	default:
+	}
+
+	if p.config.EnqueueTimeout > 0 {
+		timer := time.NewTimer(p.config.EnqueueTimeout)
+		defer timer.Stop()
+		select {
+		case p.tasks <- task:
+			return true
+		case <-timer.C:
+		}
+	}
+
+	if p.config.DropPolicy == ShadowDropOldest {
+		select {
+		case oldest := <-p.tasks:
+			p.recordDrop(oldest.ruleID, "evicted")
+		default:
+		}
+		select {
+		case p.tasks <- task:
+			return true
+		default:
+		}
+	}
+
+	p.recordDrop(ruleID, "queue_full")
+	return false
+}
+
+// QueueDepth는 현재 대기 중인 작업 수를 반환합니다.
+func (p *ShadowPool) QueueDepth() int {
+	return len(p.tasks)
+}
+
+// Close는 새 작업 수락을 중단하고 대기 중인 작업이 끝날 때까지 기다립니다.
+// ctx가 먼저 만료되면 남은 작업을 기다리지 않고 ctx의 에러를 반환합니다.
+func (p *ShadowPool) Close(ctx context.Context) error {
+	p.mu.Lock()
+	if !p.closed {
+		p.closed = true
+		close(p.tasks)
+	}
+	p.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		p.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("shadow pool shutdown interrupted with %d pending jobs: %w", len(p.tasks), ctx.Err())
+	}
+}
+
+// worker는 큐에서 작업을 꺼내 실행합니다.
+func (p *ShadowPool) worker() {
+	defer p.wg.Done()
+	for task := range p.tasks {
+		p.run(task)
+	}
+}
+
+// run은 작업 하나를 타임아웃과 함께 실행하고, panic이 워커를 종료시키지 않도록 복구합니다.
+func (p *ShadowPool) run(task shadowTask) {
+	ctx, cancel := context.WithTimeout(task.ctx, p.config.JobTimeout)
+	defer cancel()
+
+	defer func() {
+		if r := recover(); r != nil {
+			p.logger.WithContext(ctx).Error("shadow job panicked", "rule_id", task.ruleID, "panic", r)
+			p.metrics.IncrementCounter("shadow_jobs_failed", map[string]string{"rule_id": task.ruleID})
+		}
+	}()
+
+	task.job(ctx)
+}
+
+// recordDrop은 버려진 섀도우 작업 메트릭을 기록합니다.
+func (p *ShadowPool) recordDrop(ruleID, reason string) {
+	p.metrics.IncrementCounter("shadow_jobs_dropped", map[string]string{
+		"rule_id": ruleID,
+		"reason":  reason,
+	})
+}
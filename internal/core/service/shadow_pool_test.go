@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// blockWorker는 단일 워커를 점유하는 작업을 제출하고, 해제 함수를 반환합니다.
+func blockWorker(t *testing.T, pool *ShadowPool) func() {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	ok := pool.Submit(context.Background(), "blocker", func(ctx context.Context) {
+		close(started)
+		<-release
+	})
+	assert.True(t, ok)
+	<-started
+	return func() { close(release) }
+}
+
+func TestShadowPool_RunsSubmittedJobs(t *testing.T) {
+	pool := NewShadowPool(ShadowPoolConfig{Workers: 4, QueueSize: 100}, &MockLogger{}, &MockMetricsCollector{})
+
+	var count int32
+	for i := 0; i < 50; i++ {
+		assert.True(t, pool.Submit(context.Background(), "rule-1", func(ctx context.Context) {
+			atomic.AddInt32(&count, 1)
+		}))
+	}
+
+	assert.NoError(t, pool.Close(context.Background()))
+	assert.Equal(t, int32(50), atomic.LoadInt32(&count))
+}
+
+func TestShadowPool_DropNewestWhenFull(t *testing.T) {
+	mockMetrics := &MockMetricsCollector{}
+	mockMetrics.On("IncrementCounter", "shadow_jobs_dropped", map[string]string{"rule_id": "rule-1", "reason": "queue_full"}).Return()
+
+	pool := NewShadowPool(ShadowPoolConfig{Workers: 1, QueueSize: 1, DropPolicy: ShadowDropNewest}, &MockLogger{}, mockMetrics)
+	release := blockWorker(t, pool)
+
+	var ran []string
+	var mu sync.Mutex
+	record := func(name string) ShadowJob {
+		return func(ctx context.Context) {
+			mu.Lock()
+			ran = append(ran, name)
+			mu.Unlock()
+		}
+	}
+
+	assert.True(t, pool.Submit(context.Background(), "rule-1", record("queued")))
+	assert.False(t, pool.Submit(context.Background(), "rule-1", record("dropped")))
+
+	release()
+	assert.NoError(t, pool.Close(context.Background()))
+	assert.Equal(t, []string{"queued"}, ran)
+	mockMetrics.AssertExpectations(t)
+}
+
+func TestShadowPool_DropOldestWhenFull(t *testing.T) {
+	mockMetrics := &MockMetricsCollector{}
+	mockMetrics.On("IncrementCounter", "shadow_jobs_dropped", map[string]string{"rule_id": "rule-old", "reason": "evicted"}).Return()
+
+	pool := NewShadowPool(ShadowPoolConfig{Workers: 1, QueueSize: 1, DropPolicy: ShadowDropOldest}, &MockLogger{}, mockMetrics)
+	release := blockWorker(t, pool)
+
+	var ran []string
+	var mu sync.Mutex
+	record := func(name string) ShadowJob {
+		return func(ctx context.Context) {
+			mu.Lock()
+			ran = append(ran, name)
+			mu.Unlock()
+		}
+	}
+
+	assert.True(t, pool.Submit(context.Background(), "rule-old", record("old")))
+	assert.True(t, pool.Submit(context.Background(), "rule-new", record("new")))
+
+	release()
+	assert.NoError(t, pool.Close(context.Background()))
+	assert.Equal(t, []string{"new"}, ran)
+	mockMetrics.AssertExpectations(t)
+}
+
+func TestShadowPool_EnqueueTimeoutWaitsForCapacity(t *testing.T) {
+	pool := NewShadowPool(ShadowPoolConfig{Workers: 1, QueueSize: 1, EnqueueTimeout: time.Second}, &MockLogger{}, &MockMetricsCollector{})
+	release := blockWorker(t, pool)
+
+	assert.True(t, pool.Submit(context.Background(), "rule-1", func(ctx context.Context) {}))
+
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		release()
+	}()
+	assert.True(t, pool.Submit(context.Background(), "rule-1", func(ctx context.Context) {}))
+
+	assert.NoError(t, pool.Close(context.Background()))
+}
+
+func TestShadowPool_SubmitAfterClose(t *testing.T) {
+	mockMetrics := &MockMetricsCollector{}
+	mockMetrics.On("IncrementCounter", "shadow_jobs_dropped", map[string]string{"rule_id": "rule-1", "reason": "closed"}).Return()
+
+	pool := NewShadowPool(ShadowPoolConfig{Workers: 1, QueueSize: 1}, &MockLogger{}, mockMetrics)
+	assert.NoError(t, pool.Close(context.Background()))
+
+	assert.False(t, pool.Submit(context.Background(), "rule-1", func(ctx context.Context) {}))
+	mockMetrics.AssertExpectations(t)
+}
+
+func TestShadowPool_JobContextOutlivesRequest(t *testing.T) {
+	pool := NewShadowPool(ShadowPoolConfig{Workers: 1, QueueSize: 1}, &MockLogger{}, &MockMetricsCollector{})
+
+	reqCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var jobErr error
+	assert.True(t, pool.Submit(reqCtx, "rule-1", func(ctx context.Context) {
+		jobErr = ctx.Err()
+	}))
+
+	assert.NoError(t, pool.Close(context.Background()))
+	assert.NoError(t, jobErr)
+}
@@ -0,0 +1,214 @@
+package service
+
+import (
+	"context"
+	"demo-api-bridge/internal/core/domain"
+	"demo-api-bridge/internal/core/port"
+	"time"
+)
+
+// TransitionController
+// : 활성화된 오케스트레이션 규칙을 주기적으로 평가해 단계적 전환과 자동 롤백을 수행하는 컨트롤러입니다.
+//
+// 단계적 전환 (ramp plan이 있는 PARALLEL / SHADOW / CANARY 규칙):
+//  1. 현재 단계 시작 이후의 비교 통계 조회 (샘플 수, 평균 일치율, 모던 API 에러율)
+//  2. OrchestrationRule.EvaluateRamp로 유지/진행/완료 결정
+//  3. 진행/완료면 규칙을 저장하고 전환 이력(TransitionEvent)을 기록
+//
+// 자동 롤백 (MODERN_ONLY 규칙):
+//  1. 최근 관측 구간의 샘플링된 비교 통계 조회 (일치율, 에러율, 평균 응답 시간)
+//  2. OrchestrationRule.EvaluateRollback으로 롤백 여부 판단
+//  3. 롤백이면 대상 모드로 전환하고 사유와 함께 전환 이력을 기록
+//
+// 자동 전환 (ramp plan이 없고 자동 전환이 활성화된 PARALLEL / SHADOW 규칙):
+//  1. OrchestrationService.EvaluateTransition으로 안정성 확인 기간의 구간별 통계를 평가
+//  2. 전환 가능하면 MODERN_ONLY로 전환하고 전환 이력을 기록
+//
+// 평가는 요청마다가 아니라 transition_interval 주기로만 수행하므로, 트래픽이 많아도 통계 조회 부하는 일정합니다.
+//
+// 단계 시작 시간이 없는 규칙은 처음 관측한 시점을 시작 시간으로 저장하고 평가를 다음 주기로 미룹니다.
+type TransitionController struct {
+	orchestrationSvc  port.OrchestrationService        // 자동 전환 평가 / 실행
+	orchestrationRepo port.OrchestrationRepository     // 오케스트레이션 규칙 저장소
+	comparisonRepo    port.ComparisonRepository        // 비교 결과 저장소
+	historyRepo       port.TransitionHistoryRepository // 전환 이력 저장소
+	logger            port.Logger                      // 로거
+	metrics           port.MetricsCollector            // 메트릭 수집기
+	now               func() time.Time                 // 현재 시간 (테스트에서 교체)
+}
+
+// NewTransitionController는 새로운 TransitionController를 생성합니다.
+func NewTransitionController(
+	orchestrationSvc port.OrchestrationService,
+	orchestrationRepo port.OrchestrationRepository,
+	comparisonRepo port.ComparisonRepository,
+	historyRepo port.TransitionHistoryRepository,
+	logger port.Logger,
+	metrics port.MetricsCollector,
+) *TransitionController {
+	return &TransitionController{
+		orchestrationSvc:  orchestrationSvc,
+		orchestrationRepo: orchestrationRepo,
+		comparisonRepo:    comparisonRepo,
+		historyRepo:       historyRepo,
+		logger:            logger,
+		metrics:           metrics,
+		now:               time.Now,
+	}
+}
+
+// EvaluateAll은 활성화된 모든 규칙을 평가합니다.
+// 한 규칙의 평가 실패는 로그만 남기고 나머지 규칙 평가를 계속합니다.
+func (c *TransitionController) EvaluateAll(ctx context.Context) error {
+	rules, err := c.orchestrationRepo.FindActive(ctx)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("failed to load active orchestration rules", "error", err)
+		return err
+	}
+
+	for _, rule := range rules {
+		switch {
+		case rule.CurrentMode == domain.MODERN_ONLY:
+			if err := c.evaluateRollback(ctx, rule); err != nil {
+				c.logger.WithContext(ctx).Error("failed to evaluate rollback", "rule_id", rule.ID, "error", err)
+			}
+		case rule.HasRampPlan():
+			if err := c.evaluateRamp(ctx, rule); err != nil {
+				c.logger.WithContext(ctx).Error("failed to evaluate ramp", "rule_id", rule.ID, "error", err)
+			}
+		case rule.AutoTransitionBlocker() == "":
+			if err := c.evaluateAutoTransition(ctx, rule); err != nil {
+				c.logger.WithContext(ctx).Error("failed to evaluate auto transition", "rule_id", rule.ID, "error", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// evaluateRamp는 규칙 하나의 단계 진행 여부를 평가하고 결과를 반영합니다.
+func (c *TransitionController) evaluateRamp(ctx context.Context, rule *domain.OrchestrationRule) error {
+	now := c.now()
+
+	if rule.TransitionConfig.RampState.StageStartedAt.IsZero() {
+		rule.TransitionConfig.RampState.StageStartedAt = now
+		rule.UpdatedAt = now
+		return c.orchestrationRepo.Update(ctx, rule)
+	}
+
+	stats, err := c.comparisonRepo.GetComparisonStatistics(ctx, rule.RoutingRuleID, rule.TransitionConfig.RampState.StageStartedAt, now)
+	if err != nil {
+		return err
+	}
+
+	decision := rule.EvaluateRamp(transitionSignals(stats), now)
+	if decision.Action == domain.RampHold {
+		c.logger.WithContext(ctx).Debug("ramp stage held", "rule_id", rule.ID, "reason", decision.Reason)
+		return nil
+	}
+
+	rule.ApplyRampDecision(decision, now)
+	if err := c.orchestrationRepo.Update(ctx, rule); err != nil {
+		return err
+	}
+
+	if err := c.historyRepo.Save(ctx, domain.NewRampTransitionEvent(rule.ID, decision, now)); err != nil {
+		c.logger.WithContext(ctx).Warn("failed to save transition event", "rule_id", rule.ID, "error", err)
+	}
+
+	c.metrics.IncrementCounter("ramp_transitions", map[string]string{
+		"rule_id": rule.ID,
+		"action":  string(decision.Action),
+		"to_mode": string(decision.ToMode),
+	})
+	c.metrics.RecordGauge("ramp_modern_percentage", float64(decision.ToPercentage), map[string]string{
+		"rule_id": rule.ID,
+	})
+
+	c.logger.WithContext(ctx).Info("ramp stage changed",
+		"rule_id", rule.ID,
+		"action", decision.Action,
+		"from_percentage", decision.FromPercentage,
+		"to_percentage", decision.ToPercentage,
+		"reason", decision.Reason,
+	)
+
+	return nil
+}
+
+// evaluateAutoTransition은 규칙 하나의 자동 전환 조건을 평가하고, 조건을 만족하면 MODERN_ONLY로 전환합니다.
+func (c *TransitionController) evaluateAutoTransition(ctx context.Context, rule *domain.OrchestrationRule) error {
+	evaluation, err := c.orchestrationSvc.EvaluateTransition(ctx, rule)
+	if err != nil {
+		return err
+	}
+
+	if !evaluation.CanTransition {
+		c.logger.WithContext(ctx).Debug("auto transition held", "rule_id", rule.ID, "reason", evaluation.Reason)
+		return nil
+	}
+
+	c.logger.WithContext(ctx).Info("transition condition met, executing transition", "rule_id", rule.ID, "reason", evaluation.Reason)
+	return c.orchestrationSvc.ExecuteTransition(ctx, rule, domain.MODERN_ONLY, domain.TransitionTriggerAuto, "transition-controller")
+}
+
+// evaluateRollback은 MODERN_ONLY 규칙의 롤백 필요 여부를 평가하고, 필요하면 롤백합니다.
+//
+// 관측 구간은 최근 Rollback.Window이며, MODERN_ONLY로 전환되기 전의 비교 결과는 제외합니다.
+func (c *TransitionController) evaluateRollback(ctx context.Context, rule *domain.OrchestrationRule) error {
+	now := c.now()
+
+	from := now.Add(-rule.TransitionConfig.Rollback.ObservationWindow())
+	if startedAt := rule.TransitionConfig.RampState.StageStartedAt; startedAt.After(from) {
+		from = startedAt
+	}
+
+	stats, err := c.comparisonRepo.GetComparisonStatistics(ctx, rule.RoutingRuleID, from, now)
+	if err != nil {
+		return err
+	}
+
+	signals := transitionSignals(stats)
+	rollback, reason := rule.EvaluateRollback(signals)
+	if !rollback {
+		c.logger.WithContext(ctx).Debug("rollback not required", "rule_id", rule.ID, "reason", reason)
+		return nil
+	}
+
+	fromMode := rule.CurrentMode
+	rule.ApplyRollback(now)
+	if err := c.orchestrationRepo.Update(ctx, rule); err != nil {
+		return err
+	}
+
+	if err := c.historyRepo.Save(ctx, domain.NewRollbackTransitionEvent(rule.ID, fromMode, rule.CurrentMode, signals, reason, now)); err != nil {
+		c.logger.WithContext(ctx).Warn("failed to save transition event", "rule_id", rule.ID, "error", err)
+	}
+
+	c.metrics.IncrementCounter("api_mode_rollbacks", map[string]string{
+		"rule_id": rule.ID,
+		"to_mode": string(rule.CurrentMode),
+	})
+
+	c.logger.WithContext(ctx).Warn("orchestration rule rolled back",
+		"rule_id", rule.ID,
+		"from_mode", fromMode,
+		"to_mode", rule.CurrentMode,
+		"reason", reason,
+	)
+
+	return nil
+}
+
+// transitionSignals는 비교 통계를 전환 판단 지표로 변환합니다.
+func transitionSignals(stats *port.ComparisonStatistics) domain.TransitionSignals {
+	signals := domain.TransitionSignals{
+		SampleSize:     stats.TotalComparisons,
+		MatchRate:      stats.AverageMatchRate,
+		AverageLatency: stats.AverageLatency,
+	}
+	if stats.TotalComparisons > 0 {
+		signals.ErrorRate = float64(stats.ModernErrors) / float64(stats.TotalComparisons)
+	}
+	return signals
+}
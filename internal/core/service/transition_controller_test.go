@@ -0,0 +1,276 @@
+package service
+
+import (
+	"context"
+	"demo-api-bridge/internal/core/domain"
+	"demo-api-bridge/internal/core/port"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockTransitionHistoryRepository struct {
+	mock.Mock
+}
+
+func (m *MockTransitionHistoryRepository) Save(ctx context.Context, event *domain.TransitionEvent) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+func (m *MockTransitionHistoryRepository) FindByRuleID(ctx context.Context, ruleID string, limit int) ([]*domain.TransitionEvent, error) {
+	args := m.Called(ctx, ruleID, limit)
+	return args.Get(0).([]*domain.TransitionEvent), args.Error(1)
+}
+
+// newTestTransitionController는 현재 시간이 고정된 TransitionController를 생성합니다.
+func newTestTransitionController(now time.Time) (*TransitionController, *MockOrchestrationRepository, *MockComparisonRepository, *MockTransitionHistoryRepository, *MockMetricsCollector) {
+	mockOrchestrationRepo := &MockOrchestrationRepository{}
+	mockComparisonRepo := &MockComparisonRepository{}
+	mockHistoryRepo := &MockTransitionHistoryRepository{}
+	mockOrchestrationSvc := &MockOrchestrationService{}
+	mockLogger := &MockLogger{}
+	mockMetrics := &MockMetricsCollector{}
+
+	mockLogger.On("WithContext", mock.Anything).Return(mockLogger)
+	mockLogger.On("Debug", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+
+	controller := NewTransitionController(mockOrchestrationSvc, mockOrchestrationRepo, mockComparisonRepo, mockHistoryRepo, mockLogger, mockMetrics)
+	controller.now = func() time.Time { return now }
+
+	return controller, mockOrchestrationRepo, mockComparisonRepo, mockHistoryRepo, mockMetrics
+}
+
+func newTestRampRule(stageStartedAt time.Time) *domain.OrchestrationRule {
+	rule := domain.NewOrchestrationRule("orch-1", "ramp", "rule-1", "legacy-endpoint-1", "modern-endpoint-1")
+	rule.CurrentMode = domain.CANARY
+	rule.TransitionConfig.RampPlan = []domain.RampStage{
+		{Percentage: 1, MinSampleSize: 50, MinMatchRate: 0.98, MaxErrorRate: 0.01, DwellTime: 10 * time.Minute},
+		{Percentage: 25, MinSampleSize: 50, MinMatchRate: 0.98, MaxErrorRate: 0.01, DwellTime: 10 * time.Minute},
+	}
+	rule.TransitionConfig.Canary.Percentage = 1
+	rule.TransitionConfig.RampState = domain.RampState{StageIndex: 0, StageStartedAt: stageStartedAt}
+	return rule
+}
+
+func TestTransitionController_EvaluateAll_AdvancesStage(t *testing.T) {
+	// Given
+	now := time.Date(2025, 1, 5, 12, 0, 0, 0, time.UTC)
+	stageStartedAt := now.Add(-time.Hour)
+	controller, mockOrchestrationRepo, mockComparisonRepo, mockHistoryRepo, mockMetrics := newTestTransitionController(now)
+
+	ctx := context.Background()
+	rule := newTestRampRule(stageStartedAt)
+	plain := domain.NewOrchestrationRule("orch-2", "plain", "rule-2", "legacy-endpoint-1", "modern-endpoint-1")
+	plain.TransitionConfig.AutoTransitionEnabled = false
+
+	mockOrchestrationRepo.On("FindActive", ctx).Return([]*domain.OrchestrationRule{rule, plain}, nil)
+	mockComparisonRepo.On("GetComparisonStatistics", ctx, "rule-1", stageStartedAt, now).Return(&port.ComparisonStatistics{
+		RoutingRuleID:    "rule-1",
+		TotalComparisons: 200,
+		AverageMatchRate: 0.99,
+		ModernErrors:     1,
+	}, nil)
+	mockOrchestrationRepo.On("Update", ctx, mock.MatchedBy(func(r *domain.OrchestrationRule) bool {
+		return r.ID == "orch-1" && r.TransitionConfig.Canary.Percentage == 25 && r.TransitionConfig.RampState.StageIndex == 1
+	})).Return(nil)
+	mockHistoryRepo.On("Save", ctx, mock.MatchedBy(func(e *domain.TransitionEvent) bool {
+		return e.RuleID == "orch-1" &&
+			e.FromPercentage == 1 && e.ToPercentage == 25 &&
+			e.Trigger == domain.TransitionTriggerAuto &&
+			e.SampleSize == 200 && e.ErrorRate == 0.005
+	})).Return(nil)
+	mockMetrics.On("IncrementCounter", "ramp_transitions", map[string]string{
+		"rule_id": "orch-1",
+		"action":  "ADVANCE",
+		"to_mode": "CANARY",
+	}).Return()
+	mockMetrics.On("RecordGauge", "ramp_modern_percentage", 25.0, map[string]string{"rule_id": "orch-1"}).Return()
+
+	// When
+	err := controller.EvaluateAll(ctx)
+
+	// Then
+	assert.NoError(t, err)
+	assert.True(t, rule.TransitionConfig.RampState.StageStartedAt.Equal(now))
+	mockOrchestrationRepo.AssertExpectations(t)
+	mockComparisonRepo.AssertExpectations(t)
+	mockHistoryRepo.AssertExpectations(t)
+	mockMetrics.AssertExpectations(t)
+}
+
+func TestTransitionController_EvaluateAll_HoldsStage(t *testing.T) {
+	// Given
+	now := time.Date(2025, 1, 5, 12, 0, 0, 0, time.UTC)
+	stageStartedAt := now.Add(-time.Hour)
+	controller, mockOrchestrationRepo, mockComparisonRepo, mockHistoryRepo, mockMetrics := newTestTransitionController(now)
+
+	ctx := context.Background()
+	rule := newTestRampRule(stageStartedAt)
+
+	mockOrchestrationRepo.On("FindActive", ctx).Return([]*domain.OrchestrationRule{rule}, nil)
+	mockComparisonRepo.On("GetComparisonStatistics", ctx, "rule-1", stageStartedAt, now).Return(&port.ComparisonStatistics{
+		RoutingRuleID:    "rule-1",
+		TotalComparisons: 200,
+		AverageMatchRate: 0.99,
+		ModernErrors:     20, // 에러율 10%
+	}, nil)
+
+	// When
+	err := controller.EvaluateAll(ctx)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, 1, rule.TransitionConfig.Canary.Percentage)
+	mockOrchestrationRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	mockHistoryRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	mockMetrics.AssertNotCalled(t, "IncrementCounter", mock.Anything, mock.Anything)
+}
+
+func TestTransitionController_EvaluateAll_StartsObservationWindow(t *testing.T) {
+	// Given
+	now := time.Date(2025, 1, 5, 12, 0, 0, 0, time.UTC)
+	controller, mockOrchestrationRepo, mockComparisonRepo, mockHistoryRepo, _ := newTestTransitionController(now)
+
+	ctx := context.Background()
+	rule := newTestRampRule(time.Time{})
+
+	mockOrchestrationRepo.On("FindActive", ctx).Return([]*domain.OrchestrationRule{rule}, nil)
+	mockOrchestrationRepo.On("Update", ctx, rule).Return(nil)
+
+	// When
+	err := controller.EvaluateAll(ctx)
+
+	// Then
+	assert.NoError(t, err)
+	assert.True(t, rule.TransitionConfig.RampState.StageStartedAt.Equal(now))
+	mockOrchestrationRepo.AssertExpectations(t)
+	mockComparisonRepo.AssertNotCalled(t, "GetComparisonStatistics", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockHistoryRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+}
+
+func TestTransitionController_EvaluateAll_RollsBackModernOnly(t *testing.T) {
+	// Given
+	now := time.Date(2025, 1, 5, 12, 0, 0, 0, time.UTC)
+	controller, mockOrchestrationRepo, mockComparisonRepo, mockHistoryRepo, mockMetrics := newTestTransitionController(now)
+	mockLogger := controller.logger.(*MockLogger)
+	mockLogger.On("Warn", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+
+	ctx := context.Background()
+	rule := domain.NewOrchestrationRule("orch-1", "modern", "rule-1", "legacy-endpoint-1", "modern-endpoint-1")
+	rule.CurrentMode = domain.MODERN_ONLY
+	rule.TransitionConfig.RampState.StageStartedAt = now.Add(-5 * time.Minute)
+	rule.TransitionConfig.Rollback = domain.RollbackConfig{
+		TargetMode:    domain.LEGACY_ONLY,
+		SampleRate:    0.1,
+		MinSampleSize: 20,
+		MaxErrorRate:  0.05,
+	}
+
+	// 관측 구간은 15분이지만 MODERN_ONLY 전환 시점 이후만 집계
+	mockOrchestrationRepo.On("FindActive", ctx).Return([]*domain.OrchestrationRule{rule}, nil)
+	mockComparisonRepo.On("GetComparisonStatistics", ctx, "rule-1", now.Add(-5*time.Minute), now).Return(&port.ComparisonStatistics{
+		RoutingRuleID:    "rule-1",
+		TotalComparisons: 40,
+		AverageMatchRate: 0.97,
+		ModernErrors:     8,
+	}, nil)
+	mockOrchestrationRepo.On("Update", ctx, mock.MatchedBy(func(r *domain.OrchestrationRule) bool {
+		return r.CurrentMode == domain.LEGACY_ONLY && !r.TransitionConfig.AutoTransitionEnabled
+	})).Return(nil)
+	mockHistoryRepo.On("Save", ctx, mock.MatchedBy(func(e *domain.TransitionEvent) bool {
+		return e.RuleID == "orch-1" &&
+			e.FromMode == domain.MODERN_ONLY && e.ToMode == domain.LEGACY_ONLY &&
+			e.Trigger == domain.TransitionTriggerRollback &&
+			e.ErrorRate == 0.2 && e.Reason == "error rate 0.2000 > 0.0500"
+	})).Return(nil)
+	mockMetrics.On("IncrementCounter", "api_mode_rollbacks", map[string]string{
+		"rule_id": "orch-1",
+		"to_mode": "LEGACY_ONLY",
+	}).Return()
+
+	// When
+	err := controller.EvaluateAll(ctx)
+
+	// Then
+	assert.NoError(t, err)
+	mockOrchestrationRepo.AssertExpectations(t)
+	mockComparisonRepo.AssertExpectations(t)
+	mockHistoryRepo.AssertExpectations(t)
+	mockMetrics.AssertExpectations(t)
+}
+
+func TestTransitionController_EvaluateAll_KeepsHealthyModernOnly(t *testing.T) {
+	// Given
+	now := time.Date(2025, 1, 5, 12, 0, 0, 0, time.UTC)
+	controller, mockOrchestrationRepo, mockComparisonRepo, mockHistoryRepo, _ := newTestTransitionController(now)
+
+	ctx := context.Background()
+	rule := domain.NewOrchestrationRule("orch-1", "modern", "rule-1", "legacy-endpoint-1", "modern-endpoint-1")
+	rule.CurrentMode = domain.MODERN_ONLY
+	rule.TransitionConfig.Rollback = domain.RollbackConfig{SampleRate: 0.1, MinSampleSize: 20}
+
+	mockOrchestrationRepo.On("FindActive", ctx).Return([]*domain.OrchestrationRule{rule}, nil)
+	mockComparisonRepo.On("GetComparisonStatistics", ctx, "rule-1", now.Add(-domain.DefaultRollbackWindow), now).Return(&port.ComparisonStatistics{
+		RoutingRuleID:    "rule-1",
+		TotalComparisons: 40,
+		AverageMatchRate: 0.99,
+	}, nil)
+
+	// When
+	err := controller.EvaluateAll(ctx)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, domain.MODERN_ONLY, rule.CurrentMode)
+	mockComparisonRepo.AssertExpectations(t)
+	mockOrchestrationRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	mockHistoryRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+}
+
+func TestTransitionController_EvaluateAll_AutoTransition(t *testing.T) {
+	tests := []struct {
+		name          string
+		canTransition bool
+	}{
+		{name: "condition met", canTransition: true},
+		{name: "condition not met", canTransition: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			now := time.Date(2025, 1, 5, 12, 0, 0, 0, time.UTC)
+			controller, mockOrchestrationRepo, mockComparisonRepo, _, _ := newTestTransitionController(now)
+			mockOrchestrationSvc := controller.orchestrationSvc.(*MockOrchestrationService)
+			controller.logger.(*MockLogger).On("Info", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+
+			ctx := context.Background()
+			rule := domain.NewOrchestrationRule("orch-1", "auto", "rule-1", "legacy-endpoint-1", "modern-endpoint-1")
+			rule.CurrentMode = domain.SHADOW
+
+			mockOrchestrationRepo.On("FindActive", ctx).Return([]*domain.OrchestrationRule{rule}, nil)
+			mockOrchestrationSvc.On("EvaluateTransition", ctx, rule).Return(&domain.TransitionEvaluation{CanTransition: tt.canTransition, Reason: "evaluated"}, nil)
+			if tt.canTransition {
+				mockOrchestrationSvc.On("ExecuteTransition", ctx, rule, domain.MODERN_ONLY, domain.TransitionTriggerAuto, "transition-controller").Return(nil)
+			}
+
+			// When
+			err := controller.EvaluateAll(ctx)
+
+			// Then
+			assert.NoError(t, err)
+			mockOrchestrationSvc.AssertExpectations(t)
+			if !tt.canTransition {
+				mockOrchestrationSvc.AssertNotCalled(t, "ExecuteTransition", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			}
+			// 자동 전환 평가는 OrchestrationService에 맡기고 컨트롤러가 직접 통계를 조회하지 않음
+			mockComparisonRepo.AssertNotCalled(t, "GetComparisonStatistics", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
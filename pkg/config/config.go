@@ -20,6 +20,7 @@ type Config struct {
 	Metrics        MetricsConfig        `yaml:"metrics"`
 	Cache          CacheConfig          `yaml:"cache"`
 	Endpoints      EndpointsConfig      `yaml:"endpoints"`
+	Orchestration  OrchestrationConfig  `yaml:"orchestration"`
 }
 
 // ServerConfig는 서버 관련 설정을 나타냅니다.
@@ -74,13 +75,23 @@ type ExternalAPIConfig struct {
 	RetryDelay             time.Duration `yaml:"retry_delay"`
 	MaxRetryDelay          time.Duration `yaml:"max_retry_delay"`
 	RetryBackoffMultiplier float64       `yaml:"retry_backoff_multiplier"`
+	Streaming              bool          `yaml:"streaming"`     // 비교가 필요 없는 LEGACY_ONLY / MODERN_ONLY 요청의 본문 스트리밍
+	MaxBodySize            int64         `yaml:"max_body_size"` // 요청/응답 본문 최대 크기 (바이트, 0이면 제한 없음)
 }
 
 // CircuitBreakerConfig는 Circuit Breaker 관련 설정을 나타냅니다.
+// 전역 설정과 엔드포인트별 설정(endpoints.*.circuit_breaker)에 함께 사용하며,
+// 엔드포인트별 설정에 없는 항목은 전역 설정을 따릅니다.
 type CircuitBreakerConfig struct {
-	MaxRequests uint32        `yaml:"max_requests"`
-	Interval    time.Duration `yaml:"interval"`
-	Timeout     time.Duration `yaml:"timeout"`
+	Strategy            string        `yaml:"strategy"`             // OPEN 전환 조건: consecutive_failures, failure_ratio, slow_call_ratio
+	MaxRequests         uint32        `yaml:"max_requests"`         // HALF_OPEN 상태에서 허용할 최대 요청 수
+	Interval            time.Duration `yaml:"interval"`             // CLOSED 상태 카운터 초기화 주기 (비율 전략의 집계 구간)
+	Timeout             time.Duration `yaml:"timeout"`              // OPEN 상태 유지 시간
+	ConsecutiveFailures uint32        `yaml:"consecutive_failures"` // 연속 실패 임계값
+	MinRequests         uint32        `yaml:"min_requests"`         // 비율을 평가하기 위한 최소 요청 수
+	FailureRatio        float64       `yaml:"failure_ratio"`        // 실패 비율 임계값 (0~1)
+	SlowCallRatio       float64       `yaml:"slow_call_ratio"`      // 느리거나 실패한 호출의 비율 임계값 (0~1)
+	SlowCallThreshold   time.Duration `yaml:"slow_call_threshold"`  // 느린 호출로 간주할 응답 시간
 }
 
 // MetricsConfig는 메트릭 관련 설정을 나타냅니다.
@@ -98,10 +109,33 @@ type CacheConfig struct {
 	BufferItems     int64         `yaml:"buffer_items"`      // Ristretto 버퍼 크기
 	MetricsEnabled  bool          `yaml:"metrics_enabled"`   // Ristretto 메트릭 활성화
 	DefaultTTL      time.Duration `yaml:"default_ttl"`       // 기본 TTL
-	RoutingRulesTTL time.Duration `yaml:"routing_rules_ttl"` // 라우팅 규칙 TTL
+	RoutingRulesTTL time.Duration `yaml:"routing_rules_ttl"` // 라우트 인덱스 갱신 주기
 	APIResponseTTL  time.Duration `yaml:"api_response_ttl"`  // API 응답 TTL
 }
 
+// OrchestrationConfig는 레거시/모던 API 오케스트레이션 관련 설정을 나타냅니다.
+type OrchestrationConfig struct {
+	Shadow             ShadowConfig    `yaml:"shadow"`
+	TransitionInterval time.Duration   `yaml:"transition_interval"` // 단계적 전환 / 자동 전환 / 자동 롤백 평가 주기 (0이면 비활성화)
+	Retention          RetentionConfig `yaml:"retention"`           // 비교 결과 보존 정책
+}
+
+// RetentionConfig는 비교 결과 보존 정책 설정을 나타냅니다.
+type RetentionConfig struct {
+	Interval      time.Duration `yaml:"interval"`       // 보존 정책 적용 주기 (0이면 비활성화)
+	BodyRetention time.Duration `yaml:"body_retention"` // 응답 본문 보존 기간 (지나면 본문만 제거, 0이면 유지)
+	MaxAge        time.Duration `yaml:"max_age"`        // 비교 결과 보존 기간 (지나면 일별 요약 후 삭제, 0이면 유지)
+}
+
+// ShadowConfig는 SHADOW 모드 백그라운드 워커 풀 설정을 나타냅니다.
+type ShadowConfig struct {
+	Workers        int           `yaml:"workers"`         // 동시에 실행할 워커 수
+	QueueSize      int           `yaml:"queue_size"`      // 대기 큐 크기
+	DropPolicy     string        `yaml:"drop_policy"`     // 큐 포화 시 처리 방식: drop_newest, drop_oldest
+	EnqueueTimeout time.Duration `yaml:"enqueue_timeout"` // 큐 포화 시 버리기 전 최대 대기 시간
+	JobTimeout     time.Duration `yaml:"job_timeout"`     // 섀도우 작업 하나의 최대 실행 시간
+}
+
 // EndpointsConfig는 API 엔드포인트 설정을 나타냅니다.
 type EndpointsConfig struct {
 	Endpoints map[string]EndpointConfig `yaml:"endpoints"`
@@ -109,16 +143,17 @@ type EndpointsConfig struct {
 
 // EndpointConfig는 개별 엔드포인트 설정을 나타냅니다.
 type EndpointConfig struct {
-	ID          string        `yaml:"id"`
-	Name        string        `yaml:"name"`
-	Description string        `yaml:"description"`
-	BaseURL     string        `yaml:"base_url"`
-	HealthURL   string        `yaml:"health_url"`
-	IsActive    bool          `yaml:"is_active"`
-	IsLegacy    bool          `yaml:"is_legacy"`    // 레거시 API 여부
-	IsDefault   bool          `yaml:"is_default"`   // 기본 엔드포인트 여부
-	Timeout     time.Duration `yaml:"timeout"`
-	RetryConfig RetryConfig   `yaml:"retry"`
+	ID             string               `yaml:"id"`
+	Name           string               `yaml:"name"`
+	Description    string               `yaml:"description"`
+	BaseURL        string               `yaml:"base_url"`
+	HealthURL      string               `yaml:"health_url"`
+	IsActive       bool                 `yaml:"is_active"`
+	IsLegacy       bool                 `yaml:"is_legacy"`  // 레거시 API 여부
+	IsDefault      bool                 `yaml:"is_default"` // 기본 엔드포인트 여부
+	Timeout        time.Duration        `yaml:"timeout"`
+	RetryConfig    RetryConfig          `yaml:"retry"`
+	CircuitBreaker CircuitBreakerConfig `yaml:"circuit_breaker"` // 엔드포인트별 Circuit Breaker 설정 (없는 항목은 전역 설정 사용)
 }
 
 // RetryConfig는 재시도 정책 설정을 나타냅니다.
@@ -202,11 +237,19 @@ func getDefaultConfig() *Config {
 			RetryDelay:             1 * time.Second,
 			MaxRetryDelay:          10 * time.Second,
 			RetryBackoffMultiplier: 2.0,
+			Streaming:              true,
+			MaxBodySize:            32 << 20, // 32MB
 		},
 		CircuitBreaker: CircuitBreakerConfig{
-			MaxRequests: 5,
-			Interval:    10 * time.Second,
-			Timeout:     5 * time.Second,
+			Strategy:            "consecutive_failures",
+			MaxRequests:         5,
+			Interval:            10 * time.Second,
+			Timeout:             5 * time.Second,
+			ConsecutiveFailures: 5,
+			MinRequests:         10,
+			FailureRatio:        0.5,
+			SlowCallRatio:       0.5,
+			SlowCallThreshold:   5 * time.Second,
 		},
 		Metrics: MetricsConfig{
 			Enabled: true,
@@ -223,6 +266,21 @@ func getDefaultConfig() *Config {
 			RoutingRulesTTL: 3600 * time.Second, // 1시간
 			APIResponseTTL:  600 * time.Second,  // 10분
 		},
+		Orchestration: OrchestrationConfig{
+			Shadow: ShadowConfig{
+				Workers:        8,
+				QueueSize:      1000,
+				DropPolicy:     "drop_newest",
+				EnqueueTimeout: 0, // 대기 없이 즉시 버림 (클라이언트 지연 방지)
+				JobTimeout:     30 * time.Second,
+			},
+			TransitionInterval: 1 * time.Minute,
+			Retention: RetentionConfig{
+				Interval:      1 * time.Hour,
+				BodyRetention: 7 * 24 * time.Hour,  // 7일
+				MaxAge:        30 * 24 * time.Hour, // 30일
+			},
+		},
 		Endpoints: EndpointsConfig{
 			Endpoints: map[string]EndpointConfig{
 				"legacy-user-api": {
@@ -0,0 +1,166 @@
+package test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"demo-api-bridge/internal/adapter/outbound/database"
+	"demo-api-bridge/internal/core/domain"
+	"demo-api-bridge/internal/core/port"
+	"demo-api-bridge/pkg/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestOracleComparisonRepository_MatchesMock은 Oracle 비교 저장소가 Mock 저장소(기준 구현)와
+// 같은 비교 결과와 통계를 반환하는지 확인합니다. OracleDB에 연결할 수 없으면 건너뜁니다.
+func TestOracleComparisonRepository_MatchesMock(t *testing.T) {
+	cfg, err := config.LoadConfig("../config/config.yaml")
+	require.NoError(t, err)
+
+	oracleRepo, err := database.NewOracleComparisonRepository(&cfg.Database)
+	if err != nil {
+		t.Skipf("OracleDB unavailable: %v", err)
+	}
+	mockRepo := database.NewMockComparisonRepository()
+
+	ctx := context.Background()
+	ruleID := fmt.Sprintf("it-cmp-%d", time.Now().UnixNano())
+	t.Cleanup(func() { deleteComparisons(t, cfg, ruleID) })
+
+	// Oracle TIMESTAMP 정밀도(마이크로초)에 맞춰 시간을 자름
+	now := time.Now().Truncate(time.Microsecond)
+	comparisons := newComparisonScenario(ruleID, now)
+
+	for _, comparison := range comparisons {
+		require.NoError(t, mockRepo.SaveComparison(ctx, comparison))
+		require.NoError(t, oracleRepo.SaveComparison(ctx, comparison))
+	}
+
+	// 단건 조회: 모든 필드 왕복
+	for _, comparison := range comparisons {
+		expected, err := mockRepo.GetComparison(ctx, comparison.ID)
+		require.NoError(t, err)
+		actual, err := oracleRepo.GetComparison(ctx, comparison.ID)
+		require.NoError(t, err)
+		assertSameComparison(t, expected, actual)
+	}
+
+	// 최근 비교 결과: 같은 개수, 같은 순서
+	expectedRecent, err := mockRepo.GetRecentComparisons(ctx, ruleID, 2)
+	require.NoError(t, err)
+	actualRecent, err := oracleRepo.GetRecentComparisons(ctx, ruleID, 2)
+	require.NoError(t, err)
+	require.Len(t, actualRecent, len(expectedRecent))
+	for i := range expectedRecent {
+		assertSameComparison(t, expectedRecent[i], actualRecent[i])
+	}
+
+	// 기간 통계
+	from, to := now.Add(-time.Hour), now.Add(time.Minute)
+	expectedStats, err := mockRepo.GetComparisonStatistics(ctx, ruleID, from, to)
+	require.NoError(t, err)
+	actualStats, err := oracleRepo.GetComparisonStatistics(ctx, ruleID, from, to)
+	require.NoError(t, err)
+	assertSameStatistics(t, expectedStats, actualStats)
+}
+
+// newComparisonScenario는 일치, 부분 불일치, 모던 API 실패 비교 결과를 생성합니다.
+func newComparisonScenario(ruleID string, now time.Time) []*domain.APIComparison {
+	legacy := func(body string, duration time.Duration) *domain.Response {
+		return &domain.Response{
+			StatusCode:  200,
+			Headers:     http.Header{"Content-Type": {"application/json"}},
+			Body:        []byte(body),
+			ContentType: "application/json",
+			Duration:    duration,
+		}
+	}
+
+	matched := domain.NewAPIComparison(ruleID+"-1", ruleID+"-req-1", ruleID, legacy(`{"id":1}`, 12500*time.Microsecond), legacy(`{"id":1}`, 8250*time.Microsecond))
+	matched.MatchRate = 1.0
+
+	partial := domain.NewAPIComparison(ruleID+"-2", ruleID+"-req-2", ruleID, legacy(`{"id":2,"price":10}`, 20*time.Millisecond), legacy(`{"id":2,"price":11}`, 30*time.Millisecond))
+	partial.MatchRate = 0.8333333333333334
+	partial.StrictMode = true
+	partial.Differences = []domain.ResponseDiff{{Type: domain.VALUE_MISMATCH, Dimension: domain.DimensionBody, Path: "price", LegacyValue: 10.0, ModernValue: 11.0}}
+
+	failed := domain.NewAPIComparison(ruleID+"-3", ruleID+"-req-3", ruleID, legacy(`{"id":3}`, 15*time.Millisecond), nil)
+	failed.Differences = []domain.ResponseDiff{{Type: domain.EXTRA, Path: "modern_response", Message: "Modern API call failed", ModernValue: "timeout"}}
+
+	for i, comparison := range []*domain.APIComparison{matched, partial, failed} {
+		comparison.OrchestrationRuleID = "orch-" + ruleID
+		comparison.ComparisonDuration = time.Duration(i+1) * 1500 * time.Microsecond
+		comparison.Timestamp = now.Add(time.Duration(i-3) * time.Minute)
+		comparison.CreatedAt = comparison.Timestamp
+	}
+
+	return []*domain.APIComparison{matched, partial, failed}
+}
+
+func assertSameComparison(t *testing.T, expected, actual *domain.APIComparison) {
+	t.Helper()
+
+	assert.Equal(t, expected.ID, actual.ID)
+	assert.Equal(t, expected.RequestID, actual.RequestID)
+	assert.Equal(t, expected.RoutingRuleID, actual.RoutingRuleID)
+	assert.Equal(t, expected.OrchestrationRuleID, actual.OrchestrationRuleID)
+	assert.Equal(t, expected.MatchRate, actual.MatchRate)
+	assert.Equal(t, expected.StrictMode, actual.StrictMode)
+	assert.Equal(t, expected.IsSuccessful(), actual.IsSuccessful())
+	assert.Equal(t, expected.ComparisonDuration, actual.ComparisonDuration)
+	assert.True(t, expected.Timestamp.Equal(actual.Timestamp), "timestamp %v != %v", expected.Timestamp, actual.Timestamp)
+
+	expectedDiffs, _ := json.Marshal(expected.Differences)
+	actualDiffs, _ := json.Marshal(actual.Differences)
+	assert.JSONEq(t, string(expectedDiffs), string(actualDiffs))
+
+	assertSameResponse(t, expected.LegacyResponse, actual.LegacyResponse)
+	assertSameResponse(t, expected.ModernResponse, actual.ModernResponse)
+}
+
+func assertSameResponse(t *testing.T, expected, actual *domain.Response) {
+	t.Helper()
+
+	if expected == nil {
+		assert.Nil(t, actual)
+		return
+	}
+	require.NotNil(t, actual)
+	assert.Equal(t, expected.StatusCode, actual.StatusCode)
+	assert.Equal(t, expected.Headers, actual.Headers)
+	assert.Equal(t, expected.Body, actual.Body)
+	assert.Equal(t, expected.ContentType, actual.ContentType)
+	assert.Equal(t, expected.Duration, actual.Duration)
+}
+
+func assertSameStatistics(t *testing.T, expected, actual *port.ComparisonStatistics) {
+	t.Helper()
+
+	assert.Equal(t, expected.TotalComparisons, actual.TotalComparisons)
+	assert.Equal(t, expected.SuccessfulMatches, actual.SuccessfulMatches)
+	assert.Equal(t, expected.ModernErrors, actual.ModernErrors)
+	assert.InDelta(t, expected.AverageMatchRate, actual.AverageMatchRate, 1e-9)
+	assert.Equal(t, expected.AverageLatency, actual.AverageLatency)
+	assert.True(t, expected.LastComparison.Equal(actual.LastComparison), "last comparison %v != %v", expected.LastComparison, actual.LastComparison)
+}
+
+// deleteComparisons는 테스트가 저장한 비교 결과를 삭제합니다.
+func deleteComparisons(t *testing.T, cfg *config.Config, ruleID string) {
+	db, err := sql.Open("oracle", cfg.Database.GetDSN())
+	if err != nil {
+		t.Logf("Failed to open database for cleanup: %v", err)
+		return
+	}
+	defer db.Close()
+
+	if _, err := db.Exec(`DELETE FROM comparison_logs WHERE routing_rule_id = :1`, ruleID); err != nil {
+		t.Logf("Failed to delete test comparisons: %v", err)
+	}
+}
@@ -41,11 +41,12 @@ func TestCRUDAPI(t *testing.T) {
 	routingService := service.NewRoutingService(routingRepo, cacheRepo, log, metricsCollector)
 
 	circuitBreakerService := service.NewCircuitBreakerService(log, metricsCollector)
-	httpClient := httpclient.NewHTTPClientAdapterWithCircuitBreaker(30*time.Second, circuitBreakerService)
+	httpClient := httpclient.NewHTTPClientAdapterWithCircuitBreaker(30*time.Second, circuitBreakerService, metricsCollector)
 
 	orchestrationService := service.NewOrchestrationService(
 		orchestrationRepo,
 		comparisonRepo,
+		database.NewMockTransitionHistoryRepository(),
 		httpClient,
 		log,
 		metricsCollector,
@@ -340,6 +340,7 @@ func setupIntegrationTest(testName, externalAPIURL string) (*gin.Engine, func())
 	orchestrationSvc := service.NewOrchestrationService(
 		orchestrationRepo,
 		comparisonRepo,
+		database.NewMockTransitionHistoryRepository(),
 		httpClient,
 		log,
 		metricsCollector,
@@ -410,6 +411,7 @@ func setupParallelTest(testName, legacyURL, modernURL string) (*gin.Engine, func
 	orchestrationSvc := service.NewOrchestrationService(
 		orchestrationRepo,
 		comparisonRepo,
+		database.NewMockTransitionHistoryRepository(),
 		httpClient,
 		log,
 		metricsCollector,
@@ -503,6 +505,7 @@ func setupCachedTest(testName, externalAPIURL string) (*gin.Engine, func()) {
 	orchestrationSvc := service.NewOrchestrationService(
 		orchestrationRepo,
 		comparisonRepo,
+		database.NewMockTransitionHistoryRepository(),
 		httpClient,
 		log,
 		metricsCollector,
@@ -574,6 +577,7 @@ func setupPostTest(testName, externalAPIURL string) (*gin.Engine, func()) {
 	orchestrationSvc := service.NewOrchestrationService(
 		orchestrationRepo,
 		comparisonRepo,
+		database.NewMockTransitionHistoryRepository(),
 		httpClient,
 		log,
 		metricsCollector,
@@ -250,6 +250,7 @@ func setupParallelCallsTest(testName, legacyURL, modernURL string) (*gin.Engine,
 	orchestrationSvc := service.NewOrchestrationService(
 		orchestrationRepo,
 		comparisonRepo,
+		database.NewMockTransitionHistoryRepository(),
 		httpClient,
 		log,
 		metricsCollector,
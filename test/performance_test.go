@@ -52,6 +52,7 @@ func setupPerformanceTest(testName string) (port.BridgeService, func()) {
 	orchestrationSvc := service.NewOrchestrationService(
 		orchestrationRepo,
 		comparisonRepo,
+		database.NewMockTransitionHistoryRepository(),
 		httpClient,
 		log,
 		metricsCollector,